@@ -0,0 +1,179 @@
+// Package alstest provides a fake AbletonOSC server for testing code built
+// on als.
+package alstest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hypebeast/go-osc/osc"
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+// Server is a fake AbletonOSC. It remembers values written with set
+// messages and answers get messages with them, echoing the object indices
+// like Live does. Getters without a value are never answered, so they time
+// out.
+type Server struct {
+	// SendPort is where clients send messages, ListenPort where replies go.
+	SendPort   int
+	ListenPort int
+
+	server *osc.Server
+	reply  *osc.Client
+
+	mu       sync.Mutex
+	values   map[string][]any
+	received []*osc.Message
+}
+
+// NewServer starts a fake server, closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{
+		SendPort:   conn.LocalAddr().(*net.UDPAddr).Port,
+		ListenPort: freePort(t),
+		values:     make(map[string][]any),
+	}
+	s.reply = osc.NewClient("127.0.0.1", s.ListenPort)
+
+	d := osc.NewStandardDispatcher()
+	d.AddMsgHandler("*", s.handle)
+	s.server = &osc.Server{Dispatcher: d}
+	go s.server.Serve(conn)
+	t.Cleanup(func() { conn.Close() })
+
+	s.Set("/live/test", "ok")
+	return s
+}
+
+// Client returns a running als client connected to the server, closed when
+// the test finishes.
+func (s *Server) Client(t testing.TB) *als.Client {
+	t.Helper()
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   s.SendPort,
+		ListenAddr: s.ListenPort,
+		Timeout:    200 * time.Millisecond,
+	})
+	client.Run()
+	t.Cleanup(client.Close)
+
+	// wait for the client to listen
+	for i := 0; i < 50; i++ {
+		if _, err := client.Query("/live/test"); err == nil {
+			break
+		}
+	}
+	return client
+}
+
+// Set stores the arguments a getter replies with, including any leading
+// object indices, e.g. Set("/live/track/get/name", int32(0), "Bass").
+func (s *Server) Set(addr string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := min(Indices(addr), len(args))
+	s.values[key(addr, args[:n])] = args
+}
+
+// Get returns the arguments a getter replies with.
+func (s *Server) Get(addr string, indices ...any) ([]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	args, ok := s.values[key(addr, indices)]
+	return args, ok
+}
+
+// Emit sends a message to the client, e.g. a listener update.
+func (s *Server) Emit(addr string, args ...any) {
+	s.reply.Send(osc.NewMessage(addr, args...))
+}
+
+// Received returns every message received on addr.
+func (s *Server) Received(addr string) []*osc.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []*osc.Message
+	for _, msg := range s.received {
+		if msg.Address == addr {
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+// WaitFor waits until a message has been received on addr, as messages are
+// handled concurrently.
+func (s *Server) WaitFor(t testing.TB, addr string) []*osc.Message {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if msgs := s.Received(addr); len(msgs) > 0 {
+			return msgs
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no message received on %s", addr)
+	return nil
+}
+
+func (s *Server) handle(msg *osc.Message) {
+	s.mu.Lock()
+	s.received = append(s.received, msg)
+	s.mu.Unlock()
+
+	switch {
+	case strings.Contains(msg.Address, "/set/"):
+		get := strings.Replace(msg.Address, "/set/", "/get/", 1)
+		s.Set(get, msg.Arguments...)
+	case strings.Contains(msg.Address, "/get/") || msg.Address == "/live/test":
+		n := min(Indices(msg.Address), len(msg.Arguments))
+		if args, ok := s.Get(msg.Address, msg.Arguments[:n]...); ok {
+			s.Emit(msg.Address, args...)
+		}
+	}
+}
+
+// Indices returns how many object indices lead the arguments of an
+// AbletonOSC address, e.g. 2 (track and clip) for /live/clip/get/name.
+func Indices(addr string) int {
+	parts := strings.Split(strings.TrimPrefix(addr, "/live/"), "/")
+	switch parts[0] {
+	case "track", "scene":
+		return 1
+	case "clip", "clip_slot":
+		return 2
+	case "device":
+		if len(parts) > 2 && parts[2] == "parameter" {
+			return 3
+		}
+		return 2
+	}
+	return 0
+}
+
+func key(addr string, indices []any) string {
+	return fmt.Sprint(addr, indices)
+}
+
+func freePort(t testing.TB) int {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
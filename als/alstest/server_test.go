@@ -0,0 +1,27 @@
+package alstest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestServer verifies getters, setters and timeouts against the fake server
+func TestServer(t *testing.T) {
+	s := NewServer(t)
+	client := s.Client(t)
+
+	s.Set("/live/song/get/tempo", float32(120))
+	s.Set("/live/track/get/name", int32(1), "Bass")
+
+	assert.Equal(t, float32(120), client.Song.GetTempo())
+	assert.Equal(t, "Bass", client.Track.GetName(1))
+	assert.Equal(t, "", client.Track.GetName(2))
+
+	client.Track.SetMute(1, true)
+	s.WaitFor(t, "/live/track/set/mute")
+	assert.True(t, client.Track.GetMute(1))
+
+	assert.Equal(t, 2, Indices("/live/clip/get/notes"))
+	assert.Equal(t, 3, Indices("/live/device/get/parameter/value"))
+}
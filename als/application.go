@@ -6,7 +6,7 @@ type ApplicationAPI struct {
 }
 
 func (a *ApplicationAPI) Test() string {
-	msg := a.client.send("/live/test").Wait()
+	msg := a.client.get("/live/test")
 	if len(msg.Arguments) > 0 {
 		if result, ok := msg.Arguments[0].(string); ok {
 			return result
@@ -16,7 +16,7 @@ func (a *ApplicationAPI) Test() string {
 }
 
 func (a *ApplicationAPI) GetVersion() (major, minor int32) {
-	msg := a.client.send("/live/application/get/version").Wait()
+	msg := a.client.get("/live/application/get/version")
 	if len(msg.Arguments) >= 2 {
 		if maj, ok := msg.Arguments[0].(int32); ok {
 			major = maj
@@ -34,7 +34,7 @@ func (a *ApplicationAPI) Reload() {
 }
 
 func (a *ApplicationAPI) GetLogLevel() string {
-	msg := a.client.send("/live/api/get/log_level").Wait()
+	msg := a.client.get("/live/api/get/log_level")
 	if len(msg.Arguments) > 0 {
 		if level, ok := msg.Arguments[0].(string); ok {
 			return level
@@ -0,0 +1,106 @@
+package als
+
+import "fmt"
+
+// Get queries a getter by address and returns the reply arguments
+// following the indices. Unlike the typed getters, it returns an error
+// wrapping oscclient.ErrTimeout when Live doesn't answer, and it reaches
+// properties they don't cover. Only a reply echoing the indices is taken,
+// see Query.
+func (c *Client) Get(addr string, indices ...any) ([]any, error) {
+	msg, err := c.Query(addr, indices...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", addr, err)
+	}
+	if len(msg.Arguments) < len(indices) {
+		return nil, nil
+	}
+	return msg.Arguments[len(indices):], nil
+}
+
+// SceneNames reads every scene's name through Get. AbletonOSC has no list
+// of scene names, so it asks for each scene in turn.
+func (c *Client) SceneNames() ([]string, error) {
+	count, err := c.Get("/live/song/get/num_scenes")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, max(Int(First(count)), 0))
+	for i := range names {
+		name, err := c.Get("/live/scene/get/name", int32(i))
+		if err != nil {
+			return nil, err
+		}
+		names[i] = String(First(name))
+	}
+	return names, nil
+}
+
+// AbletonOSC arguments are loosely typed: numbers arrive as ints or floats,
+// and bools as ints. The converters below accept either and return the zero
+// value for anything else, like the typed getters.
+
+// First returns the first argument, or nil if there are none.
+func First(args []any) any {
+	if len(args) == 0 {
+		return nil
+	}
+	return args[0]
+}
+
+// Float converts an argument to a float.
+func Float(v any) float32 {
+	switch n := v.(type) {
+	case float32:
+		return n
+	case int32:
+		return float32(n)
+	}
+	return 0
+}
+
+// Int converts an argument to an int.
+func Int(v any) int32 {
+	switch n := v.(type) {
+	case int32:
+		return n
+	case float32:
+		return int32(n)
+	}
+	return 0
+}
+
+// Bool converts an argument to a bool.
+func Bool(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case int32:
+		return b != 0
+	}
+	return false
+}
+
+// String converts an argument to a string.
+func String(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// Strings converts arguments to strings, keeping their positions: anything
+// but a string, such as the nil of an empty clip slot, becomes "".
+func Strings(args []any) []string {
+	list := make([]string, len(args))
+	for i, arg := range args {
+		list[i] = String(arg)
+	}
+	return list
+}
+
+// FromBool converts a bool to the 1 or 0 AbletonOSC expects.
+func FromBool(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
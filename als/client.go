@@ -1,6 +1,11 @@
 package als
 
-import "github.com/matt0792/ableton-ctrl/oscclient"
+import (
+	"sync"
+
+	"github.com/hypebeast/go-osc/osc"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
 
 // Client provides a high-level interface to Ableton.
 type Client struct {
@@ -13,6 +18,9 @@ type Client struct {
 	Device      *DeviceAPI
 	View        *ViewAPI
 	ClipSlot    *ClipSlotAPI
+
+	sharedMu sync.Mutex
+	shared   map[any]any
 }
 
 // NewClient creates a new Ableton Live OSC client.
@@ -48,3 +56,58 @@ func (c *Client) Close() {
 func (c *Client) send(addr string, params ...any) *oscclient.Call {
 	return c.osc.Send(addr, params...)
 }
+
+// get queries a getter, returning an empty message on timeout like
+// Call.Wait. The reply must echo indices, see oscclient.Client.Request.
+func (c *Client) get(addr string, indices ...any) *osc.Message {
+	msg, err := c.osc.Request(addr, indices...)
+	if err != nil {
+		return &osc.Message{}
+	}
+	return msg
+}
+
+// Send sends a raw AbletonOSC message without waiting for a response.
+func (c *Client) Send(addr string, params ...any) {
+	c.osc.Send(addr, params...)
+}
+
+// Query sends a raw AbletonOSC message and returns the response. Unlike the
+// typed getters, which return zero values on timeout, it returns
+// oscclient.ErrTimeout. Only a reply echoing params is taken, as replies to
+// getters lead with their indices, so concurrent queries for different
+// tracks can't get each other's replies.
+func (c *Client) Query(addr string, params ...any) (*osc.Message, error) {
+	return c.osc.Request(addr, params...)
+}
+
+// RateLimit returns the maximum messages sent per second, or 0 if sending
+// isn't limited.
+func (c *Client) RateLimit() int {
+	return c.osc.RateLimit()
+}
+
+// Subscribe calls fn for every message Live sends to addr until the returned
+// function is called. Use it with the StartListen methods, e.g.
+// /live/song/get/beat after Song.StartListenBeat.
+func (c *Client) Subscribe(addr string, fn func(*osc.Message)) (unsubscribe func()) {
+	return c.osc.Subscribe(addr, fn)
+}
+
+// Shared returns the value stored under key, calling create to store one the
+// first time. Packages use it to keep one instance per client of something
+// that must not be duplicated, such as listen.Shared's hub. Like context
+// keys, key should be of an unexported type.
+func (c *Client) Shared(key any, create func() any) any {
+	c.sharedMu.Lock()
+	defer c.sharedMu.Unlock()
+	if v, ok := c.shared[key]; ok {
+		return v
+	}
+	if c.shared == nil {
+		c.shared = make(map[any]any)
+	}
+	v := create()
+	c.shared[key] = v
+	return v
+}
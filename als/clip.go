@@ -1,6 +1,6 @@
 package als
 
-import "github.com/matt0792/ableton-ctrl/oscclient"
+import "github.com/hypebeast/go-osc/osc"
 
 // ClipAPI provides methods for interacting with Ableton's Clip API.
 type ClipAPI struct {
@@ -32,34 +32,36 @@ func (c *ClipAPI) DuplicateLoop(trackID, clipID int32) {
 // GetNotes returns notes from the clip within the specified range.
 // If no range is specified, returns all notes.
 func (c *ClipAPI) GetNotes(trackID, clipID int32, rangeParams ...int32) []Note {
-	var msg *oscclient.Call
+	params := []any{trackID, clipID}
 	if len(rangeParams) == 4 {
 		// startPitch, pitchSpan, startTime, timeSpan
-		msg = c.client.send("/live/clip/get/notes", trackID, clipID,
-			rangeParams[0], rangeParams[1], rangeParams[2], rangeParams[3])
-	} else {
-		msg = c.client.send("/live/clip/get/notes", trackID, clipID)
+		params = append(params, rangeParams[0], rangeParams[1], rangeParams[2], rangeParams[3])
+	}
+	// the reply echoes only the track and clip
+	result, err := c.client.osc.RequestEcho("/live/clip/get/notes", params[:2], params...)
+	if err != nil {
+		result = &osc.Message{}
 	}
-
-	result := msg.Wait()
 	notes := make([]Note, 0)
 
-	// come in groups of 5: pitch, start_time, duration, velocity, mute
-	for i := 0; i+4 < len(result.Arguments); i += 5 {
+	// follow the track and clip in groups of 5: pitch, start_time,
+	// duration, velocity, mute
+	args := result.Arguments[min(2, len(result.Arguments)):]
+	for i := 0; i+4 < len(args); i += 5 {
 		note := Note{}
-		if pitch, ok := result.Arguments[i].(int32); ok {
+		if pitch, ok := args[i].(int32); ok {
 			note.Pitch = pitch
 		}
-		if startTime, ok := result.Arguments[i+1].(float32); ok {
+		if startTime, ok := args[i+1].(float32); ok {
 			note.StartTime = startTime
 		}
-		if duration, ok := result.Arguments[i+2].(float32); ok {
+		if duration, ok := args[i+2].(float32); ok {
 			note.Duration = duration
 		}
-		if velocity, ok := result.Arguments[i+3].(int32); ok {
+		if velocity, ok := args[i+3].(int32); ok {
 			note.Velocity = velocity
 		}
-		if mute, ok := result.Arguments[i+4].(int32); ok {
+		if mute, ok := args[i+4].(int32); ok {
 			note.Mute = mute != 0
 		}
 		notes = append(notes, note)
@@ -87,7 +89,7 @@ func (c *ClipAPI) RemoveNotes(trackID, clipID, startPitch, pitchSpan int32, star
 // Getters
 
 func (c *ClipAPI) GetColor(trackID, clipID int32) int32 {
-	msg := c.client.send("/live/clip/get/color", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/color", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val
@@ -97,7 +99,7 @@ func (c *ClipAPI) GetColor(trackID, clipID int32) int32 {
 }
 
 func (c *ClipAPI) GetName(trackID, clipID int32) string {
-	msg := c.client.send("/live/clip/get/name", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/name", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(string); ok {
 			return val
@@ -107,7 +109,7 @@ func (c *ClipAPI) GetName(trackID, clipID int32) string {
 }
 
 func (c *ClipAPI) GetGain(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/gain", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/gain", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -117,7 +119,7 @@ func (c *ClipAPI) GetGain(trackID, clipID int32) float32 {
 }
 
 func (c *ClipAPI) GetLength(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/length", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/length", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -128,7 +130,7 @@ func (c *ClipAPI) GetLength(trackID, clipID int32) float32 {
 
 // GetPitchCoarse returns the clip pitch coarse adjustment in semitones.
 func (c *ClipAPI) GetPitchCoarse(trackID, clipID int32) int32 {
-	msg := c.client.send("/live/clip/get/pitch_coarse", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/pitch_coarse", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val
@@ -139,7 +141,7 @@ func (c *ClipAPI) GetPitchCoarse(trackID, clipID int32) int32 {
 
 // GetPitchFine returns the clip pitch fine adjustment in cents.
 func (c *ClipAPI) GetPitchFine(trackID, clipID int32) int32 {
-	msg := c.client.send("/live/clip/get/pitch_fine", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/pitch_fine", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val
@@ -149,7 +151,7 @@ func (c *ClipAPI) GetPitchFine(trackID, clipID int32) int32 {
 }
 
 func (c *ClipAPI) GetFilePath(trackID, clipID int32) string {
-	msg := c.client.send("/live/clip/get/file_path", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/file_path", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(string); ok {
 			return val
@@ -159,7 +161,7 @@ func (c *ClipAPI) GetFilePath(trackID, clipID int32) string {
 }
 
 func (c *ClipAPI) GetIsAudioClip(trackID, clipID int32) bool {
-	msg := c.client.send("/live/clip/get/is_audio_clip", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/is_audio_clip", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -169,7 +171,7 @@ func (c *ClipAPI) GetIsAudioClip(trackID, clipID int32) bool {
 }
 
 func (c *ClipAPI) GetIsMIDIClip(trackID, clipID int32) bool {
-	msg := c.client.send("/live/clip/get/is_midi_clip", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/is_midi_clip", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -179,7 +181,7 @@ func (c *ClipAPI) GetIsMIDIClip(trackID, clipID int32) bool {
 }
 
 func (c *ClipAPI) GetIsPlaying(trackID, clipID int32) bool {
-	msg := c.client.send("/live/clip/get/is_playing", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/is_playing", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -189,7 +191,7 @@ func (c *ClipAPI) GetIsPlaying(trackID, clipID int32) bool {
 }
 
 func (c *ClipAPI) GetIsRecording(trackID, clipID int32) bool {
-	msg := c.client.send("/live/clip/get/is_recording", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/is_recording", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -199,7 +201,7 @@ func (c *ClipAPI) GetIsRecording(trackID, clipID int32) bool {
 }
 
 func (c *ClipAPI) GetPlayingPosition(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/playing_position", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/playing_position", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -209,7 +211,7 @@ func (c *ClipAPI) GetPlayingPosition(trackID, clipID int32) float32 {
 }
 
 func (c *ClipAPI) GetLoopStart(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/loop_start", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/loop_start", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -219,7 +221,7 @@ func (c *ClipAPI) GetLoopStart(trackID, clipID int32) float32 {
 }
 
 func (c *ClipAPI) GetLoopEnd(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/loop_end", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/loop_end", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -229,7 +231,7 @@ func (c *ClipAPI) GetLoopEnd(trackID, clipID int32) float32 {
 }
 
 func (c *ClipAPI) GetWarping(trackID, clipID int32) bool {
-	msg := c.client.send("/live/clip/get/warping", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/warping", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -239,7 +241,7 @@ func (c *ClipAPI) GetWarping(trackID, clipID int32) bool {
 }
 
 func (c *ClipAPI) GetStartMarker(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/start_marker", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/start_marker", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -249,7 +251,7 @@ func (c *ClipAPI) GetStartMarker(trackID, clipID int32) float32 {
 }
 
 func (c *ClipAPI) GetEndMarker(trackID, clipID int32) float32 {
-	msg := c.client.send("/live/clip/get/end_marker", trackID, clipID).Wait()
+	msg := c.client.get("/live/clip/get/end_marker", trackID, clipID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -27,7 +27,7 @@ func (c *ClipSlotAPI) DuplicateClipTo(trackIndex, clipIndex, targetTrackIndex, t
 // --- Property Getters ---
 
 func (c *ClipSlotAPI) GetHasClip(trackIndex, clipIndex int32) bool {
-	msg := c.client.send("/live/clip_slot/get/has_clip", trackIndex, clipIndex).Wait()
+	msg := c.client.get("/live/clip_slot/get/has_clip", trackIndex, clipIndex)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -37,7 +37,7 @@ func (c *ClipSlotAPI) GetHasClip(trackIndex, clipIndex int32) bool {
 }
 
 func (c *ClipSlotAPI) GetHasStopButton(trackIndex, clipIndex int32) bool {
-	msg := c.client.send("/live/clip_slot/get/has_stop_button", trackIndex, clipIndex).Wait()
+	msg := c.client.get("/live/clip_slot/get/has_stop_button", trackIndex, clipIndex)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val != 0
@@ -8,7 +8,7 @@ type DeviceAPI struct {
 // --- Property Getters ---
 
 func (d *DeviceAPI) GetName(trackID, deviceID int32) string {
-	msg := d.client.send("/live/device/get/name", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/name", trackID, deviceID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(string); ok {
 			return val
@@ -18,7 +18,7 @@ func (d *DeviceAPI) GetName(trackID, deviceID int32) string {
 }
 
 func (d *DeviceAPI) GetClassName(trackID, deviceID int32) string {
-	msg := d.client.send("/live/device/get/class_name", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/class_name", trackID, deviceID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(string); ok {
 			return val
@@ -28,7 +28,7 @@ func (d *DeviceAPI) GetClassName(trackID, deviceID int32) string {
 }
 
 func (d *DeviceAPI) GetType(trackID, deviceID int32) string {
-	msg := d.client.send("/live/device/get/type", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/type", trackID, deviceID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(string); ok {
 			return val
@@ -38,7 +38,7 @@ func (d *DeviceAPI) GetType(trackID, deviceID int32) string {
 }
 
 func (d *DeviceAPI) GetNumParameters(trackID, deviceID int32) int32 {
-	msg := d.client.send("/live/device/get/num_parameters", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/num_parameters", trackID, deviceID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(int32); ok {
 			return val
@@ -48,7 +48,7 @@ func (d *DeviceAPI) GetNumParameters(trackID, deviceID int32) int32 {
 }
 
 func (d *DeviceAPI) GetParametersName(trackID, deviceID int32) []string {
-	msg := d.client.send("/live/device/get/parameters/name", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/parameters/name", trackID, deviceID)
 	names := make([]string, 0)
 	// Skip first two arguments which are track ID and device ID
 	for i := 2; i < len(msg.Arguments); i++ {
@@ -60,7 +60,7 @@ func (d *DeviceAPI) GetParametersName(trackID, deviceID int32) []string {
 }
 
 func (d *DeviceAPI) GetParametersValue(trackID, deviceID int32) []float32 {
-	msg := d.client.send("/live/device/get/parameters/value", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/parameters/value", trackID, deviceID)
 	values := make([]float32, 0)
 	// Skip first two arguments which are track ID and device ID
 	for i := 2; i < len(msg.Arguments); i++ {
@@ -72,7 +72,7 @@ func (d *DeviceAPI) GetParametersValue(trackID, deviceID int32) []float32 {
 }
 
 func (d *DeviceAPI) GetParametersMin(trackID, deviceID int32) []float32 {
-	msg := d.client.send("/live/device/get/parameters/min", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/parameters/min", trackID, deviceID)
 	mins := make([]float32, 0)
 	// Skip first two arguments which are track ID and device ID
 	for i := 2; i < len(msg.Arguments); i++ {
@@ -84,7 +84,7 @@ func (d *DeviceAPI) GetParametersMin(trackID, deviceID int32) []float32 {
 }
 
 func (d *DeviceAPI) GetParametersMax(trackID, deviceID int32) []float32 {
-	msg := d.client.send("/live/device/get/parameters/max", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/parameters/max", trackID, deviceID)
 	maxs := make([]float32, 0)
 	// Skip first two arguments which are track ID and device ID
 	for i := 2; i < len(msg.Arguments); i++ {
@@ -96,7 +96,7 @@ func (d *DeviceAPI) GetParametersMax(trackID, deviceID int32) []float32 {
 }
 
 func (d *DeviceAPI) GetParametersIsQuantized(trackID, deviceID int32) []bool {
-	msg := d.client.send("/live/device/get/parameters/is_quantized", trackID, deviceID).Wait()
+	msg := d.client.get("/live/device/get/parameters/is_quantized", trackID, deviceID)
 	quantized := make([]bool, 0)
 	// Skip first two arguments which are track ID and device ID
 	for i := 2; i < len(msg.Arguments); i++ {
@@ -108,7 +108,7 @@ func (d *DeviceAPI) GetParametersIsQuantized(trackID, deviceID int32) []bool {
 }
 
 func (d *DeviceAPI) GetParameterValue(trackID, deviceID, parameterID int32) float32 {
-	msg := d.client.send("/live/device/get/parameter/value", trackID, deviceID, parameterID).Wait()
+	msg := d.client.get("/live/device/get/parameter/value", trackID, deviceID, parameterID)
 	if len(msg.Arguments) >= 4 {
 		if val, ok := msg.Arguments[3].(float32); ok {
 			return val
@@ -119,7 +119,7 @@ func (d *DeviceAPI) GetParameterValue(trackID, deviceID, parameterID int32) floa
 
 // GetParameterValueString returns the value as a formatted display string (e.g., "50.0 Hz", "3.2 dB").
 func (d *DeviceAPI) GetParameterValueString(trackID, deviceID, parameterID int32) string {
-	msg := d.client.send("/live/device/get/parameter/value_string", trackID, deviceID, parameterID).Wait()
+	msg := d.client.get("/live/device/get/parameter/value_string", trackID, deviceID, parameterID)
 	if len(msg.Arguments) >= 4 {
 		if val, ok := msg.Arguments[3].(string); ok {
 			return val
@@ -22,7 +22,7 @@ func (s *SceneAPI) FireSelected() {
 // --- Property Getters ---
 
 func (s *SceneAPI) GetColor(sceneID int32) int32 {
-	msg := s.client.send("/live/scene/get/color", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/color", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -32,7 +32,7 @@ func (s *SceneAPI) GetColor(sceneID int32) int32 {
 }
 
 func (s *SceneAPI) GetColorIndex(sceneID int32) int32 {
-	msg := s.client.send("/live/scene/get/color_index", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/color_index", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -42,7 +42,7 @@ func (s *SceneAPI) GetColorIndex(sceneID int32) int32 {
 }
 
 func (s *SceneAPI) GetIsEmpty(sceneID int32) bool {
-	msg := s.client.send("/live/scene/get/is_empty", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/is_empty", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -52,7 +52,7 @@ func (s *SceneAPI) GetIsEmpty(sceneID int32) bool {
 }
 
 func (s *SceneAPI) GetIsTriggered(sceneID int32) bool {
-	msg := s.client.send("/live/scene/get/is_triggered", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/is_triggered", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -62,7 +62,7 @@ func (s *SceneAPI) GetIsTriggered(sceneID int32) bool {
 }
 
 func (s *SceneAPI) GetName(sceneID int32) string {
-	msg := s.client.send("/live/scene/get/name", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/name", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -72,7 +72,7 @@ func (s *SceneAPI) GetName(sceneID int32) string {
 }
 
 func (s *SceneAPI) GetTempo(sceneID int32) float32 {
-	msg := s.client.send("/live/scene/get/tempo", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/tempo", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -82,7 +82,7 @@ func (s *SceneAPI) GetTempo(sceneID int32) float32 {
 }
 
 func (s *SceneAPI) GetTempoEnabled(sceneID int32) bool {
-	msg := s.client.send("/live/scene/get/tempo_enabled", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/tempo_enabled", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -92,7 +92,7 @@ func (s *SceneAPI) GetTempoEnabled(sceneID int32) bool {
 }
 
 func (s *SceneAPI) GetTimeSignatureNumerator(sceneID int32) int32 {
-	msg := s.client.send("/live/scene/get/time_signature_numerator", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/time_signature_numerator", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -102,7 +102,7 @@ func (s *SceneAPI) GetTimeSignatureNumerator(sceneID int32) int32 {
 }
 
 func (s *SceneAPI) GetTimeSignatureDenominator(sceneID int32) int32 {
-	msg := s.client.send("/live/scene/get/time_signature_denominator", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/time_signature_denominator", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -112,7 +112,7 @@ func (s *SceneAPI) GetTimeSignatureDenominator(sceneID int32) int32 {
 }
 
 func (s *SceneAPI) GetTimeSignatureEnabled(sceneID int32) bool {
-	msg := s.client.send("/live/scene/get/time_signature_enabled", sceneID).Wait()
+	msg := s.client.get("/live/scene/get/time_signature_enabled", sceneID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
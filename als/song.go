@@ -1,6 +1,6 @@
 package als
 
-import "github.com/matt0792/ableton-ctrl/oscclient"
+import "github.com/hypebeast/go-osc/osc"
 
 // SongAPI provides methods for interacting with Ableton Live's Song API.
 type SongAPI struct {
@@ -100,7 +100,7 @@ func (s *SongAPI) Undo() {
 // --- Property Getters ---
 
 func (s *SongAPI) GetArrangementOverdub() bool {
-	msg := s.client.send("/live/song/get/arrangement_overdub").Wait()
+	msg := s.client.get("/live/song/get/arrangement_overdub")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -110,7 +110,7 @@ func (s *SongAPI) GetArrangementOverdub() bool {
 }
 
 func (s *SongAPI) GetBackToArranger() bool {
-	msg := s.client.send("/live/song/get/back_to_arranger").Wait()
+	msg := s.client.get("/live/song/get/back_to_arranger")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -120,7 +120,7 @@ func (s *SongAPI) GetBackToArranger() bool {
 }
 
 func (s *SongAPI) GetCanRedo() bool {
-	msg := s.client.send("/live/song/get/can_redo").Wait()
+	msg := s.client.get("/live/song/get/can_redo")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -130,7 +130,7 @@ func (s *SongAPI) GetCanRedo() bool {
 }
 
 func (s *SongAPI) GetCanUndo() bool {
-	msg := s.client.send("/live/song/get/can_undo").Wait()
+	msg := s.client.get("/live/song/get/can_undo")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -140,7 +140,7 @@ func (s *SongAPI) GetCanUndo() bool {
 }
 
 func (s *SongAPI) GetClipTriggerQuantization() int32 {
-	msg := s.client.send("/live/song/get/clip_trigger_quantization").Wait()
+	msg := s.client.get("/live/song/get/clip_trigger_quantization")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -150,7 +150,7 @@ func (s *SongAPI) GetClipTriggerQuantization() int32 {
 }
 
 func (s *SongAPI) GetCurrentSongTime() float32 {
-	msg := s.client.send("/live/song/get/current_song_time").Wait()
+	msg := s.client.get("/live/song/get/current_song_time")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -160,7 +160,7 @@ func (s *SongAPI) GetCurrentSongTime() float32 {
 }
 
 func (s *SongAPI) GetGrooveAmount() float32 {
-	msg := s.client.send("/live/song/get/groove_amount").Wait()
+	msg := s.client.get("/live/song/get/groove_amount")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -170,7 +170,7 @@ func (s *SongAPI) GetGrooveAmount() float32 {
 }
 
 func (s *SongAPI) GetIsPlaying() bool {
-	msg := s.client.send("/live/song/get/is_playing").Wait()
+	msg := s.client.get("/live/song/get/is_playing")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -180,7 +180,7 @@ func (s *SongAPI) GetIsPlaying() bool {
 }
 
 func (s *SongAPI) GetLoop() bool {
-	msg := s.client.send("/live/song/get/loop").Wait()
+	msg := s.client.get("/live/song/get/loop")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -190,7 +190,7 @@ func (s *SongAPI) GetLoop() bool {
 }
 
 func (s *SongAPI) GetLoopLength() float32 {
-	msg := s.client.send("/live/song/get/loop_length").Wait()
+	msg := s.client.get("/live/song/get/loop_length")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -200,7 +200,7 @@ func (s *SongAPI) GetLoopLength() float32 {
 }
 
 func (s *SongAPI) GetLoopStart() float32 {
-	msg := s.client.send("/live/song/get/loop_start").Wait()
+	msg := s.client.get("/live/song/get/loop_start")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -210,7 +210,7 @@ func (s *SongAPI) GetLoopStart() float32 {
 }
 
 func (s *SongAPI) GetMetronome() bool {
-	msg := s.client.send("/live/song/get/metronome").Wait()
+	msg := s.client.get("/live/song/get/metronome")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -220,7 +220,7 @@ func (s *SongAPI) GetMetronome() bool {
 }
 
 func (s *SongAPI) GetMIDIRecordingQuantization() int32 {
-	msg := s.client.send("/live/song/get/midi_recording_quantization").Wait()
+	msg := s.client.get("/live/song/get/midi_recording_quantization")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -230,7 +230,7 @@ func (s *SongAPI) GetMIDIRecordingQuantization() int32 {
 }
 
 func (s *SongAPI) GetNudgeDown() bool {
-	msg := s.client.send("/live/song/get/nudge_down").Wait()
+	msg := s.client.get("/live/song/get/nudge_down")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -240,7 +240,7 @@ func (s *SongAPI) GetNudgeDown() bool {
 }
 
 func (s *SongAPI) GetNudgeUp() bool {
-	msg := s.client.send("/live/song/get/nudge_up").Wait()
+	msg := s.client.get("/live/song/get/nudge_up")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -250,7 +250,7 @@ func (s *SongAPI) GetNudgeUp() bool {
 }
 
 func (s *SongAPI) GetPunchIn() bool {
-	msg := s.client.send("/live/song/get/punch_in").Wait()
+	msg := s.client.get("/live/song/get/punch_in")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -260,7 +260,7 @@ func (s *SongAPI) GetPunchIn() bool {
 }
 
 func (s *SongAPI) GetPunchOut() bool {
-	msg := s.client.send("/live/song/get/punch_out").Wait()
+	msg := s.client.get("/live/song/get/punch_out")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -270,7 +270,7 @@ func (s *SongAPI) GetPunchOut() bool {
 }
 
 func (s *SongAPI) GetRecordMode() bool {
-	msg := s.client.send("/live/song/get/record_mode").Wait()
+	msg := s.client.get("/live/song/get/record_mode")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -280,7 +280,7 @@ func (s *SongAPI) GetRecordMode() bool {
 }
 
 func (s *SongAPI) GetSessionRecord() bool {
-	msg := s.client.send("/live/song/get/session_record").Wait()
+	msg := s.client.get("/live/song/get/session_record")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val != 0
@@ -290,7 +290,7 @@ func (s *SongAPI) GetSessionRecord() bool {
 }
 
 func (s *SongAPI) GetSessionRecordStatus() int32 {
-	msg := s.client.send("/live/song/get/session_record_status").Wait()
+	msg := s.client.get("/live/song/get/session_record_status")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -300,7 +300,7 @@ func (s *SongAPI) GetSessionRecordStatus() int32 {
 }
 
 func (s *SongAPI) GetSignatureDenominator() int32 {
-	msg := s.client.send("/live/song/get/signature_denominator").Wait()
+	msg := s.client.get("/live/song/get/signature_denominator")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -310,7 +310,7 @@ func (s *SongAPI) GetSignatureDenominator() int32 {
 }
 
 func (s *SongAPI) GetSignatureNumerator() int32 {
-	msg := s.client.send("/live/song/get/signature_numerator").Wait()
+	msg := s.client.get("/live/song/get/signature_numerator")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -320,7 +320,7 @@ func (s *SongAPI) GetSignatureNumerator() int32 {
 }
 
 func (s *SongAPI) GetSongLength() float32 {
-	msg := s.client.send("/live/song/get/song_length").Wait()
+	msg := s.client.get("/live/song/get/song_length")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -331,7 +331,7 @@ func (s *SongAPI) GetSongLength() float32 {
 
 // GetTempo returns the current tempo in BPM
 func (s *SongAPI) GetTempo() float32 {
-	msg := s.client.send("/live/song/get/tempo").Wait()
+	msg := s.client.get("/live/song/get/tempo")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(float32); ok {
 			return val
@@ -341,7 +341,7 @@ func (s *SongAPI) GetTempo() float32 {
 }
 
 func (s *SongAPI) GetNumScenes() int32 {
-	msg := s.client.send("/live/song/get/num_scenes").Wait()
+	msg := s.client.get("/live/song/get/num_scenes")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -351,7 +351,7 @@ func (s *SongAPI) GetNumScenes() int32 {
 }
 
 func (s *SongAPI) GetNumTracks() int32 {
-	msg := s.client.send("/live/song/get/num_tracks").Wait()
+	msg := s.client.get("/live/song/get/num_tracks")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -363,14 +363,15 @@ func (s *SongAPI) GetNumTracks() int32 {
 // GetTrackNames returns track names in the specified range
 // If no range is specified, returns all track names
 func (s *SongAPI) GetTrackNames(indexRange ...int32) []string {
-	var msg *oscclient.Call
+	var params []any
 	if len(indexRange) == 2 {
-		msg = s.client.send("/live/song/get/track_names", indexRange[0], indexRange[1])
-	} else {
-		msg = s.client.send("/live/song/get/track_names")
+		params = []any{indexRange[0], indexRange[1]}
+	}
+	// the reply is only names
+	result, err := s.client.osc.RequestEcho("/live/song/get/track_names", nil, params...)
+	if err != nil {
+		result = &osc.Message{}
 	}
-
-	result := msg.Wait()
 	names := make([]string, 0)
 	for _, arg := range result.Arguments {
 		if name, ok := arg.(string); ok {
@@ -516,3 +517,21 @@ func (s *SongAPI) StartListenBeat() {
 func (s *SongAPI) StopListenBeat() {
 	s.client.send("/live/song/stop_listen/beat")
 }
+
+// OnProperty calls fn with every update of a property started with
+// StartListenProperty, until the returned function is called.
+func (s *SongAPI) OnProperty(property string, fn func(msg *osc.Message)) (unsubscribe func()) {
+	return s.client.Subscribe("/live/song/get/"+property, fn)
+}
+
+// OnBeat calls fn with the beat number on every beat while listening with
+// StartListenBeat, until the returned function is called.
+func (s *SongAPI) OnBeat(fn func(beat int32)) (unsubscribe func()) {
+	return s.client.Subscribe("/live/song/get/beat", func(msg *osc.Message) {
+		if len(msg.Arguments) > 0 {
+			if val, ok := msg.Arguments[0].(int32); ok {
+				fn(val)
+			}
+		}
+	})
+}
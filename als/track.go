@@ -1,10 +1,17 @@
 package als
 
+import "github.com/hypebeast/go-osc/osc"
+
 // TrackAPI provides methods for interacting with Ableton Live's Track API.
 type TrackAPI struct {
 	client *Client
 }
 
+// Client returns the client the API sends through.
+func (t *TrackAPI) Client() *Client {
+	return t.client
+}
+
 // --- Methods ---
 
 func (t *TrackAPI) StopAllClips(trackID int32) {
@@ -14,7 +21,7 @@ func (t *TrackAPI) StopAllClips(trackID int32) {
 // --- Property Getters ---
 
 func (t *TrackAPI) GetArm(trackID int32) bool {
-	msg := t.client.send("/live/track/get/arm", trackID).Wait()
+	msg := t.client.get("/live/track/get/arm", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -24,7 +31,7 @@ func (t *TrackAPI) GetArm(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetAvailableInputRoutingChannels(trackID int32) []string {
-	msg := t.client.send("/live/track/get/available_input_routing_channels", trackID).Wait()
+	msg := t.client.get("/live/track/get/available_input_routing_channels", trackID)
 	channels := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -36,7 +43,7 @@ func (t *TrackAPI) GetAvailableInputRoutingChannels(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetAvailableInputRoutingTypes(trackID int32) []string {
-	msg := t.client.send("/live/track/get/available_input_routing_types", trackID).Wait()
+	msg := t.client.get("/live/track/get/available_input_routing_types", trackID)
 	types := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -48,7 +55,7 @@ func (t *TrackAPI) GetAvailableInputRoutingTypes(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetAvailableOutputRoutingChannels(trackID int32) []string {
-	msg := t.client.send("/live/track/get/available_output_routing_channels", trackID).Wait()
+	msg := t.client.get("/live/track/get/available_output_routing_channels", trackID)
 	channels := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -60,7 +67,7 @@ func (t *TrackAPI) GetAvailableOutputRoutingChannels(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetAvailableOutputRoutingTypes(trackID int32) []string {
-	msg := t.client.send("/live/track/get/available_output_routing_types", trackID).Wait()
+	msg := t.client.get("/live/track/get/available_output_routing_types", trackID)
 	types := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -72,7 +79,7 @@ func (t *TrackAPI) GetAvailableOutputRoutingTypes(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetCanBeArmed(trackID int32) bool {
-	msg := t.client.send("/live/track/get/can_be_armed", trackID).Wait()
+	msg := t.client.get("/live/track/get/can_be_armed", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -82,7 +89,7 @@ func (t *TrackAPI) GetCanBeArmed(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetColor(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/color", trackID).Wait()
+	msg := t.client.get("/live/track/get/color", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -92,7 +99,7 @@ func (t *TrackAPI) GetColor(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetColorIndex(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/color_index", trackID).Wait()
+	msg := t.client.get("/live/track/get/color_index", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -102,7 +109,7 @@ func (t *TrackAPI) GetColorIndex(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetCurrentMonitoringState(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/current_monitoring_state", trackID).Wait()
+	msg := t.client.get("/live/track/get/current_monitoring_state", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -112,7 +119,7 @@ func (t *TrackAPI) GetCurrentMonitoringState(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetFiredSlotIndex(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/fired_slot_index", trackID).Wait()
+	msg := t.client.get("/live/track/get/fired_slot_index", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -122,7 +129,7 @@ func (t *TrackAPI) GetFiredSlotIndex(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetFoldState(trackID int32) bool {
-	msg := t.client.send("/live/track/get/fold_state", trackID).Wait()
+	msg := t.client.get("/live/track/get/fold_state", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -132,7 +139,7 @@ func (t *TrackAPI) GetFoldState(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetHasAudioInput(trackID int32) bool {
-	msg := t.client.send("/live/track/get/has_audio_input", trackID).Wait()
+	msg := t.client.get("/live/track/get/has_audio_input", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -142,7 +149,7 @@ func (t *TrackAPI) GetHasAudioInput(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetHasAudioOutput(trackID int32) bool {
-	msg := t.client.send("/live/track/get/has_audio_output", trackID).Wait()
+	msg := t.client.get("/live/track/get/has_audio_output", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -152,7 +159,7 @@ func (t *TrackAPI) GetHasAudioOutput(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetHasMIDIInput(trackID int32) bool {
-	msg := t.client.send("/live/track/get/has_midi_input", trackID).Wait()
+	msg := t.client.get("/live/track/get/has_midi_input", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -162,7 +169,7 @@ func (t *TrackAPI) GetHasMIDIInput(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetHasMIDIOutput(trackID int32) bool {
-	msg := t.client.send("/live/track/get/has_midi_output", trackID).Wait()
+	msg := t.client.get("/live/track/get/has_midi_output", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -172,7 +179,7 @@ func (t *TrackAPI) GetHasMIDIOutput(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetInputRoutingChannel(trackID int32) string {
-	msg := t.client.send("/live/track/get/input_routing_channel", trackID).Wait()
+	msg := t.client.get("/live/track/get/input_routing_channel", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -182,7 +189,7 @@ func (t *TrackAPI) GetInputRoutingChannel(trackID int32) string {
 }
 
 func (t *TrackAPI) GetInputRoutingType(trackID int32) string {
-	msg := t.client.send("/live/track/get/input_routing_type", trackID).Wait()
+	msg := t.client.get("/live/track/get/input_routing_type", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -192,7 +199,7 @@ func (t *TrackAPI) GetInputRoutingType(trackID int32) string {
 }
 
 func (t *TrackAPI) GetOutputRoutingChannel(trackID int32) string {
-	msg := t.client.send("/live/track/get/output_routing_channel", trackID).Wait()
+	msg := t.client.get("/live/track/get/output_routing_channel", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -202,7 +209,7 @@ func (t *TrackAPI) GetOutputRoutingChannel(trackID int32) string {
 }
 
 func (t *TrackAPI) GetOutputMeterLeft(trackID int32) float32 {
-	msg := t.client.send("/live/track/get/output_meter_left", trackID).Wait()
+	msg := t.client.get("/live/track/get/output_meter_left", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -212,7 +219,7 @@ func (t *TrackAPI) GetOutputMeterLeft(trackID int32) float32 {
 }
 
 func (t *TrackAPI) GetOutputMeterLevel(trackID int32) float32 {
-	msg := t.client.send("/live/track/get/output_meter_level", trackID).Wait()
+	msg := t.client.get("/live/track/get/output_meter_level", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -222,7 +229,7 @@ func (t *TrackAPI) GetOutputMeterLevel(trackID int32) float32 {
 }
 
 func (t *TrackAPI) GetOutputMeterRight(trackID int32) float32 {
-	msg := t.client.send("/live/track/get/output_meter_right", trackID).Wait()
+	msg := t.client.get("/live/track/get/output_meter_right", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -232,7 +239,7 @@ func (t *TrackAPI) GetOutputMeterRight(trackID int32) float32 {
 }
 
 func (t *TrackAPI) GetOutputRoutingType(trackID int32) string {
-	msg := t.client.send("/live/track/get/output_routing_type", trackID).Wait()
+	msg := t.client.get("/live/track/get/output_routing_type", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -242,7 +249,7 @@ func (t *TrackAPI) GetOutputRoutingType(trackID int32) string {
 }
 
 func (t *TrackAPI) GetIsFoldable(trackID int32) bool {
-	msg := t.client.send("/live/track/get/is_foldable", trackID).Wait()
+	msg := t.client.get("/live/track/get/is_foldable", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -252,7 +259,7 @@ func (t *TrackAPI) GetIsFoldable(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetIsGrouped(trackID int32) bool {
-	msg := t.client.send("/live/track/get/is_grouped", trackID).Wait()
+	msg := t.client.get("/live/track/get/is_grouped", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -262,7 +269,7 @@ func (t *TrackAPI) GetIsGrouped(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetIsVisible(trackID int32) bool {
-	msg := t.client.send("/live/track/get/is_visible", trackID).Wait()
+	msg := t.client.get("/live/track/get/is_visible", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -272,7 +279,7 @@ func (t *TrackAPI) GetIsVisible(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetMute(trackID int32) bool {
-	msg := t.client.send("/live/track/get/mute", trackID).Wait()
+	msg := t.client.get("/live/track/get/mute", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -282,7 +289,7 @@ func (t *TrackAPI) GetMute(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetName(trackID int32) string {
-	msg := t.client.send("/live/track/get/name", trackID).Wait()
+	msg := t.client.get("/live/track/get/name", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(string); ok {
 			return val
@@ -292,7 +299,7 @@ func (t *TrackAPI) GetName(trackID int32) string {
 }
 
 func (t *TrackAPI) GetPanning(trackID int32) float32 {
-	msg := t.client.send("/live/track/get/panning", trackID).Wait()
+	msg := t.client.get("/live/track/get/panning", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -302,7 +309,7 @@ func (t *TrackAPI) GetPanning(trackID int32) float32 {
 }
 
 func (t *TrackAPI) GetPlayingSlotIndex(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/playing_slot_index", trackID).Wait()
+	msg := t.client.get("/live/track/get/playing_slot_index", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -312,7 +319,7 @@ func (t *TrackAPI) GetPlayingSlotIndex(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetSend(trackID, sendID int32) float32 {
-	msg := t.client.send("/live/track/get/send", trackID, sendID).Wait()
+	msg := t.client.get("/live/track/get/send", trackID, sendID)
 	if len(msg.Arguments) >= 3 {
 		if val, ok := msg.Arguments[2].(float32); ok {
 			return val
@@ -322,7 +329,7 @@ func (t *TrackAPI) GetSend(trackID, sendID int32) float32 {
 }
 
 func (t *TrackAPI) GetSolo(trackID int32) bool {
-	msg := t.client.send("/live/track/get/solo", trackID).Wait()
+	msg := t.client.get("/live/track/get/solo", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val != 0
@@ -332,7 +339,7 @@ func (t *TrackAPI) GetSolo(trackID int32) bool {
 }
 
 func (t *TrackAPI) GetVolume(trackID int32) float32 {
-	msg := t.client.send("/live/track/get/volume", trackID).Wait()
+	msg := t.client.get("/live/track/get/volume", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(float32); ok {
 			return val
@@ -422,7 +429,7 @@ func (t *TrackAPI) SetVolume(trackID int32, volume float32) {
 // --- Clip Properties ---
 
 func (t *TrackAPI) GetClipsName(trackID int32) []string {
-	msg := t.client.send("/live/track/get/clips/name", trackID).Wait()
+	msg := t.client.get("/live/track/get/clips/name", trackID)
 	names := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -434,7 +441,7 @@ func (t *TrackAPI) GetClipsName(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetClipsLength(trackID int32) []float32 {
-	msg := t.client.send("/live/track/get/clips/length", trackID).Wait()
+	msg := t.client.get("/live/track/get/clips/length", trackID)
 	lengths := make([]float32, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -446,7 +453,7 @@ func (t *TrackAPI) GetClipsLength(trackID int32) []float32 {
 }
 
 func (t *TrackAPI) GetClipsColor(trackID int32) []int32 {
-	msg := t.client.send("/live/track/get/clips/color", trackID).Wait()
+	msg := t.client.get("/live/track/get/clips/color", trackID)
 	colors := make([]int32, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -458,7 +465,7 @@ func (t *TrackAPI) GetClipsColor(trackID int32) []int32 {
 }
 
 func (t *TrackAPI) GetArrangementClipsName(trackID int32) []string {
-	msg := t.client.send("/live/track/get/arrangement_clips/name", trackID).Wait()
+	msg := t.client.get("/live/track/get/arrangement_clips/name", trackID)
 	names := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -470,7 +477,7 @@ func (t *TrackAPI) GetArrangementClipsName(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetArrangementClipsLength(trackID int32) []float32 {
-	msg := t.client.send("/live/track/get/arrangement_clips/length", trackID).Wait()
+	msg := t.client.get("/live/track/get/arrangement_clips/length", trackID)
 	lengths := make([]float32, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -482,7 +489,7 @@ func (t *TrackAPI) GetArrangementClipsLength(trackID int32) []float32 {
 }
 
 func (t *TrackAPI) GetArrangementClipsStartTime(trackID int32) []float32 {
-	msg := t.client.send("/live/track/get/arrangement_clips/start_time", trackID).Wait()
+	msg := t.client.get("/live/track/get/arrangement_clips/start_time", trackID)
 	startTimes := make([]float32, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -496,7 +503,7 @@ func (t *TrackAPI) GetArrangementClipsStartTime(trackID int32) []float32 {
 // --- Device Properties ---
 
 func (t *TrackAPI) GetNumDevices(trackID int32) int32 {
-	msg := t.client.send("/live/track/get/num_devices", trackID).Wait()
+	msg := t.client.get("/live/track/get/num_devices", trackID)
 	if len(msg.Arguments) >= 2 {
 		if val, ok := msg.Arguments[1].(int32); ok {
 			return val
@@ -506,7 +513,7 @@ func (t *TrackAPI) GetNumDevices(trackID int32) int32 {
 }
 
 func (t *TrackAPI) GetDevicesName(trackID int32) []string {
-	msg := t.client.send("/live/track/get/devices/name", trackID).Wait()
+	msg := t.client.get("/live/track/get/devices/name", trackID)
 	names := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -518,7 +525,7 @@ func (t *TrackAPI) GetDevicesName(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetDevicesType(trackID int32) []string {
-	msg := t.client.send("/live/track/get/devices/type", trackID).Wait()
+	msg := t.client.get("/live/track/get/devices/type", trackID)
 	types := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -530,7 +537,7 @@ func (t *TrackAPI) GetDevicesType(trackID int32) []string {
 }
 
 func (t *TrackAPI) GetDevicesClassName(trackID int32) []string {
-	msg := t.client.send("/live/track/get/devices/class_name", trackID).Wait()
+	msg := t.client.get("/live/track/get/devices/class_name", trackID)
 	classNames := make([]string, 0)
 	// Skip first argument which is track ID
 	for i := 1; i < len(msg.Arguments); i++ {
@@ -550,3 +557,16 @@ func (t *TrackAPI) StartListenProperty(trackIndex int32, property string) {
 func (t *TrackAPI) StopListenProperty(trackIndex int32, property string) {
 	t.client.send("/live/track/stop_listen/"+property, trackIndex)
 }
+
+// OnProperty calls fn with every update of a track property started with
+// StartListenProperty, until the returned function is called. Updates for
+// other tracks are ignored.
+func (t *TrackAPI) OnProperty(trackIndex int32, property string, fn func(msg *osc.Message)) (unsubscribe func()) {
+	return t.client.Subscribe("/live/track/get/"+property, func(msg *osc.Message) {
+		if len(msg.Arguments) > 0 {
+			if val, ok := msg.Arguments[0].(int32); ok && val == trackIndex {
+				fn(msg)
+			}
+		}
+	})
+}
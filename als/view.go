@@ -8,7 +8,7 @@ type ViewAPI struct {
 // --- Property Getters ---
 
 func (v *ViewAPI) GetSelectedScene() int32 {
-	msg := v.client.send("/live/view/get/selected_scene").Wait()
+	msg := v.client.get("/live/view/get/selected_scene")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -18,7 +18,7 @@ func (v *ViewAPI) GetSelectedScene() int32 {
 }
 
 func (v *ViewAPI) GetSelectedTrack() int32 {
-	msg := v.client.send("/live/view/get/selected_track").Wait()
+	msg := v.client.get("/live/view/get/selected_track")
 	if len(msg.Arguments) > 0 {
 		if val, ok := msg.Arguments[0].(int32); ok {
 			return val
@@ -28,7 +28,7 @@ func (v *ViewAPI) GetSelectedTrack() int32 {
 }
 
 func (v *ViewAPI) GetSelectedClip() (trackIndex, sceneIndex int32) {
-	msg := v.client.send("/live/view/get/selected_clip").Wait()
+	msg := v.client.get("/live/view/get/selected_clip")
 	if len(msg.Arguments) >= 2 {
 		if track, ok := msg.Arguments[0].(int32); ok {
 			trackIndex = track
@@ -41,7 +41,7 @@ func (v *ViewAPI) GetSelectedClip() (trackIndex, sceneIndex int32) {
 }
 
 func (v *ViewAPI) GetSelectedDevice() (trackIndex, deviceIndex int32) {
-	msg := v.client.send("/live/view/get/selected_device").Wait()
+	msg := v.client.get("/live/view/get/selected_device")
 	if len(msg.Arguments) >= 2 {
 		if track, ok := msg.Arguments[0].(int32); ok {
 			trackIndex = track
@@ -0,0 +1,276 @@
+package arp
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/theory"
+)
+
+// Mode is the order notes are played in.
+type Mode int
+
+const (
+	Up Mode = iota
+	Down
+	UpDown
+	DownUp
+	Random
+	AsPlayed
+)
+
+const DefaultVelocity int32 = 100
+
+type Opts struct {
+	Mode    Mode
+	Octaves int     // octave range, 1 plays the chord as held
+	Rate    float32 // beats per step, 0.25 when unset
+	Gate    float32 // note length as a fraction of a step, 1 when unset
+	Latch   bool    // keep playing the last chord through gaps
+	Seed    uint64  // seed for Random
+}
+
+// Chord is a set of notes held together from Start for Length beats.
+// Notes are in the order they were played.
+type Chord struct {
+	Notes  []als.Note
+	Start  float32
+	Length float32
+}
+
+// Held groups clip notes into the chords held over time. A new chord starts
+// whenever a note starts or ends.
+func Held(notes []als.Note) []Chord {
+	active := make([]als.Note, 0, len(notes))
+	for _, n := range notes {
+		if !n.Mute && n.Duration > 0 {
+			active = append(active, n)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		if active[i].StartTime != active[j].StartTime {
+			return active[i].StartTime < active[j].StartTime
+		}
+		return active[i].Pitch < active[j].Pitch
+	})
+
+	times := make([]float32, 0, len(active)*2)
+	for _, n := range active {
+		times = append(times, n.StartTime, n.StartTime+n.Duration)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	chords := make([]Chord, 0)
+	for i := 0; i+1 < len(times); i++ {
+		from, to := times[i], times[i+1]
+		if to <= from {
+			continue
+		}
+
+		held := make([]als.Note, 0)
+		for _, n := range active {
+			if n.StartTime <= from && n.StartTime+n.Duration > from {
+				held = append(held, n)
+			}
+		}
+		if len(held) == 0 {
+			continue
+		}
+
+		// extend the previous chord if nothing changed
+		if last := len(chords) - 1; last >= 0 && chords[last].Start+chords[last].Length == from && samePitches(chords[last].Notes, held) {
+			chords[last].Length += to - from
+			continue
+		}
+		chords = append(chords, Chord{Notes: held, Start: from, Length: to - from})
+	}
+
+	return chords
+}
+
+// FromSymbols builds chords from a progression of chord symbols, each held
+// for length beats. Chords are voiced in octave 3.
+func FromSymbols(progression string, length float32) ([]Chord, error) {
+	parsed, err := theory.ParseChords(progression)
+	if err != nil {
+		return nil, err
+	}
+
+	chords := make([]Chord, 0, len(parsed))
+	for i, c := range parsed {
+		start := float32(i) * length
+		chord := Chord{Start: start, Length: length}
+		for _, pitch := range c.Pitches(3) {
+			chord.Notes = append(chord.Notes, als.Note{
+				Pitch:     pitch,
+				StartTime: start,
+				Duration:  length,
+				Velocity:  DefaultVelocity,
+			})
+		}
+		chords = append(chords, chord)
+	}
+	return chords, nil
+}
+
+// Render arpeggiates chords. Steps fall on a grid of opts.Rate from the start
+// of the clip, and the pattern restarts with every chord.
+func Render(chords []Chord, opts Opts) []als.Note {
+	rate := opts.Rate
+	if rate <= 0 {
+		rate = 0.25
+	}
+	gate := opts.Gate
+	if gate <= 0 {
+		gate = 1
+	}
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+
+	notes := make([]als.Note, 0)
+	for i, c := range chords {
+		if len(c.Notes) == 0 {
+			continue
+		}
+
+		end := c.Start + c.Length
+		if opts.Latch && i+1 < len(chords) {
+			end = chords[i+1].Start
+		}
+
+		sequence := order(c.Notes, opts.Mode, opts.Octaves)
+		if len(sequence) == 0 {
+			continue
+		}
+		first := int(math.Ceil(float64(c.Start/rate) - 1e-6))
+		for step := first; float32(step)*rate < end-1e-6; step++ {
+			var n als.Note
+			if opts.Mode == Random {
+				n = sequence[rng.IntN(len(sequence))]
+			} else {
+				n = sequence[(step-first)%len(sequence)]
+			}
+
+			start := float32(step) * rate
+			duration := rate * gate
+			if start+duration > end {
+				duration = end - start
+			}
+			notes = append(notes, als.Note{
+				Pitch:     n.Pitch,
+				StartTime: start,
+				Duration:  duration,
+				Velocity:  n.Velocity,
+			})
+		}
+	}
+
+	return notes
+}
+
+// Arpeggiate renders chords into a clip.
+func Arpeggiate(api *als.ClipAPI, trackID, clipID int32, chords []Chord, opts Opts) {
+	api.AddNotes(trackID, clipID, Render(chords, opts)...)
+}
+
+// FromClip reads the chords held in a clip.
+func FromClip(api *als.ClipAPI, trackID, clipID int32) []Chord {
+	return Held(api.GetNotes(trackID, clipID))
+}
+
+// ArpeggiateByName renders chords into the clip named clip on the track
+// named track.
+func ArpeggiateByName(api *als.ClipAPI, r *resolve.Resolver, track, clip string, chords []Chord, opts Opts) error {
+	trackID, clipID, err := clipByName(r, track, clip)
+	if err != nil {
+		return err
+	}
+	Arpeggiate(api, trackID, clipID, chords, opts)
+	return nil
+}
+
+// FromClipByName reads the chords held in the clip named clip on the track
+// named track.
+func FromClipByName(api *als.ClipAPI, r *resolve.Resolver, track, clip string) ([]Chord, error) {
+	trackID, clipID, err := clipByName(r, track, clip)
+	if err != nil {
+		return nil, err
+	}
+	return FromClip(api, trackID, clipID), nil
+}
+
+func clipByName(r *resolve.Resolver, track, clip string) (int32, int32, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return 0, 0, err
+	}
+	clipID, err := r.Clip(trackID, clip)
+	return trackID, clipID, err
+}
+
+// order expands a chord over the octave range in the mode's order
+func order(held []als.Note, mode Mode, octaves int) []als.Note {
+	if octaves < 1 {
+		octaves = 1
+	}
+
+	base := append([]als.Note(nil), held...)
+	if mode != AsPlayed {
+		sort.SliceStable(base, func(i, j int) bool { return base[i].Pitch < base[j].Pitch })
+	}
+
+	up := make([]als.Note, 0, len(base)*octaves)
+	for o := 0; o < octaves; o++ {
+		for _, n := range base {
+			n.Pitch += int32(o * 12)
+			if n.Pitch <= 127 {
+				up = append(up, n)
+			}
+		}
+	}
+
+	switch mode {
+	case Down:
+		return reversed(up)
+	case UpDown:
+		return bounce(up)
+	case DownUp:
+		return bounce(reversed(up))
+	default:
+		return up
+	}
+}
+
+// bounce plays notes forwards then backwards without repeating the ends
+func bounce(notes []als.Note) []als.Note {
+	if len(notes) < 3 {
+		return notes
+	}
+	result := append([]als.Note(nil), notes...)
+	for i := len(notes) - 2; i > 0; i-- {
+		result = append(result, notes[i])
+	}
+	return result
+}
+
+func reversed(notes []als.Note) []als.Note {
+	result := make([]als.Note, len(notes))
+	for i, n := range notes {
+		result[len(notes)-1-i] = n
+	}
+	return result
+}
+
+func samePitches(a, b []als.Note) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Pitch != b[i].Pitch {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package arp
+
+import (
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pitches(notes []als.Note) []int32 {
+	result := make([]int32, len(notes))
+	for i, n := range notes {
+		result[i] = n.Pitch
+	}
+	return result
+}
+
+func chord(start, length float32, ps ...int32) Chord {
+	c := Chord{Start: start, Length: length}
+	for _, p := range ps {
+		c.Notes = append(c.Notes, als.Note{Pitch: p, StartTime: start, Duration: length, Velocity: 90})
+	}
+	return c
+}
+
+// TestModes verifies the note order of each mode
+func TestModes(t *testing.T) {
+	chords := []Chord{chord(0, 2, 64, 60, 67)}
+
+	tests := []struct {
+		mode     Mode
+		expected []int32
+	}{
+		{Up, []int32{60, 64, 67, 60, 64, 67, 60, 64}},
+		{Down, []int32{67, 64, 60, 67, 64, 60, 67, 64}},
+		{UpDown, []int32{60, 64, 67, 64, 60, 64, 67, 64}},
+		{DownUp, []int32{67, 64, 60, 64, 67, 64, 60, 64}},
+		{AsPlayed, []int32{64, 60, 67, 64, 60, 67, 64, 60}},
+	}
+
+	for _, tt := range tests {
+		notes := Render(chords, Opts{Mode: tt.mode})
+		assert.Equal(t, tt.expected, pitches(notes), "mode %d", tt.mode)
+	}
+}
+
+// TestOctavesRateGate verifies octave range, step rate and gate
+func TestOctavesRateGate(t *testing.T) {
+	notes := Render([]Chord{chord(0, 2, 60, 64)}, Opts{Octaves: 2, Rate: 0.5, Gate: 0.5})
+
+	assert.Equal(t, []int32{60, 64, 72, 76}, pitches(notes))
+	for i, n := range notes {
+		assert.Equal(t, float32(i)*0.5, n.StartTime)
+		assert.Equal(t, float32(0.25), n.Duration)
+		assert.Equal(t, int32(90), n.Velocity)
+	}
+}
+
+// TestLatch verifies latched chords play through gaps
+func TestLatch(t *testing.T) {
+	chords := []Chord{chord(0, 1, 60), chord(2, 1, 62)}
+
+	assert.Len(t, Render(chords, Opts{}), 8)
+
+	latched := Render(chords, Opts{Latch: true})
+	require.Len(t, latched, 12)
+	assert.Equal(t, int32(60), latched[7].Pitch)
+	assert.Equal(t, int32(62), latched[8].Pitch)
+}
+
+// TestRandom verifies random mode is seeded
+func TestRandom(t *testing.T) {
+	chords := []Chord{chord(0, 4, 60, 64, 67, 71)}
+
+	a := Render(chords, Opts{Mode: Random, Seed: 1})
+	assert.Equal(t, a, Render(chords, Opts{Mode: Random, Seed: 1}))
+	assert.NotEqual(t, a, Render(chords, Opts{Mode: Random, Seed: 2}))
+}
+
+// TestHeld verifies clip notes are grouped into held chords
+func TestHeld(t *testing.T) {
+	notes := []als.Note{
+		{Pitch: 60, StartTime: 0, Duration: 2, Velocity: 100},
+		{Pitch: 64, StartTime: 0, Duration: 2, Velocity: 100},
+		{Pitch: 67, StartTime: 1, Duration: 1, Velocity: 100},
+		{Pitch: 62, StartTime: 3, Duration: 1, Velocity: 100},
+		{Pitch: 50, StartTime: 3, Duration: 1, Velocity: 100, Mute: true},
+	}
+
+	chords := Held(notes)
+	require.Len(t, chords, 3)
+	assert.Equal(t, []int32{60, 64}, pitches(chords[0].Notes))
+	assert.Equal(t, float32(1), chords[0].Length)
+	assert.Equal(t, []int32{60, 64, 67}, pitches(chords[1].Notes))
+	assert.Equal(t, float32(1), chords[1].Start)
+	assert.Equal(t, []int32{62}, pitches(chords[2].Notes))
+	assert.Equal(t, float32(3), chords[2].Start)
+}
+
+// TestFromSymbols verifies chord symbols become held chords
+func TestFromSymbols(t *testing.T) {
+	chords, err := FromSymbols("Am C", 4)
+	require.NoError(t, err)
+	require.Len(t, chords, 2)
+	assert.Equal(t, []int32{57, 60, 64}, pitches(chords[0].Notes))
+	assert.Equal(t, float32(4), chords[1].Start)
+
+	_, err = FromSymbols("Am Q", 4)
+	assert.Error(t, err)
+}
+
+// TestArpeggiateByName verifies notes are written to the resolved clip, and
+// ambiguous names are rejected
+func TestArpeggiateByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Keys")
+	live.Set("/live/track/get/clips/name", int32(1), nil, "Chords", "Chords B")
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	err := ArpeggiateByName(client.Clip, r, "Keys", "Chords B", []Chord{chord(0, 1, 60)}, Opts{})
+	require.NoError(t, err)
+	msgs := live.WaitFor(t, "/live/clip/add/notes")
+	assert.Equal(t, []any{int32(1), int32(2)}, msgs[0].Arguments[:2])
+
+	err = ArpeggiateByName(client.Clip, r, "Keys", "Chords*", nil, Opts{})
+	assert.EqualError(t, err, `clip "Chords*" is ambiguous, it matches 1 "Chords", 2 "Chords B"`)
+}
@@ -1,37 +1,104 @@
+// Package clip reads and writes clips and their notes: names, gain, note
+// patterns and in-place edits such as Notes.Quantize and Notes.Transpose.
+//
+// An edit reads the notes, applies a transform.Func and writes back only
+// the notes that changed. AbletonOSC can't replace notes in one call, so
+// Live records the removals and additions as separate undo steps, and
+// undoing an edit in Live can take several of them. There is no undo of its
+// own; use Live's.
 package clip
 
 import (
+	"math"
+	"strings"
+	"sync"
+
 	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/groove"
+	"github.com/matt0792/ableton-ctrl/alsex/ident"
 	"github.com/matt0792/ableton-ctrl/alsex/note"
+	"github.com/matt0792/ableton-ctrl/alsex/pattern"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/theory"
 )
 
 type Clip struct {
-	api     *als.ClipAPI
-	trackID int32
-	clipID  int32
-	gain    *Gain
-	name    *Name
-	notes   *Notes
+	api   *als.ClipAPI
+	track *ident.Ref
+	scene *ident.Ref // the slot
+	gain  *Gain
+	name  *Name
+	notes *Notes
 }
 
 func New(client *als.Client, trackId, clipId int32) *Clip {
+	return NewTracked(client, ident.Fixed(trackId), ident.Fixed(clipId))
+}
+
+// NewTracked creates a Clip in the slot where track and scene cross, which
+// follows it as tracks and scenes are inserted and deleted, see
+// ident.Tracker. Once either is deleted, setters do nothing, getters return
+// zero values and Err reports it.
+func NewTracked(client *als.Client, track, scene *ident.Ref) *Clip {
 	c := &Clip{
-		api:     client.Clip,
-		trackID: trackId,
-		clipID:  clipId,
+		api:   client.Clip,
+		track: track,
+		scene: scene,
 	}
 	c.gain = &Gain{c}
 	c.name = &Name{c}
-	c.notes = &Notes{c}
+	c.notes = &Notes{Clip: c}
 	return c
 }
 
+// NewByName creates a Clip for the clip named clip on the track named track.
+// Names are resolved once, so the Clip stays on that slot if tracks or
+// scenes move; use NewTracked with refs from an ident.Tracker to follow it.
+func NewByName(client *als.Client, r *resolve.Resolver, track, clip string) (*Clip, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return nil, err
+	}
+	clipID, err := r.Clip(trackID, clip)
+	if err != nil {
+		return nil, err
+	}
+	return New(client, trackID, clipID), nil
+}
+
+// Err returns an error wrapping ident.ErrDeleted once the clip's track or
+// scene was deleted.
+func (c *Clip) Err() error {
+	_, _, err := c.index()
+	return err
+}
+
+func (c *Clip) index() (int32, int32, error) {
+	trackID, err := c.track.Index()
+	if err != nil {
+		return 0, 0, err
+	}
+	clipID, err := c.scene.Index()
+	return trackID, clipID, err
+}
+
+// id returns the clip's current track and slot, false once either was
+// deleted
+func (c *Clip) id() (int32, int32, bool) {
+	trackID, clipID, err := c.index()
+	return trackID, clipID, err == nil
+}
+
 func (c *Clip) Fire() {
-	c.api.Fire(c.trackID, c.clipID)
+	if trackID, clipID, ok := c.id(); ok {
+		c.api.Fire(trackID, clipID)
+	}
 }
 
 func (c *Clip) Stop() {
-	c.api.Stop(c.trackID, c.clipID)
+	if trackID, clipID, ok := c.id(); ok {
+		c.api.Stop(trackID, clipID)
+	}
 }
 
 // Gain
@@ -45,11 +112,17 @@ func (c *Clip) Gain() *Gain {
 }
 
 func (g *Gain) Get() float32 {
-	return g.api.GetGain(g.trackID, g.clipID)
+	trackID, clipID, ok := g.id()
+	if !ok {
+		return 0
+	}
+	return g.api.GetGain(trackID, clipID)
 }
 
 func (g *Gain) Set(value float32) {
-	g.api.SetGain(g.trackID, g.clipID, value)
+	if trackID, clipID, ok := g.id(); ok {
+		g.api.SetGain(trackID, clipID, value)
+	}
 }
 
 // Name
@@ -63,21 +136,33 @@ func (c *Clip) Name() *Name {
 }
 
 func (n *Name) Get() string {
-	return n.api.GetName(n.trackID, n.clipID)
+	trackID, clipID, ok := n.id()
+	if !ok {
+		return ""
+	}
+	return n.api.GetName(trackID, clipID)
 }
 
 func (n *Name) Set(value string) {
-	n.api.SetName(n.trackID, n.clipID, value)
+	if trackID, clipID, ok := n.id(); ok {
+		n.api.SetName(trackID, clipID, value)
+	}
 }
 
 func (c *Clip) Length() float32 {
-	return c.api.GetLength(c.trackID, c.clipID)
+	trackID, clipID, ok := c.id()
+	if !ok {
+		return 0
+	}
+	return c.api.GetLength(trackID, clipID)
 }
 
 // Notes
 
 type Notes struct {
 	*Clip
+
+	mu sync.Mutex // serializes transforms
 }
 
 func (c *Clip) Notes() *Notes {
@@ -85,14 +170,28 @@ func (c *Clip) Notes() *Notes {
 }
 
 func (n *Notes) Get() []als.Note {
-	return n.api.GetNotes(n.trackID, n.clipID)
+	trackID, clipID, ok := n.id()
+	if !ok {
+		return nil
+	}
+	return n.api.GetNotes(trackID, clipID)
+}
+
+// Add writes notes to the clip, keeping existing notes
+func (n *Notes) Add(notes ...als.Note) {
+	if trackID, clipID, ok := n.id(); ok {
+		n.api.AddNotes(trackID, clipID, notes...)
+	}
 }
 
 type NoteBuilder struct {
 	clip     *Clip
 	notes    []als.Note
+	steps    []float32 // start of each note in steps, chord tones share a step
+	lengths  []float32 // length of each note in steps
 	duration float32
 	velocity int32
+	seed     uint64
 }
 
 // Names creates notes from note names
@@ -115,17 +214,94 @@ func (n *Notes) Names(notes ...string) *NoteBuilder {
 			Velocity:  nb.velocity,
 			Mute:      false,
 		})
+		nb.steps = append(nb.steps, float32(i))
+		nb.lengths = append(nb.lengths, 1)
 	}
 
 	return nb
 }
 
-// Duration sets the duration for all notes
+// Chords creates block chords from a progression of chord symbols, one bar
+// each, e.g. "Am7 D7 Gmaj7". Invalid symbols are skipped. Successive chords
+// are voice led from the first, which is in root position in octave 3.
+func (n *Notes) Chords(progression string) *NoteBuilder {
+	nb := &NoteBuilder{
+		clip:     n.Clip,
+		notes:    make([]als.Note, 0),
+		duration: 4,
+		velocity: 100,
+	}
+
+	var prev []int32
+	for i, symbol := range strings.Fields(progression) {
+		chord, err := theory.ParseChord(symbol)
+		if err != nil {
+			continue
+		}
+
+		var pitches []int32
+		if prev == nil || chord.Bass >= 0 {
+			pitches = chord.Pitches(3)
+		} else {
+			pitches = theory.VoiceLead(prev, chord.Pitches(3))
+		}
+		prev = pitches
+
+		for _, pitch := range pitches {
+			nb.notes = append(nb.notes, als.Note{
+				Pitch:     pitch,
+				StartTime: float32(i) * nb.duration,
+				Duration:  nb.duration,
+				Velocity:  nb.velocity,
+			})
+			nb.steps = append(nb.steps, float32(i))
+			nb.lengths = append(nb.lengths, 1)
+		}
+	}
+
+	return nb
+}
+
+// Sequence creates notes from a mini-notation pattern, e.g.
+// "c3 [e3 g3] ~ <a3 b3>", rendering the given number of cycles one bar each.
+// See pattern.Parse for the syntax. Duration sets the length of a cycle.
+func (n *Notes) Sequence(src string, cycles int) (*NoteBuilder, error) {
+	p, err := pattern.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	nb := &NoteBuilder{
+		clip:     n.Clip,
+		notes:    make([]als.Note, 0),
+		duration: 4,
+		velocity: pattern.DefaultVelocity,
+	}
+
+	for c := 0; c < cycles; c++ {
+		for _, e := range p.Events(c) {
+			start := float32(c) + float32(e.Start)
+			length := float32(e.Duration)
+			nb.notes = append(nb.notes, als.Note{
+				Pitch:     e.Pitch,
+				StartTime: start * nb.duration,
+				Duration:  length * nb.duration,
+				Velocity:  nb.velocity,
+			})
+			nb.steps = append(nb.steps, start)
+			nb.lengths = append(nb.lengths, length)
+		}
+	}
+
+	return nb, nil
+}
+
+// Duration sets the length of a step in beats and retimes all notes
 func (nb *NoteBuilder) Duration(beats float32) *NoteBuilder {
 	nb.duration = beats
 	for i := range nb.notes {
-		nb.notes[i].Duration = beats
-		nb.notes[i].StartTime = float32(i) * beats
+		nb.notes[i].Duration = nb.lengths[i] * beats
+		nb.notes[i].StartTime = nb.steps[i] * beats
 	}
 	return nb
 }
@@ -139,7 +315,9 @@ func (nb *NoteBuilder) Velocity(vel int32) *NoteBuilder {
 	return nb
 }
 
-// Pattern adjusts note timing based on pattern type
+// Pattern adjusts note timing based on pattern type. Swing and humanize
+// range from 0 to 100, where swing 100 is 75% MPC swing on 16th notes, or on
+// 8th notes when no notes fall between them.
 func (nb *NoteBuilder) Pattern(patternType string, swing, humanize int) *NoteBuilder {
 	switch patternType {
 	case "straight":
@@ -157,29 +335,47 @@ func (nb *NoteBuilder) Pattern(patternType string, swing, humanize int) *NoteBui
 	return nb
 }
 
+// Seed sets the random seed used by Pattern's humanize
+func (nb *NoteBuilder) Seed(seed uint64) *NoteBuilder {
+	nb.seed = seed
+	return nb
+}
+
+// Humanize randomizes note timing, velocity and length
+func (nb *NoteBuilder) Humanize(opts groove.HumanizeOpts) *NoteBuilder {
+	nb.notes = groove.Humanize(nb.notes, opts)
+	return nb
+}
+
+// Groove applies a groove template, see groove.Groove.Apply
+func (nb *NoteBuilder) Groove(g *groove.Groove, amount float32) *NoteBuilder {
+	nb.notes = g.Apply(nb.notes, amount)
+	return nb
+}
+
+// applySwing swings the finest of 16ths and 8ths the notes use, shifting
+// notes rather than quantizing them so earlier timing changes are kept
 func (nb *NoteBuilder) applySwing(amount int) {
-	// delay every other note
-	swingFactor := float32(amount) / 100.0 * 0.1
-	for i := range nb.notes {
-		if i%2 == 1 {
-			nb.notes[i].StartTime += swingFactor
+	percent := 50 + float32(amount)*0.25
+	grid := float32(0.5)
+	for _, n := range nb.notes {
+		if int(math.Round(float64(n.StartTime/0.25)))%2 != 0 {
+			grid = 0.25
+			break
 		}
 	}
+	nb.notes = groove.Swing(percent, grid).Shift(nb.notes, 1)
 }
 
-func (nb NoteBuilder) applyHumanize(amount int) {
+func (nb *NoteBuilder) applyHumanize(amount int) {
 	factor := float32(amount) / 100.0
-	for i := range nb.notes {
-		// vary start time
-		variation := factor * 0.05
-		nb.notes[i].StartTime += variation * (float32(i%3) - 1) / 2
-
-		// vary velocity
-		velVariation := int32(float32(amount) * 0.2)
-		nb.notes[i].Velocity += velVariation * (int32(i%3) - 1)
-	}
+	nb.notes = groove.Humanize(nb.notes, groove.HumanizeOpts{
+		Seed:     nb.seed,
+		Timing:   factor * 0.05,
+		Velocity: float32(amount) * 0.2,
+	})
 }
 
 func (nb *NoteBuilder) Build() {
-	nb.clip.api.AddNotes(nb.clip.trackID, nb.clip.clipID, nb.notes...)
+	nb.clip.Notes().Add(nb.notes...)
 }
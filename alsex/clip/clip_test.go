@@ -0,0 +1,105 @@
+package clip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/groove"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/transform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func starts(notes []als.Note) []float32 {
+	times := make([]float32, len(notes))
+	for i, n := range notes {
+		times[i] = n.StartTime
+	}
+	return times
+}
+
+// TestSwing verifies swing follows the finest of 16ths and 8ths in the notes
+// and keeps earlier timing changes
+func TestSwing(t *testing.T) {
+	n := &Notes{}
+
+	sixteenths := n.Names("c3", "d3", "e3", "f3").Pattern("swing", 100, 0)
+	assert.InDeltaSlice(t, []float32{0, 0.375, 0.5, 0.875}, starts(sixteenths.notes), 1e-6)
+
+	eighths := n.Names("c3", "d3", "e3", "f3").Duration(0.5).Pattern("swing", 100, 0)
+	assert.InDeltaSlice(t, []float32{0, 0.75, 1, 1.75}, starts(eighths.notes), 1e-6)
+
+	late := groove.Groove{Grid: 0.5, Timing: []float32{0.02}, Velocity: []float32{1}}
+	grooved := n.Names("c3", "d3").Duration(0.5).Groove(&late, 1).Pattern("swing", 100, 0)
+	assert.InDeltaSlice(t, []float32{0.02, 0.77}, starts(grooved.notes), 1e-6)
+}
+
+// TestTransform verifies a transform writes back only the changed notes
+func TestTransform(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/clip/get/notes", int32(0), int32(1),
+		int32(60), float32(0), float32(1), int32(100), int32(0))
+	n := New(live.Client(t), 0, 1).Notes()
+
+	transposed := n.Transpose(2)
+	assert.Equal(t, int32(62), transposed[0].Pitch)
+	added := live.WaitFor(t, "/live/clip/add/notes")
+	assert.Equal(t, []any{int32(0), int32(1), int32(62), float32(0), float32(1), int32(100), int32(0)}, added[0].Arguments)
+	removed := live.WaitFor(t, "/live/clip/remove/notes")
+	assert.Equal(t, int32(60), removed[0].Arguments[2])
+
+	// nothing changes, so nothing is sent
+	n.Transpose(0)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, live.Received("/live/clip/add/notes"), 1)
+}
+
+// TestSync verifies Sync removes and adds only the notes that differ, even
+// with no tolerance
+func TestSync(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/clip/get/notes", int32(0), int32(1),
+		int32(60), float32(0), float32(1), int32(100), int32(0),
+		int32(64), float32(1), float32(1), int32(100), int32(0))
+	n := New(live.Client(t), 0, 1).Notes()
+
+	change := n.Sync([]als.Note{
+		{Pitch: 60, StartTime: 0, Duration: 1, Velocity: 100},
+		{Pitch: 67, StartTime: 1, Duration: 1, Velocity: 100},
+	}, 0)
+	assert.Len(t, change.Remove, 1)
+	assert.Len(t, change.Add, 1)
+
+	removed := live.WaitFor(t, "/live/clip/remove/notes")
+	assert.Len(t, removed, 1)
+	args := removed[0].Arguments
+	assert.Equal(t, []any{int32(0), int32(1), int32(64), int32(1)}, args[:4])
+	assert.InDelta(t, 1-transform.DefaultTolerance, args[4], 1e-6)
+	assert.InDelta(t, 2*transform.DefaultTolerance, args[5], 1e-6)
+
+	added := live.WaitFor(t, "/live/clip/add/notes")
+	assert.Len(t, added, 1)
+	assert.Equal(t, []any{int32(0), int32(1), int32(67), float32(1), float32(1), int32(100), int32(0)}, added[0].Arguments)
+}
+
+// TestNewByName verifies the resolved slot is the one sent, and ambiguous
+// names are rejected
+func TestNewByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	live.Set("/live/track/get/clips/name", int32(1), nil, "Bassline", "Bassline B")
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	c, err := NewByName(client, r, "Bass", "Bassline B")
+	require.NoError(t, err)
+	c.Name().Set("Sub")
+	msgs := live.WaitFor(t, "/live/clip/set/name")
+	assert.Equal(t, []any{int32(1), int32(2), "Sub"}, msgs[0].Arguments)
+
+	_, err = NewByName(client, r, "Bass", "Bassline*")
+	assert.EqualError(t, err, `clip "Bassline*" is ambiguous, it matches 1 "Bassline", 2 "Bassline B"`)
+}
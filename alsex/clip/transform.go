@@ -0,0 +1,113 @@
+package clip
+
+import (
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/theory"
+	"github.com/matt0792/ableton-ctrl/alsex/transform"
+)
+
+// Transform replaces the clip's notes with fn applied to them. Live sees
+// the edit as separate removals and additions rather than one undo step,
+// see the package doc.
+func (n *Notes) Transform(fn transform.Func) []als.Note {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	before := n.Get()
+	after := fn(before)
+	n.replace(before, after)
+	return after
+}
+
+// Sync updates the clip to hold exactly the desired notes, removing and
+// adding only the notes that differ. Timing within tolerance beats counts as
+// unchanged, so regenerating a clip every bar doesn't retrigger held notes.
+// Notes are removed by a window of twice the tolerance around their start,
+// so tolerances below transform.DefaultTolerance use it instead.
+func (n *Notes) Sync(desired []als.Note, tolerance float32) transform.Change {
+	tolerance = max(tolerance, transform.DefaultTolerance)
+	change := transform.Diff(n.Get(), desired, tolerance)
+	n.apply(change, tolerance)
+	return change
+}
+
+func (n *Notes) apply(change transform.Change, tolerance float32) {
+	trackID, clipID, ok := n.id()
+	if !ok {
+		return
+	}
+	for _, note := range change.Remove {
+		start := note.StartTime - tolerance
+		n.api.RemoveNotes(trackID, clipID, note.Pitch, 1, start, 2*tolerance)
+	}
+	if len(change.Add) > 0 {
+		n.api.AddNotes(trackID, clipID, change.Add...)
+	}
+}
+
+// Quantize moves notes towards a grid in beats, with strength from 0 to 1
+func (n *Notes) Quantize(grid, strength float32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.Quantize(notes, grid, strength)
+	})
+}
+
+// Transpose shifts notes by semitones
+func (n *Notes) Transpose(semitones int32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.Transpose(notes, semitones)
+	})
+}
+
+// TransposeScale shifts notes by degrees of a scale
+func (n *Notes) TransposeScale(scale *theory.Scale, degrees int) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.TransposeScale(notes, scale, degrees)
+	})
+}
+
+// Reverse plays the clip's notes backwards over the clip length
+func (n *Notes) Reverse() []als.Note {
+	length := n.Length()
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.Reverse(notes, length)
+	})
+}
+
+// Stretch scales note timing by factor, e.g. 0.5 for double time
+func (n *Notes) Stretch(factor float32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.Stretch(notes, factor)
+	})
+}
+
+// Legato extends notes to the start of the next note
+func (n *Notes) Legato() []als.Note {
+	return n.Transform(transform.Legato)
+}
+
+// ScaleVelocity multiplies velocities by factor
+func (n *Notes) ScaleVelocity(factor float32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.ScaleVelocity(notes, factor)
+	})
+}
+
+// CompressVelocity reduces velocities above threshold by ratio
+func (n *Notes) CompressVelocity(threshold int32, ratio float32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.CompressVelocity(notes, threshold, ratio)
+	})
+}
+
+// Invert mirrors pitches around axis
+func (n *Notes) Invert(axis int32) []als.Note {
+	return n.Transform(func(notes []als.Note) []als.Note {
+		return transform.Invert(notes, axis)
+	})
+}
+
+// replace writes notes over old, touching only the notes that changed
+func (n *Notes) replace(old, notes []als.Note) {
+	n.apply(transform.Diff(old, notes, transform.DefaultTolerance), transform.DefaultTolerance)
+}
@@ -0,0 +1,39 @@
+package drum
+
+// FirstPad is the pitch of the bottom left pad of a Drum Rack.
+const FirstPad int32 = 36
+
+// PadMap maps drum names to pad pitches.
+type PadMap map[string]int32
+
+// DefaultPads follows the General MIDI drum map used by Live's Drum Rack
+// presets.
+var DefaultPads = PadMap{
+	"kick":      36,
+	"rim":       37,
+	"snare":     38,
+	"clap":      39,
+	"snare2":    40,
+	"lowtom":    41,
+	"closedhat": 42,
+	"hightom":   43,
+	"pedalhat":  44,
+	"midtom":    45,
+	"openhat":   46,
+	"lowmidtom": 47,
+	"himidtom":  48,
+	"crash":     49,
+	"tom":       50,
+	"ride":      51,
+}
+
+// Pad returns the pitch of the nth pad, counting from FirstPad.
+func Pad(index int) int32 {
+	return FirstPad + int32(index)
+}
+
+// Pitch looks up a drum by name, returning false if it isn't mapped.
+func (m PadMap) Pitch(name string) (int32, bool) {
+	pitch, ok := m[name]
+	return pitch, ok
+}
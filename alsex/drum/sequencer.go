@@ -0,0 +1,256 @@
+package drum
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/clip"
+	"github.com/matt0792/ableton-ctrl/alsex/pattern"
+)
+
+const (
+	DefaultVelocity int32 = 100
+	AccentVelocity  int32 = 127
+)
+
+// Sequencer is a step sequencer with one lane per drum pad.
+type Sequencer struct {
+	steps      int
+	stepLength float32
+	gate       float32
+	seed       uint64
+	pads       PadMap
+	lanes      []*Lane
+}
+
+// Lane is a row of steps playing a single pad. Velocities and
+// probabilities are per step lanes, repeating if shorter than the lane.
+type Lane struct {
+	Pitch         int32
+	Hits          []bool
+	Velocities    []int32
+	Probabilities []float32
+}
+
+// New creates a sequencer with the given number of 16th note steps.
+func New(steps int) *Sequencer {
+	return &Sequencer{
+		steps:      steps,
+		stepLength: 0.25,
+		gate:       1,
+		pads:       DefaultPads,
+	}
+}
+
+// Pads sets the map used to look up drums by name.
+func (s *Sequencer) Pads(pads PadMap) *Sequencer {
+	s.pads = pads
+	return s
+}
+
+// Pitch returns the pad pitch of a named drum, e.g. "kick".
+func (s *Sequencer) Pitch(name string) (int32, error) {
+	pitch, ok := s.pads.Pitch(name)
+	if !ok {
+		return 0, fmt.Errorf("unknown drum: %s", name)
+	}
+	return pitch, nil
+}
+
+// StepLength sets the length of a step in beats.
+func (s *Sequencer) StepLength(beats float32) *Sequencer {
+	s.stepLength = beats
+	return s
+}
+
+// Gate sets note length as a fraction of the step length.
+func (s *Sequencer) Gate(gate float32) *Sequencer {
+	s.gate = gate
+	return s
+}
+
+// Seed sets the random seed used for step probabilities.
+func (s *Sequencer) Seed(seed uint64) *Sequencer {
+	s.seed = seed
+	return s
+}
+
+// Lanes returns the sequencer's lanes.
+func (s *Sequencer) Lanes() []*Lane {
+	return s.lanes
+}
+
+// Euclid adds a lane with hits spread evenly over the steps, rotated left
+// by rotation steps.
+func (s *Sequencer) Euclid(pitch int32, hits, rotation int) *Lane {
+	return s.add(&Lane{Pitch: pitch, Hits: pattern.Euclid(hits, s.steps, rotation)})
+}
+
+// Grid adds a lane from a step string, one character per step, repeating to
+// fill the sequencer:
+//
+//	x  hit
+//	X  accented hit
+//	?  hit with 50% probability
+//	.  rest (also - and _)
+//
+// Whitespace and | may be used to group steps.
+func (s *Sequencer) Grid(pitch int32, steps string) (*Lane, error) {
+	hits := make([]bool, 0, s.steps)
+	velocities := make([]int32, 0, s.steps)
+	probabilities := make([]float32, 0, s.steps)
+
+	for _, r := range steps {
+		velocity, probability := DefaultVelocity, float32(1)
+		switch r {
+		case 'x':
+		case 'X':
+			velocity = AccentVelocity
+		case '?':
+			probability = 0.5
+		case '.', '-', '_':
+			hits = append(hits, false)
+			velocities = append(velocities, DefaultVelocity)
+			probabilities = append(probabilities, 1)
+			continue
+		case ' ', '|', '\t', '\n':
+			continue
+		default:
+			return nil, fmt.Errorf("invalid step %q", r)
+		}
+		hits = append(hits, true)
+		velocities = append(velocities, velocity)
+		probabilities = append(probabilities, probability)
+	}
+	if len(hits) == 0 {
+		return nil, fmt.Errorf("empty grid")
+	}
+
+	return s.add(&Lane{
+		Pitch:         pitch,
+		Hits:          repeat(hits, s.steps),
+		Velocities:    repeat(velocities, s.steps),
+		Probabilities: repeat(probabilities, s.steps),
+	}), nil
+}
+
+// Random adds a lane where every step plays with the given probability.
+func (s *Sequencer) Random(pitch int32, probability float32) *Lane {
+	l := &Lane{Pitch: pitch, Hits: make([]bool, s.steps), Probabilities: []float32{probability}}
+	for i := range l.Hits {
+		l.Hits[i] = true
+	}
+	return s.add(l)
+}
+
+func (s *Sequencer) add(l *Lane) *Lane {
+	s.lanes = append(s.lanes, l)
+	return l
+}
+
+// Rotate shifts the lane's steps left by n, or right when negative.
+func (l *Lane) Rotate(n int) *Lane {
+	l.Hits = rotate(l.Hits, n)
+	l.Velocities = rotate(l.Velocities, n)
+	l.Probabilities = rotate(l.Probabilities, n)
+	return l
+}
+
+// Velocity sets the velocity lane, repeating if shorter than the lane.
+func (l *Lane) Velocity(velocities ...int32) *Lane {
+	l.Velocities = velocities
+	return l
+}
+
+// Probability sets the probability lane, repeating if shorter than the lane.
+func (l *Lane) Probability(probabilities ...float32) *Lane {
+	l.Probabilities = probabilities
+	return l
+}
+
+// Accent raises the velocity of the steps marked in accents to velocity.
+// Unmarked steps keep their current velocity.
+func (l *Lane) Accent(accents []bool, velocity int32) *Lane {
+	if len(accents) == 0 {
+		return l
+	}
+
+	velocities := make([]int32, len(l.Hits))
+	for i := range velocities {
+		velocities[i] = l.velocity(i)
+		if accents[i%len(accents)] {
+			velocities[i] = velocity
+		}
+	}
+	l.Velocities = velocities
+	return l
+}
+
+func (l *Lane) velocity(step int) int32 {
+	if len(l.Velocities) == 0 {
+		return DefaultVelocity
+	}
+	return l.Velocities[step%len(l.Velocities)]
+}
+
+func (l *Lane) probability(step int) float32 {
+	if len(l.Probabilities) == 0 {
+		return 1
+	}
+	return l.Probabilities[step%len(l.Probabilities)]
+}
+
+// Notes renders the given number of repeats of the sequence. Probabilities
+// are rolled per step and repeat, using the sequencer's seed.
+func (s *Sequencer) Notes(repeats int) []als.Note {
+	rng := rand.New(rand.NewPCG(s.seed, s.seed))
+	notes := make([]als.Note, 0)
+
+	for r := 0; r < repeats; r++ {
+		for step := 0; step < s.steps; step++ {
+			for _, l := range s.lanes {
+				if len(l.Hits) == 0 || !l.Hits[step%len(l.Hits)] {
+					continue
+				}
+				if p := l.probability(step); p < 1 && rng.Float32() >= p {
+					continue
+				}
+				notes = append(notes, als.Note{
+					Pitch:     l.Pitch,
+					StartTime: float32(r*s.steps+step) * s.stepLength,
+					Duration:  s.stepLength * s.gate,
+					Velocity:  l.velocity(step),
+				})
+			}
+		}
+	}
+
+	return notes
+}
+
+// Length returns the length of one pass of the sequence in beats.
+func (s *Sequencer) Length() float32 {
+	return float32(s.steps) * s.stepLength
+}
+
+// Write adds the rendered sequence to a clip.
+func (s *Sequencer) Write(c *clip.Clip, repeats int) {
+	c.Notes().Add(s.Notes(repeats)...)
+}
+
+func repeat[T any](v []T, n int) []T {
+	result := make([]T, n)
+	for i := range result {
+		result[i] = v[i%len(v)]
+	}
+	return result
+}
+
+func rotate[T any](v []T, n int) []T {
+	if len(v) == 0 {
+		return v
+	}
+	r := ((n % len(v)) + len(v)) % len(v)
+	return append(append([]T{}, v[r:]...), v[:r]...)
+}
@@ -0,0 +1,93 @@
+package drum
+
+import (
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func starts(notes []als.Note, pitch int32) []float32 {
+	var result []float32
+	for _, n := range notes {
+		if n.Pitch == pitch {
+			result = append(result, n.StartTime)
+		}
+	}
+	return result
+}
+
+// TestEuclid verifies euclidean lanes and rotation
+func TestEuclid(t *testing.T) {
+	s := New(8)
+	s.Euclid(Pad(0), 3, 0)
+	s.Euclid(Pad(2), 3, 0).Rotate(-2)
+
+	notes := s.Notes(1)
+	assert.Equal(t, []float32{0, 0.75, 1.5}, starts(notes, 36))
+	assert.Equal(t, []float32{0, 0.5, 1.25}, starts(notes, 38))
+}
+
+// TestGrid verifies step strings, accents and repeats
+func TestGrid(t *testing.T) {
+	s := New(16)
+	kick, err := s.Pitch("kick")
+	require.NoError(t, err)
+
+	_, err = s.Grid(kick, "X... x...")
+	require.NoError(t, err)
+
+	notes := s.Notes(2)
+	require.Len(t, notes, 8)
+	assert.Equal(t, []float32{0, 1, 2, 3, 4, 5, 6, 7}, starts(notes, kick))
+	assert.Equal(t, AccentVelocity, notes[0].Velocity)
+	assert.Equal(t, DefaultVelocity, notes[1].Velocity)
+	assert.Equal(t, float32(0.25), notes[0].Duration)
+
+	_, err = s.Grid(kick, "x.y.")
+	assert.Error(t, err)
+	_, err = s.Grid(kick, "| |")
+	assert.Error(t, err)
+	_, err = s.Pitch("cowbell")
+	assert.Error(t, err)
+}
+
+// TestVelocityLane verifies per step velocities and accents
+func TestVelocityLane(t *testing.T) {
+	s := New(4)
+	s.Euclid(42, 4, 0).Velocity(100, 60).Accent([]bool{false, false, false, true}, 120)
+
+	var velocities []int32
+	for _, n := range s.Notes(1) {
+		velocities = append(velocities, n.Velocity)
+	}
+	assert.Equal(t, []int32{100, 60, 100, 120}, velocities)
+}
+
+// TestProbability verifies probabilities are seeded and roughly respected
+func TestProbability(t *testing.T) {
+	render := func(seed uint64) []als.Note {
+		s := New(16).Seed(seed)
+		s.Random(42, 0.5)
+		return s.Notes(8)
+	}
+
+	a := render(7)
+	assert.Equal(t, a, render(7))
+	assert.NotEqual(t, a, render(8))
+	assert.InDelta(t, 64, len(a), 20)
+
+	s := New(16)
+	s.Euclid(36, 4, 0).Probability(0)
+	assert.Empty(t, s.Notes(4))
+}
+
+// TestPads verifies custom pad maps
+func TestPads(t *testing.T) {
+	s := New(4).Pads(PadMap{"kick": 48})
+	pitch, err := s.Pitch("kick")
+	require.NoError(t, err)
+	assert.Equal(t, int32(48), pitch)
+	assert.Equal(t, float32(1), s.Length())
+}
@@ -0,0 +1,217 @@
+package duck
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/alsex/meter"
+	"github.com/matt0792/ableton-ctrl/alsex/mod"
+	"github.com/matt0792/ableton-ctrl/alsex/track"
+)
+
+// DefaultInterval is how often rules are evaluated.
+const DefaultInterval = 20 * time.Millisecond
+
+// Rule ducks targets while a source track is loud, like a compressor with
+// a sidechain input. Levels are in dB. Targets are volume or send faders,
+// reduced along Live's fader taper, see track.VolumeToDb.
+type Rule struct {
+	Source    int32        // track whose meter triggers ducking
+	Targets   []mod.Target // volumes or sends to duck
+	Threshold float32      // level above which ducking starts
+	Ratio     float32      // reduction ratio above the threshold, e.g. 4
+	Range     float32      // the most the targets are reduced
+	Attack    time.Duration
+	Release   time.Duration
+
+	reduction float64   // smoothed reduction in dB
+	sent      float64   // reduction last applied
+	base      []float32 // target values before ducking
+}
+
+// Reduction returns the gain reduction the rule would apply to a source
+// level, before smoothing.
+func (r *Rule) Reduction(level float32) float32 {
+	if level <= r.Threshold || r.Ratio <= 0 {
+		return 0
+	}
+	reduction := (level - r.Threshold) * (1 - 1/r.Ratio)
+	if r.Range > 0 && reduction > r.Range {
+		reduction = r.Range
+	}
+	return reduction
+}
+
+// Engine applies ducking rules from a meter service.
+type Engine struct {
+	meter    *meter.Service
+	interval time.Duration
+
+	mu     sync.Mutex
+	rules  []*Rule
+	levels map[int32]float32
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// New creates an engine reading levels from m, which must be started
+// separately.
+func New(m *meter.Service) *Engine {
+	return &Engine{
+		meter:    m,
+		interval: DefaultInterval,
+		levels:   make(map[int32]float32),
+	}
+}
+
+// Add adds a rule. Rules added while running take effect immediately.
+func (e *Engine) Add(r *Rule) *Rule {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stop != nil {
+		r.capture()
+	}
+	e.rules = append(e.rules, r)
+	return r
+}
+
+// Start reads the targets' current values and begins ducking.
+func (e *Engine) Start() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stop != nil {
+		return
+	}
+	for _, r := range e.rules {
+		r.capture()
+	}
+
+	frames, unsubscribe := e.meter.Subscribe(meter.DefaultBuffer)
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+	go e.run(frames, unsubscribe, e.stop, e.done)
+}
+
+// Stop stops ducking and restores the targets' values.
+func (e *Engine) Stop() {
+	e.mu.Lock()
+	if e.stop == nil {
+		e.mu.Unlock()
+		return
+	}
+	close(e.stop)
+	done := e.done
+	e.stop = nil
+	e.mu.Unlock()
+
+	<-done
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range e.rules {
+		r.restore()
+	}
+}
+
+func (e *Engine) run(frames <-chan meter.Frame, unsubscribe func(), stop, done chan struct{}) {
+	defer close(done)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	last := time.Now()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case f := <-frames:
+			e.mu.Lock()
+			e.levels[f.Track] = level(f)
+			e.mu.Unlock()
+		case now := <-ticker.C:
+			e.step(now.Sub(last))
+			last = now
+		}
+	}
+}
+
+// step moves every rule's reduction towards its target over dt and applies
+// any change of more than a tenth of a dB. Targets are set after unlocking,
+// so slow sends don't hold up meter frames or Add.
+func (e *Engine) step(dt time.Duration) {
+	e.mu.Lock()
+	var writes []write
+	for _, r := range e.rules {
+		lvl, ok := e.levels[r.Source]
+		if !ok {
+			lvl = -100
+		}
+		target := float64(r.Reduction(lvl))
+
+		tau := r.Release
+		if target > r.reduction {
+			tau = r.Attack
+		}
+		r.reduction = track.Smooth(r.reduction, target, dt, tau)
+		if math.Abs(target-r.reduction) < 0.01 {
+			r.reduction = target
+		}
+
+		if math.Abs(r.reduction-r.sent) >= 0.1 || (r.reduction == 0 && r.sent != 0) {
+			writes = append(writes, r.apply()...)
+		}
+	}
+	e.mu.Unlock()
+
+	for _, w := range writes {
+		w.target.Set(w.value)
+	}
+}
+
+// write is a target value to set once the engine is unlocked
+type write struct {
+	target mod.Target
+	value  float32
+}
+
+func (r *Rule) capture() {
+	r.base = make([]float32, len(r.Targets))
+	for i, t := range r.Targets {
+		r.base[i] = t.Get()
+	}
+	r.reduction = 0
+	r.sent = 0
+}
+
+// apply returns the writes reducing the targets from their base volumes
+func (r *Rule) apply() []write {
+	writes := make([]write, len(r.Targets))
+	for i, t := range r.Targets {
+		db := track.VolumeToDb(r.base[i]) - float32(r.reduction)
+		writes[i] = write{target: t, value: max(track.DbToVolume(db), 0)}
+	}
+	r.sent = r.reduction
+	return writes
+}
+
+func (r *Rule) restore() {
+	for i, t := range r.Targets {
+		if i < len(r.base) {
+			t.Set(r.base[i])
+		}
+	}
+	r.reduction = 0
+	r.sent = 0
+}
+
+// level converts a meter frame to dB from its louder channel
+func level(f meter.Frame) float32 {
+	peak := math.Max(float64(f.Left), float64(f.Right))
+	if peak <= 0 {
+		return -100
+	}
+	return float32(20 * math.Log10(peak))
+}
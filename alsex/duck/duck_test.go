@@ -0,0 +1,63 @@
+package duck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/alsex/meter"
+	"github.com/matt0792/ableton-ctrl/alsex/mod"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeTarget(value *float32) mod.Target {
+	return mod.Target{
+		Get: func() float32 { return *value },
+		Set: func(v float32) { *value = v },
+	}
+}
+
+// TestReduction verifies threshold, ratio and range
+func TestReduction(t *testing.T) {
+	r := &Rule{Threshold: -30, Ratio: 4, Range: 12}
+	assert.Equal(t, float32(0), r.Reduction(-40))
+	assert.Equal(t, float32(6), r.Reduction(-22))
+	assert.Equal(t, float32(12), r.Reduction(0))
+}
+
+// TestStep verifies attack, release and restoring targets
+func TestStep(t *testing.T) {
+	music := float32(0.8)
+	e := New(nil)
+	r := e.Add(&Rule{
+		Source:    1,
+		Targets:   []mod.Target{fakeTarget(&music)},
+		Threshold: -30,
+		Ratio:     2,
+		Range:     20,
+		Attack:    0,
+		Release:   100 * time.Millisecond,
+	})
+	r.capture()
+
+	e.levels[1] = level(meter.Frame{Left: 0.1, Right: 0.05}) // -20 dB
+	e.step(20 * time.Millisecond)
+	assert.InDelta(t, 5, r.reduction, 1e-9)
+	// 0.8 is -2 dB on Live's fader, so ducked by 5 dB it's -7 dB
+	assert.InDelta(t, 0.675, music, 1e-3)
+
+	// release falls exponentially then settles at the base value
+	e.levels[1] = -100
+	e.step(100 * time.Millisecond)
+	assert.InDelta(t, 5*0.3679, r.reduction, 1e-3)
+	for i := 0; i < 20; i++ {
+		e.step(100 * time.Millisecond)
+	}
+	assert.Equal(t, 0.0, r.reduction)
+	assert.Equal(t, float32(0.8), music)
+
+	e.levels[1] = 0
+	e.step(20 * time.Millisecond)
+	assert.NotEqual(t, float32(0.8), music)
+	r.restore()
+	assert.Equal(t, float32(0.8), music)
+}
@@ -0,0 +1,136 @@
+package groove
+
+import (
+	"math"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// Groove is a timing and accent template repeating every len(Timing) grid
+// steps.
+type Groove struct {
+	Grid     float32   // step length in beats, e.g. 0.25 for 16ths
+	Timing   []float32 // offset of each step in beats
+	Velocity []float32 // velocity multiplier of each step, 1 leaves it unchanged
+}
+
+// Swing builds an MPC style swing groove. Percent is where the second note
+// of each pair of grid steps falls: 50 is straight, 66 is close to a triplet
+// feel, 75 is a dotted feel.
+func Swing(percent, grid float32) *Groove {
+	offset := 2*grid*percent/100 - grid
+	return &Groove{
+		Grid:     grid,
+		Timing:   []float32{0, offset},
+		Velocity: []float32{1, 1},
+	}
+}
+
+// Extract builds a groove from reference notes, e.g. a drum loop read with
+// ClipAPI.GetNotes. Each note is assigned to its nearest grid step, and the
+// template holds the average offset and relative velocity of each of the
+// steps positions in the loop. Steps without notes stay on the grid.
+func Extract(notes []als.Note, grid float32, steps int) *Groove {
+	g := &Groove{
+		Grid:     grid,
+		Timing:   make([]float32, steps),
+		Velocity: make([]float32, steps),
+	}
+	if grid <= 0 || steps <= 0 {
+		return g
+	}
+
+	offsets := make([]float64, steps)
+	velocities := make([]float64, steps)
+	counts := make([]int, steps)
+	var totalVelocity float64
+
+	for _, n := range notes {
+		idx := nearestStep(n.StartTime, grid)
+		step := mod(idx, steps)
+		offsets[step] += float64(n.StartTime - float32(idx)*grid)
+		velocities[step] += float64(n.Velocity)
+		counts[step]++
+		totalVelocity += float64(n.Velocity)
+	}
+
+	mean := totalVelocity / math.Max(1, float64(len(notes)))
+	for i := range steps {
+		g.Velocity[i] = 1
+		if counts[i] == 0 {
+			continue
+		}
+		g.Timing[i] = float32(offsets[i] / float64(counts[i]))
+		if mean > 0 {
+			g.Velocity[i] = float32(velocities[i] / float64(counts[i]) / mean)
+		}
+	}
+
+	return g
+}
+
+// Apply returns a copy of notes moved towards the groove. Amount scales the
+// effect, 0 leaves notes unchanged and 1 applies the groove fully. Notes are
+// matched to the groove by their nearest grid step.
+func (g *Groove) Apply(notes []als.Note, amount float32) []als.Note {
+	result := make([]als.Note, len(notes))
+	copy(result, notes)
+	if g.Grid <= 0 || len(g.Timing) == 0 {
+		return result
+	}
+
+	for i, n := range result {
+		idx := nearestStep(n.StartTime, g.Grid)
+		step := mod(idx, len(g.Timing))
+
+		// quantize towards the grid before adding the groove's offset
+		target := float32(idx)*g.Grid + g.Timing[step]
+		n.StartTime += (target - n.StartTime) * amount
+		if n.StartTime < 0 {
+			n.StartTime = 0
+		}
+
+		if step < len(g.Velocity) {
+			scale := 1 + (g.Velocity[step]-1)*amount
+			n.Velocity = clampVelocity(int32(math.Round(float64(float32(n.Velocity) * scale))))
+		}
+
+		result[i] = n
+	}
+
+	return result
+}
+
+// Shift returns a copy of notes moved by the groove's offset of their nearest
+// grid step, scaled by amount. Unlike Apply, notes aren't quantized first, so
+// timing from an earlier Humanize or groove is kept.
+func (g *Groove) Shift(notes []als.Note, amount float32) []als.Note {
+	result := make([]als.Note, len(notes))
+	copy(result, notes)
+	if g.Grid <= 0 || len(g.Timing) == 0 {
+		return result
+	}
+
+	for i, n := range result {
+		step := mod(nearestStep(n.StartTime, g.Grid), len(g.Timing))
+		n.StartTime += g.Timing[step] * amount
+		if n.StartTime < 0 {
+			n.StartTime = 0
+		}
+		if step < len(g.Velocity) {
+			scale := 1 + (g.Velocity[step]-1)*amount
+			n.Velocity = clampVelocity(int32(math.Round(float64(float32(n.Velocity) * scale))))
+		}
+		result[i] = n
+	}
+
+	return result
+}
+
+func nearestStep(t, grid float32) int {
+	return int(math.Round(float64(t / grid)))
+}
+
+func mod(a, b int) int {
+	return ((a % b) + b) % b
+}
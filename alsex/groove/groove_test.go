@@ -0,0 +1,113 @@
+package groove
+
+import (
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sixteenths(n int) []als.Note {
+	notes := make([]als.Note, n)
+	for i := range notes {
+		notes[i] = als.Note{Pitch: 36, StartTime: float32(i) * 0.25, Duration: 0.25, Velocity: 100}
+	}
+	return notes
+}
+
+// TestHumanizeDeterministic verifies the same seed gives the same notes
+func TestHumanizeDeterministic(t *testing.T) {
+	notes := sixteenths(16)
+	opts := HumanizeOpts{Seed: 42, Timing: 0.02, Velocity: 10, Length: 0.2}
+
+	a := Humanize(notes, opts)
+	b := Humanize(notes, opts)
+	assert.Equal(t, a, b)
+
+	opts.Seed = 43
+	assert.NotEqual(t, a, Humanize(notes, opts))
+
+	// the input is left untouched
+	assert.Equal(t, sixteenths(16), notes)
+}
+
+// TestHumanizeBounds verifies offsets stay within their amounts
+func TestHumanizeBounds(t *testing.T) {
+	notes := sixteenths(64)
+
+	for _, d := range []Distribution{Uniform, Triangular} {
+		result := Humanize(notes, HumanizeOpts{Seed: 1, Distribution: d, Timing: 0.05, Velocity: 20, Length: 0.5})
+		require.Len(t, result, len(notes))
+
+		for i, n := range result {
+			assert.InDelta(t, notes[i].StartTime, n.StartTime, 0.05+1e-6)
+			assert.InDelta(t, notes[i].Velocity, n.Velocity, 20)
+			assert.InDelta(t, notes[i].Duration, n.Duration, 0.125+1e-6)
+			assert.GreaterOrEqual(t, n.StartTime, float32(0))
+		}
+	}
+
+	loud := []als.Note{{Pitch: 36, Velocity: 127, Duration: 1}}
+	for seed := uint64(0); seed < 32; seed++ {
+		n := Humanize(loud, HumanizeOpts{Seed: seed, Distribution: Gaussian, Velocity: 30})[0]
+		assert.LessOrEqual(t, n.Velocity, int32(127))
+		assert.GreaterOrEqual(t, n.Velocity, int32(1))
+	}
+}
+
+// TestSwing verifies MPC swing delays every second grid step
+func TestSwing(t *testing.T) {
+	result := Swing(66, 0.25).Apply(sixteenths(4), 1)
+
+	assert.InDelta(t, 0, result[0].StartTime, 1e-6)
+	assert.InDelta(t, 0.33, result[1].StartTime, 1e-6)
+	assert.InDelta(t, 0.5, result[2].StartTime, 1e-6)
+	assert.InDelta(t, 0.83, result[3].StartTime, 1e-6)
+
+	straight := Swing(50, 0.25).Apply(sixteenths(4), 1)
+	assert.Equal(t, sixteenths(4), straight)
+}
+
+// TestShift verifies the groove offsets notes without quantizing them
+func TestShift(t *testing.T) {
+	notes := sixteenths(4)
+	notes[1].StartTime += 0.02
+	notes[2].StartTime -= 0.01
+
+	result := Swing(66, 0.25).Shift(notes, 1)
+	assert.InDelta(t, 0, result[0].StartTime, 1e-6)
+	assert.InDelta(t, 0.35, result[1].StartTime, 1e-6)
+	assert.InDelta(t, 0.49, result[2].StartTime, 1e-6)
+	assert.InDelta(t, 0.83, result[3].StartTime, 1e-6)
+}
+
+// TestExtract verifies a groove extracted from a reference reproduces it
+func TestExtract(t *testing.T) {
+	reference := []als.Note{
+		{Pitch: 36, StartTime: 0, Velocity: 120},
+		{Pitch: 42, StartTime: 0.3, Velocity: 60},
+		{Pitch: 38, StartTime: 0.5, Velocity: 120},
+		{Pitch: 42, StartTime: 0.8, Velocity: 60},
+	}
+
+	g := Extract(reference, 0.25, 4)
+	assert.InDeltaSlice(t, []float32{0, 0.05, 0, 0.05}, g.Timing, 1e-6)
+	assert.InDeltaSlice(t, []float32{4.0 / 3, 2.0 / 3, 4.0 / 3, 2.0 / 3}, g.Velocity, 1e-6)
+
+	result := g.Apply(sixteenths(4), 1)
+	for i, n := range result {
+		assert.InDelta(t, reference[i].StartTime, n.StartTime, 1e-6)
+	}
+	assert.Equal(t, int32(127), result[0].Velocity) // 133 clamped
+	assert.Equal(t, int32(67), result[1].Velocity)
+}
+
+// TestApplyAmount verifies amount scales the groove
+func TestApplyAmount(t *testing.T) {
+	g := Swing(75, 0.25)
+	notes := sixteenths(2)
+
+	assert.Equal(t, notes, g.Apply(notes, 0))
+	assert.InDelta(t, 0.3125, g.Apply(notes, 0.5)[1].StartTime, 1e-6)
+}
@@ -0,0 +1,91 @@
+package groove
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// Distribution shapes the random offsets applied by Humanize.
+type Distribution int
+
+const (
+	// Uniform spreads offsets evenly across [-amount, amount].
+	Uniform Distribution = iota
+	// Gaussian draws normally distributed offsets with amount as the standard
+	// deviation, clamped to three deviations.
+	Gaussian
+	// Triangular favours small offsets, within [-amount, amount].
+	Triangular
+)
+
+type HumanizeOpts struct {
+	Seed         uint64
+	Rand         *rand.Rand // overrides Seed when set
+	Distribution Distribution
+	Timing       float32 // start time offset in beats
+	Velocity     float32 // velocity offset
+	Length       float32 // duration change as a fraction of the duration
+}
+
+// Humanize returns a copy of notes with random variations in timing, velocity
+// and length. The same seed always gives the same result.
+func Humanize(notes []als.Note, opts HumanizeOpts) []als.Note {
+	rng := opts.Rand
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+	}
+
+	result := make([]als.Note, len(notes))
+	for i, n := range notes {
+		if opts.Timing > 0 {
+			n.StartTime += opts.Timing * sample(rng, opts.Distribution)
+			if n.StartTime < 0 {
+				n.StartTime = 0
+			}
+		}
+
+		if opts.Velocity > 0 {
+			v := float32(n.Velocity) + opts.Velocity*sample(rng, opts.Distribution)
+			n.Velocity = clampVelocity(int32(math.Round(float64(v))))
+		}
+
+		if opts.Length > 0 {
+			n.Duration *= 1 + opts.Length*sample(rng, opts.Distribution)
+			if n.Duration < minDuration {
+				n.Duration = minDuration
+			}
+		}
+
+		result[i] = n
+	}
+
+	return result
+}
+
+// shortest note Humanize will produce, in beats
+const minDuration float32 = 1.0 / 128
+
+// sample returns a value in roughly [-1, 1] shaped by the distribution
+func sample(rng *rand.Rand, d Distribution) float32 {
+	switch d {
+	case Gaussian:
+		v := rng.NormFloat64()
+		return float32(math.Max(-3, math.Min(3, v)))
+	case Triangular:
+		return float32(rng.Float64() - rng.Float64())
+	default:
+		return float32(rng.Float64()*2 - 1)
+	}
+}
+
+func clampVelocity(v int32) int32 {
+	if v < 1 {
+		return 1
+	}
+	if v > 127 {
+		return 127
+	}
+	return v
+}
@@ -0,0 +1,542 @@
+// Package ident keeps handles on tracks and scenes pointing at the same
+// object while tracks and scenes are inserted and deleted.
+//
+// Live addresses tracks and scenes by index, so creating a track shifts
+// every track after it. A Tracker follows these changes. Its own mutations,
+// such as Tracker.CreateMIDITrack, move handles straight away. Changes made
+// in Live are seen through the num_tracks and num_scenes listeners and
+// matched up by name, so a handle whose object was renamed at the same time
+// as tracks moved may be lost. A handle whose object was deleted reports
+// ErrDeleted.
+package ident
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// ErrDeleted is returned for handles whose object was deleted.
+var ErrDeleted = errors.New("deleted")
+
+// Ref is a handle on a track or scene.
+type Ref struct {
+	tracker *Tracker // nil for fixed refs
+	list    *list
+
+	// guarded by tracker.mu
+	index    int32
+	deleted  bool
+	watchers map[int]func(index int32, err error)
+	nextID   int
+}
+
+// Fixed returns a ref that always has index, for code that takes refs but
+// doesn't track.
+func Fixed(index int32) *Ref {
+	return &Ref{index: index}
+}
+
+// Index returns the current index, or an error wrapping ErrDeleted once the
+// object was deleted. Changes made in Live are picked up on the tracker's
+// goroutine, so Index can lag them by a round trip to Live but never
+// blocks.
+func (r *Ref) Index() (int32, error) {
+	if r.tracker == nil {
+		return r.index, nil
+	}
+	r.tracker.mu.Lock()
+	defer r.tracker.mu.Unlock()
+	if r.deleted {
+		return 0, fmt.Errorf("%s %d: %w", r.list.kind, r.index, ErrDeleted)
+	}
+	return r.index, nil
+}
+
+// Deleted reports whether the object was deleted.
+func (r *Ref) Deleted() bool {
+	_, err := r.Index()
+	return err != nil
+}
+
+// Watch calls fn after the ref moves to a new index, or with an error
+// wrapping ErrDeleted once the object was deleted. fn runs on the tracker's
+// goroutine, or the goroutine of the mutation that moved the ref.
+func (r *Ref) Watch(fn func(index int32, err error)) (cancel func()) {
+	if r.tracker == nil {
+		return func() {}
+	}
+	r.tracker.mu.Lock()
+	defer r.tracker.mu.Unlock()
+	if r.watchers == nil {
+		r.watchers = map[int]func(int32, error){}
+	}
+	id := r.nextID
+	r.nextID++
+	r.watchers[id] = fn
+	return func() {
+		r.tracker.mu.Lock()
+		defer r.tracker.mu.Unlock()
+		delete(r.watchers, id)
+	}
+}
+
+// Release stops tracking the ref. Its index stays as it was.
+func (r *Ref) Release() {
+	if r.tracker == nil {
+		return
+	}
+	r.tracker.mu.Lock()
+	defer r.tracker.mu.Unlock()
+	delete(r.list.refs, r)
+}
+
+// list is the bookkeeping for tracks or scenes
+type list struct {
+	kind  string
+	count string // the song property counting them
+	read  func() ([]string, error)
+
+	names []string // by index, "" for ones we created and haven't read yet
+	refs  map[*Ref]struct{}
+
+	// pending counts our own mutations that Live hasn't reported yet
+	pending int
+	// mutated is set by our own mutations, whose names need reading
+	mutated bool
+	// generation counts our own mutations, to spot them during a read
+	generation int
+	// stale means the names need reading, moved that indices changed in
+	// Live and need matching up by name
+	stale bool
+	moved bool
+}
+
+// move is a ref changing index, for notifying watchers
+type move struct {
+	ref   *Ref
+	index int32
+	err   error
+}
+
+// Tracker keeps refs current. Without Start it only follows its own
+// mutations.
+type Tracker struct {
+	client *als.Client
+	hub    *listen.Hub
+	tracks *list
+	scenes *list
+
+	mu          sync.Mutex
+	started     bool
+	unsubscribe []func()
+	changed     chan struct{}
+	done        chan struct{}
+
+	// syncMu serializes reading names from Live
+	syncMu sync.Mutex
+}
+
+// New creates a tracker.
+func New(client *als.Client) *Tracker {
+	t := &Tracker{client: client, hub: listen.Shared(client)}
+	t.tracks = &list{kind: "track", count: "num_tracks", read: t.trackNames, refs: map[*Ref]struct{}{}}
+	t.scenes = &list{kind: "scene", count: "num_scenes", read: t.sceneNames, refs: map[*Ref]struct{}{}}
+	return t
+}
+
+// Track returns a ref on the track at index.
+func (t *Tracker) Track(index int32) *Ref {
+	return t.ref(t.tracks, index)
+}
+
+// Scene returns a ref on the scene at index.
+func (t *Tracker) Scene(index int32) *Ref {
+	return t.ref(t.scenes, index)
+}
+
+func (t *Tracker) ref(l *list, index int32) *Ref {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := &Ref{tracker: t, list: l, index: index}
+	l.refs[r] = struct{}{}
+	return r
+}
+
+// Start reads the track and scene names and follows changes made in Live.
+func (t *Tracker) Start() error {
+	t.mu.Lock()
+	if t.started {
+		t.mu.Unlock()
+		return nil
+	}
+	t.started = true
+	t.changed = make(chan struct{}, 1)
+	t.done = make(chan struct{})
+	t.mu.Unlock()
+
+	for _, l := range []*list{t.tracks, t.scenes} {
+		names, err := l.read()
+		if err != nil {
+			t.Stop()
+			return err
+		}
+		t.mu.Lock()
+		l.names = names
+		t.mu.Unlock()
+
+		unsubscribe, err := t.hub.Subscribe(listen.Topic{Object: "song", Property: l.count}, func(e listen.Event) {
+			t.counted(l, e.Values)
+		})
+		if err != nil {
+			t.Stop()
+			return err
+		}
+		t.mu.Lock()
+		t.unsubscribe = append(t.unsubscribe, unsubscribe)
+		t.mu.Unlock()
+	}
+
+	go t.run(t.changed, t.done)
+	return nil
+}
+
+// Stop stops following Live. Refs keep following the tracker's own
+// mutations.
+func (t *Tracker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.started {
+		return
+	}
+	for _, unsubscribe := range t.unsubscribe {
+		unsubscribe()
+	}
+	t.unsubscribe = nil
+	close(t.done)
+	t.started = false
+}
+
+// run reads names after changes, off the listener goroutine
+func (t *Tracker) run(changed, done chan struct{}) {
+	for {
+		select {
+		case <-changed:
+			t.sync()
+		case <-done:
+			return
+		}
+	}
+}
+
+// counted handles Live reporting the number of tracks or scenes. A count
+// we expect settles our own mutations; any other means the set changed in
+// Live. It runs on the listener goroutine so must not block.
+func (t *Tracker) counted(l *list, values []any) {
+	n, ok := als.First(values).(int32)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	switch {
+	case l.names != nil && int(n) == len(l.names):
+		l.pending = 0
+		if l.mutated {
+			l.mutated = false
+			l.stale = true
+		}
+	case l.pending > 0:
+		// part way through our own mutations
+		l.pending--
+	default:
+		l.moved = true
+	}
+	kick := l.stale || l.moved
+	changed := t.changed
+	t.mu.Unlock()
+
+	if kick {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// sync reads names that are stale and matches up moved indices
+func (t *Tracker) sync() {
+	t.syncMu.Lock()
+	var moves []move
+	for _, l := range []*list{t.tracks, t.scenes} {
+		moves = append(moves, t.syncList(l)...)
+	}
+	t.syncMu.Unlock()
+	notify(moves)
+}
+
+// syncList reads a list's names from Live. The caller holds syncMu.
+func (t *Tracker) syncList(l *list) []move {
+	for {
+		t.mu.Lock()
+		stale, moved, generation := l.stale, l.moved, l.generation
+		l.stale, l.moved = false, false
+		t.mu.Unlock()
+		if !stale && !moved {
+			return nil
+		}
+
+		names, err := l.read()
+		t.mu.Lock()
+		if err != nil {
+			// try again on the next change
+			l.stale, l.moved = l.stale || stale, l.moved || moved
+			t.mu.Unlock()
+			return nil
+		}
+		if l.generation != generation {
+			// we mutated during the read, so it may be out of date
+			l.stale, l.moved = l.stale || stale, l.moved || moved
+			t.mu.Unlock()
+			continue
+		}
+
+		var moves []move
+		if moved || len(names) != len(l.names) {
+			moves = l.match(names)
+		}
+		l.names = names
+		t.mu.Unlock()
+		return moves
+	}
+}
+
+// match moves refs to the indices of their objects in names, which Live
+// reported after changes we didn't make. Old and new names are aligned by
+// their longest common subsequence, and refs whose name is gone are
+// deleted. The caller holds mu.
+func (l *list) match(names []string) []move {
+	index := align(l.names, names)
+	var moves []move
+	for r := range l.refs {
+		if r.deleted || int(r.index) >= len(index) {
+			continue
+		}
+		switch to := index[r.index]; {
+		case to < 0:
+			r.deleted = true
+			moves = append(moves, move{ref: r, index: r.index, err: fmt.Errorf("%s %d: %w", l.kind, r.index, ErrDeleted)})
+		case to != r.index:
+			r.index = to
+			moves = append(moves, move{ref: r, index: to})
+		}
+	}
+	return moves
+}
+
+// align maps each index of before to its index in after, or -1 when it is
+// gone
+func align(before, after []string) []int32 {
+	// lengths[i][j] is the longest common subsequence of before[i:] and
+	// after[j:]
+	lengths := make([][]int, len(before)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] != "" && before[i] == after[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	index := make([]int32, len(before))
+	i, j := 0, 0
+	for i < len(before) {
+		switch {
+		case j < len(after) && before[i] != "" && before[i] == after[j]:
+			index[i] = int32(j)
+			i++
+			j++
+		case j < len(after) && lengths[i][j+1] >= lengths[i+1][j]:
+			j++
+		default:
+			index[i] = -1
+			i++
+		}
+	}
+
+	// then pair up names moved past others
+	used := make([]bool, len(after))
+	for _, j := range index {
+		if j >= 0 {
+			used[j] = true
+		}
+	}
+	for i, j := range index {
+		if j >= 0 || before[i] == "" {
+			continue
+		}
+		for k, name := range after {
+			if !used[k] && name == before[i] {
+				index[i] = int32(k)
+				used[k] = true
+				break
+			}
+		}
+	}
+
+	// duplicate names can't tell which is which, so keep them in their
+	// original order: a ref on the first of two "Audio" tracks stays on the
+	// first
+	same := map[string][]int{}
+	for i, j := range index {
+		if j >= 0 {
+			same[before[i]] = append(same[before[i]], i)
+		}
+	}
+	for _, is := range same {
+		if len(is) < 2 {
+			continue
+		}
+		js := make([]int32, len(is))
+		for n, i := range is {
+			js[n] = index[i]
+		}
+		slices.Sort(js)
+		for n, i := range is {
+			index[i] = js[n]
+		}
+	}
+	return index
+}
+
+// check reports an index that is out of range, allowing one past the end
+// when inserting. Until the tracker has read the names only negative indices
+// are caught. The caller holds mu.
+func (l *list) check(index int32, inserting bool) error {
+	n := int32(len(l.names))
+	if inserting {
+		n++
+	}
+	if index < 0 || (l.names != nil && index >= n) {
+		if l.names == nil {
+			return fmt.Errorf("%s %d not found", l.kind, index)
+		}
+		return fmt.Errorf("%s %d not found, there are %d", l.kind, index, len(l.names))
+	}
+	return nil
+}
+
+// insert shifts refs for an object inserted at index, appending when index
+// is -1
+func (t *Tracker) insert(l *list, index int32, name string) ([]move, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if index == -1 {
+		t.mutate(l)
+		if l.names != nil {
+			l.names = append(l.names, name)
+		}
+		return nil, nil
+	}
+	if err := l.check(index, true); err != nil {
+		return nil, err
+	}
+	t.mutate(l)
+	if l.names != nil {
+		l.names = append(l.names[:index], append([]string{name}, l.names[index:]...)...)
+	}
+	return l.shift(index, 1), nil
+}
+
+// remove deletes the ref on the object at index and shifts those after it
+func (t *Tracker) remove(l *list, index int32) ([]move, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := l.check(index, false); err != nil {
+		return nil, err
+	}
+	t.mutate(l)
+	if l.names != nil {
+		l.names = append(l.names[:index], l.names[index+1:]...)
+	}
+
+	var moves []move
+	for r := range l.refs {
+		if !r.deleted && r.index == index {
+			r.deleted = true
+			moves = append(moves, move{ref: r, index: index, err: fmt.Errorf("%s %d: %w", l.kind, index, ErrDeleted)})
+		}
+	}
+	return append(moves, l.shift(index+1, -1)...), nil
+}
+
+// duplicate shifts refs for a copy of the object at index inserted after it
+func (t *Tracker) duplicate(l *list, index int32) ([]move, error) {
+	t.mu.Lock()
+	if err := l.check(index, false); err != nil {
+		t.mu.Unlock()
+		return nil, err
+	}
+	name := ""
+	if l.names != nil {
+		name = l.names[index]
+	}
+	t.mu.Unlock()
+	return t.insert(l, index+1, name)
+}
+
+// mutate records one of our own mutations. The caller holds mu.
+func (t *Tracker) mutate(l *list) {
+	l.generation++
+	if t.started {
+		l.pending++
+		l.mutated = true
+	}
+}
+
+// shift moves refs at from and after by delta. The caller holds mu.
+func (l *list) shift(from, delta int32) []move {
+	var moves []move
+	for r := range l.refs {
+		if !r.deleted && r.index >= from {
+			r.index += delta
+			moves = append(moves, move{ref: r, index: r.index})
+		}
+	}
+	return moves
+}
+
+func notify(moves []move) {
+	for _, m := range moves {
+		m.ref.tracker.mu.Lock()
+		watchers := make([]func(int32, error), 0, len(m.ref.watchers))
+		for _, fn := range m.ref.watchers {
+			watchers = append(watchers, fn)
+		}
+		m.ref.tracker.mu.Unlock()
+		for _, fn := range watchers {
+			fn(m.index, m.err)
+		}
+	}
+}
+
+func (t *Tracker) trackNames() ([]string, error) {
+	args, err := t.client.Get("/live/song/get/track_names")
+	if err != nil {
+		return nil, err
+	}
+	return als.Strings(args), nil
+}
+
+func (t *Tracker) sceneNames() ([]string, error) {
+	return t.client.SceneNames()
+}
@@ -0,0 +1,208 @@
+package ident
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSet(t *testing.T) *alstest.Server {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass", "Keys")
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Verse")
+	return live
+}
+
+func index(t *testing.T, r *Ref) int32 {
+	t.Helper()
+	i, err := r.Index()
+	require.NoError(t, err)
+	return i
+}
+
+// TestAlign verifies old indices are matched to new ones by name, with
+// duplicate names kept in order
+func TestAlign(t *testing.T) {
+	assert.Equal(t, []int32{0, 2, 3}, align([]string{"A", "B", "C"}, []string{"A", "X", "B", "C"}))
+	assert.Equal(t, []int32{0, -1, 1}, align([]string{"A", "B", "C"}, []string{"A", "C"}))
+	assert.Equal(t, []int32{1, 2, 0}, align([]string{"A", "B", "C"}, []string{"C", "A", "B"}))
+	assert.Equal(t, []int32{0, -1}, align([]string{"A", ""}, []string{"A", "1-MIDI"}))
+
+	// duplicates keep their order
+	assert.Equal(t, []int32{2, 0, 1, 3}, align([]string{"A", "X", "Y", "A"}, []string{"X", "Y", "A", "A"}))
+	assert.Equal(t, []int32{1, 2, 0}, align([]string{"A", "A", "B"}, []string{"B", "A", "A"}))
+	assert.Equal(t, []int32{0, -1}, align([]string{"A", "A"}, []string{"A"}))
+	assert.Equal(t, []int32{0, 1}, align([]string{"A", "A"}, []string{"A", "A", "A"}))
+}
+
+// TestMutations verifies the tracker's own mutations move refs straight away
+func TestMutations(t *testing.T) {
+	live := newTestSet(t)
+	tracker := New(live.Client(t))
+
+	drums, bass, keys := tracker.Track(0), tracker.Track(1), tracker.Track(2)
+	verse := tracker.Scene(1)
+
+	var mu sync.Mutex
+	var moves []int32
+	var deleted error
+	cancel := bass.Watch(func(index int32, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			deleted = err
+			return
+		}
+		moves = append(moves, index)
+	})
+	defer cancel()
+
+	require.NoError(t, tracker.CreateMIDITrack(1))
+	msgs := live.WaitFor(t, "/live/song/create_midi_track")
+	assert.Equal(t, []any{int32(1)}, msgs[0].Arguments)
+	assert.Equal(t, int32(0), index(t, drums))
+	assert.Equal(t, int32(2), index(t, bass))
+	assert.Equal(t, int32(3), index(t, keys))
+
+	require.NoError(t, tracker.DuplicateTrack(0))
+	assert.Equal(t, int32(0), index(t, drums))
+	assert.Equal(t, int32(3), index(t, bass))
+
+	// appending moves nothing
+	require.NoError(t, tracker.CreateAudioTrack(-1))
+	assert.Equal(t, int32(3), index(t, bass))
+
+	require.NoError(t, tracker.DeleteTrack(3))
+	_, err := bass.Index()
+	assert.ErrorIs(t, err, ErrDeleted)
+	assert.EqualError(t, err, "track 3: deleted")
+	assert.True(t, bass.Deleted())
+	assert.Equal(t, int32(3), index(t, keys))
+
+	require.NoError(t, tracker.CreateScene(0))
+	assert.Equal(t, int32(2), index(t, verse))
+	require.NoError(t, tracker.DuplicateScene(2))
+	assert.Equal(t, int32(2), index(t, verse))
+	require.NoError(t, tracker.DeleteScene(0))
+	assert.Equal(t, int32(1), index(t, verse))
+	live.WaitFor(t, "/live/song/delete_scene")
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []int32{2, 3}, moves)
+	assert.ErrorIs(t, deleted, ErrDeleted)
+
+	fixed := Fixed(4)
+	assert.Equal(t, int32(4), index(t, fixed))
+}
+
+// TestLive verifies changes made in Live are matched up by name
+func TestLive(t *testing.T) {
+	live := newTestSet(t)
+	tracker := New(live.Client(t))
+	require.NoError(t, tracker.Start())
+	t.Cleanup(tracker.Stop)
+	live.WaitFor(t, "/live/song/start_listen/num_tracks")
+
+	drums, bass, keys := tracker.Track(0), tracker.Track(1), tracker.Track(2)
+	verse := tracker.Scene(1)
+
+	deleted := make(chan error, 1)
+	bass.Watch(func(_ int32, err error) {
+		if err != nil {
+			deleted <- err
+		}
+	})
+
+	// a track inserted in Live
+	live.Set("/live/song/get/track_names", "Pad", "Drums", "Bass", "Keys")
+	live.Emit("/live/song/get/num_tracks", int32(4))
+	assert.Eventually(t, func() bool {
+		i, err := keys.Index()
+		return err == nil && i == 3
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(1), index(t, drums))
+	assert.Equal(t, int32(2), index(t, bass))
+
+	// and one deleted
+	live.Set("/live/song/get/track_names", "Pad", "Drums", "Keys")
+	live.Emit("/live/song/get/num_tracks", int32(3))
+	select {
+	case err := <-deleted:
+		assert.True(t, errors.Is(err, ErrDeleted))
+	case <-time.After(time.Second):
+		t.Fatal("no deletion reported")
+	}
+	assert.Equal(t, int32(2), index(t, keys))
+
+	// our own mutation is settled by the count Live reports, not matched
+	// by name, so the new track isn't mistaken for a deleted one
+	require.NoError(t, tracker.CreateMIDITrack(0))
+	live.Set("/live/song/get/track_names", "1-MIDI", "Pad", "Drums", "Keys")
+	live.Emit("/live/song/get/num_tracks", int32(4))
+	assert.Equal(t, int32(3), index(t, keys))
+	assert.Eventually(t, func() bool {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+		return tracker.tracks.names[0] == "1-MIDI"
+	}, time.Second, 5*time.Millisecond)
+
+	live.Set("/live/song/get/num_scenes", int32(3))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Break")
+	live.Set("/live/scene/get/name", int32(2), "Verse")
+	live.Emit("/live/song/get/num_scenes", int32(3))
+	assert.Eventually(t, func() bool {
+		i, err := verse.Index()
+		return err == nil && i == 2
+	}, time.Second, 5*time.Millisecond)
+
+	// the new track's name was read after Live settled, so a later change
+	// in Live keeps it
+	created := tracker.Track(0)
+	live.Set("/live/song/get/track_names", "1-MIDI", "Drums", "Keys")
+	live.Emit("/live/song/get/num_tracks", int32(3))
+	assert.Eventually(t, func() bool {
+		i, err := keys.Index()
+		return err == nil && i == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(0), index(t, created))
+	assert.Equal(t, int32(1), index(t, drums))
+}
+
+// TestRange verifies mutations out of range are errors that move nothing
+// and send nothing
+func TestRange(t *testing.T) {
+	live := newTestSet(t)
+	tracker := New(live.Client(t))
+	keys := tracker.Track(2)
+
+	// before Start the names aren't known, so only negative indices fail
+	assert.EqualError(t, tracker.DeleteTrack(-1), "track -1 not found")
+	assert.EqualError(t, tracker.CreateScene(-2), "scene -2 not found")
+
+	require.NoError(t, tracker.Start())
+	t.Cleanup(tracker.Stop)
+	assert.EqualError(t, tracker.DeleteTrack(-1), "track -1 not found, there are 3")
+	assert.EqualError(t, tracker.DeleteTrack(3), "track 3 not found, there are 3")
+	assert.EqualError(t, tracker.DuplicateTrack(3), "track 3 not found, there are 3")
+	assert.EqualError(t, tracker.CreateMIDITrack(4), "track 4 not found, there are 3")
+	assert.EqualError(t, tracker.DeleteScene(-1), "scene -1 not found, there are 2")
+	assert.EqualError(t, tracker.DuplicateScene(2), "scene 2 not found, there are 2")
+	assert.Equal(t, int32(2), index(t, keys))
+
+	// one past the end inserts at the end
+	require.NoError(t, tracker.CreateMIDITrack(3))
+	live.WaitFor(t, "/live/song/create_midi_track")
+	assert.Empty(t, live.Received("/live/song/delete_track"))
+	assert.Empty(t, live.Received("/live/song/duplicate_track"))
+	assert.Empty(t, live.Received("/live/song/delete_scene"))
+	assert.Empty(t, live.Received("/live/song/duplicate_scene"))
+}
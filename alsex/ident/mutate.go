@@ -0,0 +1,83 @@
+package ident
+
+// The mutations below call the SongAPI method of the same name after
+// moving refs, so handles are right before Live reports the change. An
+// index out of range is an error and nothing is sent; until the tracker has
+// read the names, only negative indices are caught.
+
+// CreateAudioTrack creates an audio track at index, or at the end for -1.
+func (t *Tracker) CreateAudioTrack(index int32) error {
+	moves, err := t.insert(t.tracks, index, "")
+	if err != nil {
+		return err
+	}
+	t.client.Song.CreateAudioTrack(index)
+	notify(moves)
+	return nil
+}
+
+// CreateMIDITrack creates a MIDI track at index, or at the end for -1.
+func (t *Tracker) CreateMIDITrack(index int32) error {
+	moves, err := t.insert(t.tracks, index, "")
+	if err != nil {
+		return err
+	}
+	t.client.Song.CreateMIDITrack(index)
+	notify(moves)
+	return nil
+}
+
+// DeleteTrack deletes the track at index.
+func (t *Tracker) DeleteTrack(index int32) error {
+	moves, err := t.remove(t.tracks, index)
+	if err != nil {
+		return err
+	}
+	t.client.Song.DeleteTrack(index)
+	notify(moves)
+	return nil
+}
+
+// DuplicateTrack copies the track at index to just after it.
+func (t *Tracker) DuplicateTrack(index int32) error {
+	moves, err := t.duplicate(t.tracks, index)
+	if err != nil {
+		return err
+	}
+	t.client.Song.DuplicateTrack(index)
+	notify(moves)
+	return nil
+}
+
+// CreateScene creates a scene at index, or at the end for -1.
+func (t *Tracker) CreateScene(index int32) error {
+	moves, err := t.insert(t.scenes, index, "")
+	if err != nil {
+		return err
+	}
+	t.client.Song.CreateScene(index)
+	notify(moves)
+	return nil
+}
+
+// DeleteScene deletes the scene at index.
+func (t *Tracker) DeleteScene(index int32) error {
+	moves, err := t.remove(t.scenes, index)
+	if err != nil {
+		return err
+	}
+	t.client.Song.DeleteScene(index)
+	notify(moves)
+	return nil
+}
+
+// DuplicateScene copies the scene at index to just after it.
+func (t *Tracker) DuplicateScene(index int32) error {
+	moves, err := t.duplicate(t.scenes, index)
+	if err != nil {
+		return err
+	}
+	t.client.Song.DuplicateScene(index)
+	notify(moves)
+	return nil
+}
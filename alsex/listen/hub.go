@@ -0,0 +1,219 @@
+package listen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hypebeast/go-osc/osc"
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// objects maps listenable objects to the number of indices addressing them
+var objects = map[string]int{
+	"song":      0,
+	"track":     1,
+	"clip":      2,
+	"clip_slot": 2,
+	"scene":     1,
+	"view":      0,
+}
+
+var propertyPattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// Topic names a listenable property, e.g. the volume of track 2 is
+// Topic{Object: "track", Property: "volume", Indices: []int32{2}}.
+type Topic struct {
+	Object   string  `json:"object"`
+	Property string  `json:"property"`
+	Indices  []int32 `json:"indices,omitempty"`
+}
+
+// Validate checks the object is listenable and addressed by the right number
+// of indices.
+func (t Topic) Validate() error {
+	n, ok := objects[t.Object]
+	if !ok {
+		return fmt.Errorf("unknown object %q", t.Object)
+	}
+	if !propertyPattern.MatchString(t.Property) {
+		return fmt.Errorf("invalid property %q", t.Property)
+	}
+	if len(t.Indices) != n {
+		return fmt.Errorf("%s needs %d indices, got %d", t.Object, n, len(t.Indices))
+	}
+	return nil
+}
+
+func (t Topic) String() string {
+	return fmt.Sprint(t.Object, "/", t.Property, t.Indices)
+}
+
+func (t Topic) address(verb string) string {
+	return "/live/" + t.Object + "/" + verb + "/" + t.Property
+}
+
+func (t Topic) args() []any {
+	args := make([]any, len(t.Indices))
+	for i, index := range t.Indices {
+		args[i] = index
+	}
+	return args
+}
+
+// Event is a listener update.
+type Event struct {
+	Topic
+	Values []any     `json:"values"`
+	Time   time.Time `json:"time"`
+}
+
+// Hub shares Live's listeners between subscribers. The first subscriber to
+// a topic starts Live listening and the last to leave stops it.
+type Hub struct {
+	client *als.Client
+
+	mu     sync.Mutex
+	topics map[string]*topic
+	nextID int
+}
+
+type topic struct {
+	Topic
+	subscribers map[int]func(Event)
+	unsubscribe func()
+}
+
+// NewHub creates a hub of its own. Hubs don't count each other's
+// subscribers, so one stopping a listener stops it for the other: use Shared
+// unless the client is used by nothing else.
+func NewHub(client *als.Client) *Hub {
+	return &Hub{
+		client: client,
+		topics: make(map[string]*topic),
+	}
+}
+
+type sharedKey struct{}
+
+// Shared returns the client's hub, creating it on first use. Everything
+// listening through one client should use it, so a listener stays started
+// while anyone needs it.
+func Shared(client *als.Client) *Hub {
+	return client.Shared(sharedKey{}, func() any { return NewHub(client) }).(*Hub)
+}
+
+// Subscribe calls fn with every update of the topic until the returned
+// function is called. fn runs on the OSC server goroutine, so must not block
+// or wait for responses.
+func (h *Hub) Subscribe(t Topic, fn func(Event)) (unsubscribe func(), err error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := t.String()
+	tp := h.topics[key]
+	if tp == nil {
+		tp = &topic{Topic: t, subscribers: make(map[int]func(Event))}
+		tp.unsubscribe = h.client.Subscribe(t.address("get"), func(msg *osc.Message) {
+			h.deliver(key, msg)
+		})
+		h.topics[key] = tp
+		h.client.Send(t.address("start_listen"), t.args()...)
+	}
+
+	id := h.nextID
+	h.nextID++
+	tp.subscribers[id] = fn
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { h.leave(key, id) })
+	}, nil
+}
+
+// Count returns the number of subscribers to a topic.
+func (h *Hub) Count(t Topic) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if tp := h.topics[t.String()]; tp != nil {
+		return len(tp.subscribers)
+	}
+	return 0
+}
+
+func (h *Hub) leave(key string, id int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	tp := h.topics[key]
+	if tp == nil {
+		return
+	}
+	delete(tp.subscribers, id)
+	if len(tp.subscribers) == 0 {
+		delete(h.topics, key)
+		tp.unsubscribe()
+		h.client.Send(tp.address("stop_listen"), tp.args()...)
+	}
+}
+
+func (h *Hub) deliver(key string, msg *osc.Message) {
+	h.mu.Lock()
+	tp := h.topics[key]
+	if tp == nil || !matches(tp.Indices, msg.Arguments) {
+		h.mu.Unlock()
+		return
+	}
+	subscribers := make([]func(Event), 0, len(tp.subscribers))
+	for _, fn := range tp.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	event := Event{
+		Topic:  tp.Topic,
+		Values: msg.Arguments[len(tp.Indices):],
+		Time:   time.Now(),
+	}
+	h.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(event)
+	}
+}
+
+// matches reports whether a message's leading arguments are the indices
+func matches(indices []int32, args []any) bool {
+	if len(args) < len(indices) {
+		return false
+	}
+	for i, index := range indices {
+		if v, ok := args[i].(int32); !ok || v != index {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseTopic parses a topic written as a path, e.g. "track/volume/2" or
+// "song/tempo".
+func ParseTopic(s string) (Topic, error) {
+	parts := strings.Split(strings.Trim(s, "/"), "/")
+	if len(parts) < 2 {
+		return Topic{}, fmt.Errorf("invalid topic %q", s)
+	}
+
+	t := Topic{Object: parts[0], Property: parts[1]}
+	for _, part := range parts[2:] {
+		var index int32
+		if _, err := fmt.Sscan(part, &index); err != nil || index < 0 {
+			return Topic{}, fmt.Errorf("invalid index %q in topic %q", part, s)
+		}
+		t.Indices = append(t.Indices, index)
+	}
+	return t, t.Validate()
+}
@@ -0,0 +1,84 @@
+package listen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHub verifies subscribers share one Live listener per topic
+func TestHub(t *testing.T) {
+	live := alstest.NewServer(t)
+	hub := NewHub(live.Client(t))
+
+	volume := Topic{Object: "track", Property: "volume", Indices: []int32{2}}
+	a, b := make(chan Event, 4), make(chan Event, 4)
+	stopA, err := hub.Subscribe(volume, func(e Event) { a <- e })
+	require.NoError(t, err)
+	stopB, err := hub.Subscribe(volume, func(e Event) { b <- e })
+	require.NoError(t, err)
+	assert.Equal(t, 2, hub.Count(volume))
+
+	live.WaitFor(t, "/live/track/start_listen/volume")
+	assert.Len(t, live.Received("/live/track/start_listen/volume"), 1)
+
+	// other tracks are filtered out
+	live.Emit("/live/track/get/volume", int32(1), float32(0.1))
+	live.Emit("/live/track/get/volume", int32(2), float32(0.5))
+	for _, ch := range []chan Event{a, b} {
+		select {
+		case e := <-ch:
+			assert.Equal(t, []any{float32(0.5)}, e.Values)
+			assert.Equal(t, volume, e.Topic)
+		case <-time.After(time.Second):
+			t.Fatal("no event")
+		}
+	}
+
+	stopA()
+	stopA()
+	assert.Empty(t, live.Received("/live/track/stop_listen/volume"))
+	stopB()
+	msgs := live.WaitFor(t, "/live/track/stop_listen/volume")
+	assert.Equal(t, []any{int32(2)}, msgs[0].Arguments)
+	assert.Equal(t, 0, hub.Count(volume))
+}
+
+// TestShared verifies a client has one hub, so users of it count together
+func TestShared(t *testing.T) {
+	live := alstest.NewServer(t)
+	client := live.Client(t)
+	hub := Shared(client)
+	assert.Same(t, hub, Shared(client))
+
+	tempo := Topic{Object: "song", Property: "tempo"}
+	stopA, err := Shared(client).Subscribe(tempo, func(Event) {})
+	require.NoError(t, err)
+	stopB, err := Shared(client).Subscribe(tempo, func(Event) {})
+	require.NoError(t, err)
+	assert.Equal(t, 2, hub.Count(tempo))
+
+	stopA()
+	stopB()
+	live.WaitFor(t, "/live/song/stop_listen/tempo")
+	assert.Len(t, live.Received("/live/song/start_listen/tempo"), 1)
+}
+
+// TestParseTopic verifies topic paths and validation
+func TestParseTopic(t *testing.T) {
+	topic, err := ParseTopic("clip/playing_position/1/3")
+	require.NoError(t, err)
+	assert.Equal(t, Topic{Object: "clip", Property: "playing_position", Indices: []int32{1, 3}}, topic)
+
+	topic, err = ParseTopic("/song/beat")
+	require.NoError(t, err)
+	assert.Equal(t, Topic{Object: "song", Property: "beat"}, topic)
+
+	for _, invalid := range []string{"song", "mixer/volume", "track/volume", "track/volume/x", "song/Tempo"} {
+		_, err := ParseTopic(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,213 @@
+package meter
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+const (
+	DefaultPeakHold  = 2 * time.Second
+	DefaultRMSWindow = 300 * time.Millisecond
+	DefaultBuffer    = 64
+)
+
+// Opts configures a Service.
+type Opts struct {
+	PeakHold  time.Duration // how long peaks are held before falling
+	RMSWindow time.Duration // window averaged for RMS
+}
+
+// Frame is a track's meter reading. Levels are 0 to 1.
+type Frame struct {
+	Track     int32     `json:"track"`
+	Time      time.Time `json:"time"`
+	Left      float32   `json:"left"`
+	Right     float32   `json:"right"`
+	PeakLeft  float32   `json:"peak_left"`
+	PeakRight float32   `json:"peak_right"`
+	RMS       float32   `json:"rms"`
+}
+
+// Service follows the output meters of every track and fans frames out to
+// subscribers.
+type Service struct {
+	client *als.Client
+	opts   Opts
+	now    func() time.Time
+
+	mu          sync.Mutex
+	tracks      map[int32]*trackState
+	subscribers map[int]chan Frame
+	nextID      int
+	stop        func()
+}
+
+type trackState struct {
+	frame     Frame
+	leftHeld  time.Time
+	rightHeld time.Time
+	window    []sample
+}
+
+type sample struct {
+	time  time.Time
+	power float64
+}
+
+// New creates a meter service. Call Start to begin listening.
+func New(client *als.Client, opts Opts) *Service {
+	if opts.PeakHold <= 0 {
+		opts.PeakHold = DefaultPeakHold
+	}
+	if opts.RMSWindow <= 0 {
+		opts.RMSWindow = DefaultRMSWindow
+	}
+	return &Service{
+		client:      client,
+		opts:        opts,
+		now:         time.Now,
+		tracks:      make(map[int32]*trackState),
+		subscribers: make(map[int]chan Frame),
+	}
+}
+
+// Start listens to the output meters of every track in the song through the
+// client's shared listen hub. Tracks added later are picked up by calling
+// Start again.
+func (s *Service) Start() {
+	s.Stop()
+
+	hub := listen.Shared(s.client)
+	numTracks := s.client.Song.GetNumTracks()
+	var unsubscribe []func()
+	for i := int32(0); i < numTracks; i++ {
+		for _, property := range []string{"output_meter_left", "output_meter_right"} {
+			right := property == "output_meter_right"
+			topic := listen.Topic{Object: "track", Property: property, Indices: []int32{i}}
+			// track topics with one index are always valid
+			stop, _ := hub.Subscribe(topic, func(e listen.Event) {
+				if value, ok := als.First(e.Values).(float32); ok {
+					s.update(e.Indices[0], value, right)
+				}
+			})
+			unsubscribe = append(unsubscribe, stop)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stop = func() {
+		for _, fn := range unsubscribe {
+			fn()
+		}
+	}
+}
+
+// Stop stops listening. Subscribers stay subscribed.
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// Subscribe returns a channel receiving every frame. Frames are dropped
+// rather than blocking when the channel's buffer is full.
+func (s *Service) Subscribe(buffer int) (<-chan Frame, func()) {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	ch := make(chan Frame, buffer)
+
+	s.mu.Lock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = ch
+	s.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.subscribers, id)
+			close(ch)
+		})
+	}
+}
+
+// Levels returns the latest frame of every track.
+func (s *Service) Levels() map[int32]Frame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	levels := make(map[int32]Frame, len(s.tracks))
+	for id, t := range s.tracks {
+		levels[id] = t.frame
+	}
+	return levels
+}
+
+// update records a reading for one channel of a track and publishes the
+// track's frame
+func (s *Service) update(track int32, value float32, right bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	t := s.tracks[track]
+	if t == nil {
+		t = &trackState{frame: Frame{Track: track}}
+		s.tracks[track] = t
+	}
+
+	f := &t.frame
+	f.Time = now
+	if right {
+		f.Right = value
+		f.PeakRight = hold(f.PeakRight, value, &t.rightHeld, now, s.opts.PeakHold)
+	} else {
+		f.Left = value
+		f.PeakLeft = hold(f.PeakLeft, value, &t.leftHeld, now, s.opts.PeakHold)
+	}
+
+	power := (float64(f.Left)*float64(f.Left) + float64(f.Right)*float64(f.Right)) / 2
+	t.window = append(t.window, sample{time: now, power: power})
+	cutoff := now.Add(-s.opts.RMSWindow)
+	i := 0
+	for i < len(t.window) && t.window[i].time.Before(cutoff) {
+		i++
+	}
+	t.window = t.window[i:]
+
+	var sum float64
+	for _, smp := range t.window {
+		sum += smp.power
+	}
+	f.RMS = float32(math.Sqrt(sum / float64(len(t.window))))
+
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- *f:
+		default:
+		}
+	}
+}
+
+// hold returns the new held peak, which rises immediately and falls to the
+// current value once held for longer than duration
+func hold(peak, value float32, held *time.Time, now time.Time, duration time.Duration) float32 {
+	if value >= peak || now.Sub(*held) > duration {
+		*held = now
+		return value
+	}
+	return peak
+}
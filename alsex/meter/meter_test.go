@@ -0,0 +1,118 @@
+package meter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService() (*Service, *time.Time) {
+	s := New(nil, Opts{PeakHold: time.Second, RMSWindow: 500 * time.Millisecond})
+	now := time.Unix(0, 0).UTC()
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+// TestPeakHold verifies peaks hold then fall to the current level
+func TestPeakHold(t *testing.T) {
+	s, now := newTestService()
+
+	s.update(0, 0.8, false)
+	*now = now.Add(500 * time.Millisecond)
+	s.update(0, 0.2, false)
+	assert.Equal(t, float32(0.8), s.Levels()[0].PeakLeft)
+
+	*now = now.Add(time.Second)
+	s.update(0, 0.3, false)
+	assert.Equal(t, float32(0.3), s.Levels()[0].PeakLeft)
+	assert.Equal(t, float32(0), s.Levels()[0].PeakRight)
+}
+
+// TestRMS verifies RMS is averaged over the window per track
+func TestRMS(t *testing.T) {
+	s, now := newTestService()
+
+	s.update(1, 0.5, false)
+	s.update(1, 0.5, true)
+	// one reading per channel update: powers 0.125 and 0.25
+	assert.InDelta(t, 0.4330, s.Levels()[1].RMS, 1e-4)
+
+	// the old readings expire, leaving powers 0.125 and 0
+	*now = now.Add(time.Second)
+	s.update(1, 0, false)
+	s.update(1, 0, true)
+	assert.InDelta(t, 0.25, s.Levels()[1].RMS, 1e-4)
+	assert.Len(t, s.Levels(), 1)
+}
+
+// TestFanOut verifies every subscriber receives frames without blocking
+func TestFanOut(t *testing.T) {
+	s, _ := newTestService()
+	a, stopA := s.Subscribe(1)
+	b, stopB := s.Subscribe(4)
+	defer stopB()
+
+	s.update(2, 0.1, false)
+	s.update(2, 0.2, false) // dropped for a
+
+	assert.Equal(t, float32(0.1), (<-a).Left)
+	assert.Equal(t, float32(0.1), (<-b).Left)
+	assert.Equal(t, float32(0.2), (<-b).Left)
+
+	stopA()
+	stopA()
+	_, ok := <-a
+	assert.False(t, ok)
+}
+
+// TestListeners verifies meters are followed through the shared hub, so
+// stopping leaves listeners others use running
+func TestListeners(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/num_tracks", int32(2))
+	client := live.Client(t)
+
+	left := listen.Topic{Object: "track", Property: "output_meter_left", Indices: []int32{1}}
+	unsubscribe, err := listen.Shared(client).Subscribe(left, func(listen.Event) {})
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	s := New(client, Opts{})
+	s.Start()
+	live.WaitFor(t, "/live/track/start_listen/output_meter_right")
+	live.Emit("/live/track/get/output_meter_left", int32(1), float32(0.5))
+	assert.Eventually(t, func() bool { return s.Levels()[1].Left == 0.5 }, time.Second, 5*time.Millisecond)
+
+	s.Stop()
+	live.WaitFor(t, "/live/track/stop_listen/output_meter_left")
+	for _, msg := range live.Received("/live/track/stop_listen/output_meter_left") {
+		assert.Equal(t, []any{int32(0)}, msg.Arguments)
+	}
+	assert.Equal(t, 1, listen.Shared(client).Count(left))
+}
+
+// TestRecord verifies CSV and JSON recordings
+func TestRecord(t *testing.T) {
+	s, _ := newTestService()
+
+	var csvOut, jsonOut bytes.Buffer
+	stopCSV := s.Record(NewCSVRecorder(&csvOut))
+	stopJSON := s.Record(NewJSONRecorder(&jsonOut))
+
+	s.update(0, 0.5, false)
+	require.NoError(t, stopCSV())
+	require.NoError(t, stopJSON())
+
+	lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "time,track,left,right,peak_left,peak_right,rms", lines[0])
+	assert.Equal(t, "1970-01-01T00:00:00Z,0,0.5000,0.0000,0.5000,0.0000,0.3536", lines[1])
+
+	assert.Equal(t, `{"track":0,"time":"1970-01-01T00:00:00Z","left":0.5,"right":0,"peak_left":0.5,"peak_right":0,"rms":0.35355338}`+"\n", jsonOut.String())
+}
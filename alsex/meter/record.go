@@ -0,0 +1,100 @@
+package meter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Recorder writes frames for later analysis.
+type Recorder interface {
+	Write(f Frame) error
+	Flush() error
+}
+
+// CSVRecorder writes frames as CSV with a header row.
+type CSVRecorder struct {
+	w      *csv.Writer
+	header bool
+}
+
+func NewCSVRecorder(w io.Writer) *CSVRecorder {
+	return &CSVRecorder{w: csv.NewWriter(w)}
+}
+
+func (r *CSVRecorder) Write(f Frame) error {
+	if !r.header {
+		r.header = true
+		if err := r.w.Write([]string{"time", "track", "left", "right", "peak_left", "peak_right", "rms"}); err != nil {
+			return err
+		}
+	}
+	return r.w.Write([]string{
+		f.Time.Format(time.RFC3339Nano),
+		strconv.Itoa(int(f.Track)),
+		formatLevel(f.Left),
+		formatLevel(f.Right),
+		formatLevel(f.PeakLeft),
+		formatLevel(f.PeakRight),
+		formatLevel(f.RMS),
+	})
+}
+
+func (r *CSVRecorder) Flush() error {
+	r.w.Flush()
+	return r.w.Error()
+}
+
+// JSONRecorder writes frames as JSON lines.
+type JSONRecorder struct {
+	enc *json.Encoder
+}
+
+func NewJSONRecorder(w io.Writer) *JSONRecorder {
+	return &JSONRecorder{enc: json.NewEncoder(w)}
+}
+
+func (r *JSONRecorder) Write(f Frame) error {
+	return r.enc.Encode(f)
+}
+
+func (r *JSONRecorder) Flush() error {
+	return nil
+}
+
+// Record writes every frame to the recorder until the returned function is
+// called, which flushes the recorder and returns the first error.
+func (s *Service) Record(r Recorder) (stop func() error) {
+	frames, unsubscribe := s.Subscribe(1024)
+	done := make(chan error, 1)
+
+	go func() {
+		var err error
+		for f := range frames {
+			if err == nil {
+				err = r.Write(f)
+			}
+		}
+		if flushErr := r.Flush(); err == nil {
+			err = flushErr
+		}
+		done <- err
+	}()
+
+	var once sync.Once
+	var err error
+	return func() error {
+		once.Do(func() {
+			unsubscribe()
+			err = <-done
+		})
+		return err
+	}
+}
+
+func formatLevel(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', 4, 32)
+}
@@ -0,0 +1,183 @@
+package midi
+
+import (
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+)
+
+// FromClip reads a session clip into a single-track song using the current
+// tempo and time signature.
+func FromClip(client *als.Client, trackID, clipID int32) *Song {
+	song := newSong(client)
+	song.Tracks = append(song.Tracks, Track{
+		Name:  client.Clip.GetName(trackID, clipID),
+		Notes: client.Clip.GetNotes(trackID, clipID),
+	})
+	return song
+}
+
+// FromScene reads every MIDI clip in a scene, one track per Live track.
+func FromScene(client *als.Client, sceneID int32) *Song {
+	song := newSong(client)
+	names := client.Song.GetTrackNames()
+
+	for i, name := range names {
+		trackID := int32(i)
+		if !isMIDIClip(client, trackID, sceneID) {
+			continue
+		}
+		song.Tracks = append(song.Tracks, Track{
+			Name:  name,
+			Notes: client.Clip.GetNotes(trackID, sceneID),
+		})
+	}
+
+	return song
+}
+
+// FromClipByName reads the clip named clip on the track named track.
+func FromClipByName(client *als.Client, r *resolve.Resolver, track, clip string) (*Song, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return nil, err
+	}
+	clipID, err := r.Clip(trackID, clip)
+	if err != nil {
+		return nil, err
+	}
+	return FromClip(client, trackID, clipID), nil
+}
+
+// FromSceneByName reads the scene matching name.
+func FromSceneByName(client *als.Client, r *resolve.Resolver, scene string) (*Song, error) {
+	sceneID, err := r.Scene(scene)
+	if err != nil {
+		return nil, err
+	}
+	return FromScene(client, sceneID), nil
+}
+
+// FromSession lays out the session's scenes one after another. Each scene
+// lasts as long as its longest MIDI clip, and empty scenes are skipped.
+func FromSession(client *als.Client) *Song {
+	song := newSong(client)
+	names := client.Song.GetTrackNames()
+	numScenes := client.Song.GetNumScenes()
+
+	tracks := make([]Track, len(names))
+	for i, name := range names {
+		tracks[i].Name = name
+	}
+
+	var offset float32
+	for sceneID := int32(0); sceneID < numScenes; sceneID++ {
+		var sceneLength float32
+		for i := range tracks {
+			trackID := int32(i)
+			if !isMIDIClip(client, trackID, sceneID) {
+				continue
+			}
+
+			length := client.Clip.GetLength(trackID, sceneID)
+			if length > sceneLength {
+				sceneLength = length
+			}
+
+			for _, n := range client.Clip.GetNotes(trackID, sceneID) {
+				n.StartTime += offset
+				tracks[i].Notes = append(tracks[i].Notes, n)
+			}
+		}
+		offset += sceneLength
+	}
+
+	for _, t := range tracks {
+		if len(t.Notes) > 0 {
+			song.Tracks = append(song.Tracks, t)
+		}
+	}
+
+	return song
+}
+
+// FromArrangement rebuilds the arrangement from session clips. AbletonOSC
+// doesn't expose the notes of arrangement clips, so each arrangement clip is
+// matched by name to a session clip on the same track, whose notes are looped
+// to fill the arrangement clip from its start time.
+func FromArrangement(client *als.Client) *Song {
+	song := newSong(client)
+	names := client.Song.GetTrackNames()
+	numScenes := client.Song.GetNumScenes()
+
+	for i, name := range names {
+		trackID := int32(i)
+		clipNames := client.Track.GetArrangementClipsName(trackID)
+		startTimes := client.Track.GetArrangementClipsStartTime(trackID)
+		lengths := client.Track.GetArrangementClipsLength(trackID)
+		if len(clipNames) == 0 || len(clipNames) != len(startTimes) || len(clipNames) != len(lengths) {
+			continue
+		}
+
+		// index session clips on this track by name
+		sources := make(map[string]int32)
+		for sceneID := numScenes - 1; sceneID >= 0; sceneID-- {
+			if isMIDIClip(client, trackID, sceneID) {
+				sources[client.Clip.GetName(trackID, sceneID)] = sceneID
+			}
+		}
+
+		track := Track{Name: name}
+		for j, clipName := range clipNames {
+			sceneID, ok := sources[clipName]
+			if !ok {
+				continue
+			}
+			notes := client.Clip.GetNotes(trackID, sceneID)
+			loop := client.Clip.GetLength(trackID, sceneID)
+			track.Notes = append(track.Notes, loopNotes(notes, loop, startTimes[j], lengths[j])...)
+		}
+
+		if len(track.Notes) > 0 {
+			song.Tracks = append(song.Tracks, track)
+		}
+	}
+
+	return song
+}
+
+// loopNotes repeats notes every loop beats to fill length, offset to start.
+// Notes are truncated at the end of the region.
+func loopNotes(notes []als.Note, loop, start, length float32) []als.Note {
+	result := make([]als.Note, 0, len(notes))
+	if loop <= 0 {
+		loop = length
+	}
+
+	for pass := float32(0); pass < length; pass += loop {
+		for _, n := range notes {
+			if n.StartTime >= loop || pass+n.StartTime >= length {
+				continue
+			}
+			n.StartTime += start + pass
+			if end := start + length; n.StartTime+n.Duration > end {
+				n.Duration = end - n.StartTime
+			}
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+func newSong(client *als.Client) *Song {
+	return &Song{
+		Tempo:                client.Song.GetTempo(),
+		SignatureNumerator:   client.Song.GetSignatureNumerator(),
+		SignatureDenominator: client.Song.GetSignatureDenominator(),
+		Resolution:           DefaultResolution,
+	}
+}
+
+func isMIDIClip(client *als.Client, trackID, clipID int32) bool {
+	return client.ClipSlot.GetHasClip(trackID, clipID) && client.Clip.GetIsMIDIClip(trackID, clipID)
+}
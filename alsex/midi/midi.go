@@ -0,0 +1,222 @@
+package midi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// DefaultResolution is the number of ticks per quarter note used when a
+// Song doesn't specify one.
+const DefaultResolution = 480
+
+var (
+	ErrNoTracks       = errors.New("song has no tracks")
+	ErrInvalidTempo   = errors.New("tempo must be positive")
+	ErrInvalidChannel = errors.New("channel must be 0-15")
+)
+
+// Song is a multi-track Standard MIDI File in beats.
+type Song struct {
+	Name                 string
+	Tempo                float32 // BPM
+	SignatureNumerator   int32
+	SignatureDenominator int32
+	Resolution           int // ticks per quarter note
+	Tracks               []Track
+}
+
+// Track is a single MIDI track. Note start times are absolute beats from the
+// start of the song.
+type Track struct {
+	Name    string
+	Channel uint8
+	Notes   []als.Note
+}
+
+type event struct {
+	tick   int
+	order  int // note offs sort before note ons on the same tick
+	status byte
+	data   []byte
+}
+
+// WriteFile writes the song to path as a format 1 Standard MIDI File.
+func WriteFile(path string, song *Song) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if err := Write(w, song); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Write encodes the song as a format 1 Standard MIDI File. The first track
+// holds tempo and time signature, followed by one track per song track.
+func Write(w io.Writer, song *Song) error {
+	if len(song.Tracks) == 0 {
+		return ErrNoTracks
+	}
+	if song.Tempo <= 0 {
+		return ErrInvalidTempo
+	}
+
+	resolution := song.Resolution
+	if resolution <= 0 {
+		resolution = DefaultResolution
+	}
+
+	// header
+	header := make([]byte, 0, 14)
+	header = append(header, "MThd"...)
+	header = binary.BigEndian.AppendUint32(header, 6)
+	header = binary.BigEndian.AppendUint16(header, 1)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(song.Tracks)+1))
+	header = binary.BigEndian.AppendUint16(header, uint16(resolution))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	if err := writeChunk(w, conductorTrack(song)); err != nil {
+		return err
+	}
+
+	for _, track := range song.Tracks {
+		if track.Channel > 15 {
+			return ErrInvalidChannel
+		}
+		if err := writeChunk(w, noteTrack(track, resolution)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func conductorTrack(song *Song) []event {
+	events := make([]event, 0, 3)
+
+	if song.Name != "" {
+		events = append(events, metaEvent(0, 0x03, []byte(song.Name)))
+	}
+
+	// microseconds per quarter note
+	uspq := uint32(math.Round(60_000_000 / float64(song.Tempo)))
+	events = append(events, metaEvent(0, 0x51, []byte{byte(uspq >> 16), byte(uspq >> 8), byte(uspq)}))
+
+	num, denom := song.SignatureNumerator, song.SignatureDenominator
+	if num <= 0 {
+		num = 4
+	}
+	if denom <= 0 {
+		denom = 4
+	}
+	// denominator is stored as a power of two, 24 clocks per click, 8 32nds per quarter
+	events = append(events, metaEvent(0, 0x58, []byte{byte(num), byte(math.Log2(float64(denom))), 24, 8}))
+
+	return events
+}
+
+func noteTrack(track Track, resolution int) []event {
+	events := make([]event, 0, len(track.Notes)*2+1)
+
+	if track.Name != "" {
+		events = append(events, metaEvent(0, 0x03, []byte(track.Name)))
+	}
+
+	for _, n := range track.Notes {
+		if n.Mute || n.Pitch < 0 || n.Pitch > 127 {
+			continue
+		}
+
+		start := toTicks(n.StartTime, resolution)
+		end := toTicks(n.StartTime+n.Duration, resolution)
+		if end <= start {
+			end = start + 1
+		}
+
+		velocity := n.Velocity
+		if velocity < 1 {
+			velocity = 1
+		} else if velocity > 127 {
+			velocity = 127
+		}
+
+		events = append(events,
+			event{tick: start, order: 1, status: 0x90 | track.Channel, data: []byte{byte(n.Pitch), byte(velocity)}},
+			event{tick: end, order: 0, status: 0x80 | track.Channel, data: []byte{byte(n.Pitch), 0x40}},
+		)
+	}
+
+	return events
+}
+
+func metaEvent(tick int, kind byte, payload []byte) event {
+	data := []byte{kind}
+	data = appendVarInt(data, uint32(len(payload)))
+	data = append(data, payload...)
+	return event{tick: tick, status: 0xFF, data: data}
+}
+
+func writeChunk(w io.Writer, events []event) error {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].tick != events[j].tick {
+			return events[i].tick < events[j].tick
+		}
+		return events[i].order < events[j].order
+	})
+
+	var body bytes.Buffer
+	last := 0
+	for _, e := range events {
+		body.Write(appendVarInt(nil, uint32(e.tick-last)))
+		body.WriteByte(e.status)
+		body.Write(e.data)
+		last = e.tick
+	}
+	// end of track
+	body.Write([]byte{0x00, 0xFF, 0x2F, 0x00})
+
+	chunk := make([]byte, 0, 8+body.Len())
+	chunk = append(chunk, "MTrk"...)
+	chunk = binary.BigEndian.AppendUint32(chunk, uint32(body.Len()))
+	chunk = append(chunk, body.Bytes()...)
+
+	_, err := w.Write(chunk)
+	return err
+}
+
+func toTicks(beats float32, resolution int) int {
+	if beats <= 0 {
+		return 0
+	}
+	return int(math.Round(float64(beats) * float64(resolution)))
+}
+
+// appendVarInt appends v as a MIDI variable-length quantity
+func appendVarInt(b []byte, v uint32) []byte {
+	var buf [5]byte
+	i := len(buf) - 1
+	buf[i] = byte(v & 0x7F)
+	for v >>= 7; v > 0; v >>= 7 {
+		i--
+		buf[i] = byte(v&0x7F) | 0x80
+	}
+	return append(b, buf[i:]...)
+}
@@ -0,0 +1,131 @@
+package midi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAppendVarInt verifies variable-length quantity encoding
+func TestAppendVarInt(t *testing.T) {
+	tests := []struct {
+		value    uint32
+		expected []byte
+	}{
+		{0x00, []byte{0x00}},
+		{0x40, []byte{0x40}},
+		{0x7F, []byte{0x7F}},
+		{0x80, []byte{0x81, 0x00}},
+		{0x2000, []byte{0xC0, 0x00}},
+		{0x3FFF, []byte{0xFF, 0x7F}},
+		{0x4000, []byte{0x81, 0x80, 0x00}},
+		{0x0FFFFFFF, []byte{0xFF, 0xFF, 0xFF, 0x7F}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, appendVarInt(nil, tt.value), "value %#x", tt.value)
+	}
+}
+
+// TestWrite verifies the encoded file structure
+func TestWrite(t *testing.T) {
+	song := &Song{
+		Tempo:                120,
+		SignatureNumerator:   3,
+		SignatureDenominator: 8,
+		Resolution:           96,
+		Tracks: []Track{{
+			Name: "Bass",
+			Notes: []als.Note{
+				{Pitch: 60, StartTime: 0, Duration: 1, Velocity: 100},
+				{Pitch: 62, StartTime: 1, Duration: 1, Velocity: 90},
+				{Pitch: 64, StartTime: 2, Duration: 1, Velocity: 90, Mute: true},
+			},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, song))
+	data := buf.Bytes()
+
+	// header: format 1, two tracks, 96 ppq
+	assert.Equal(t, []byte("MThd\x00\x00\x00\x06\x00\x01\x00\x02\x00\x60"), data[:14])
+
+	conductor := []byte{
+		'M', 'T', 'r', 'k', 0x00, 0x00, 0x00, 0x13,
+		0x00, 0xFF, 0x51, 0x03, 0x07, 0xA1, 0x20, // 500000us per quarter
+		0x00, 0xFF, 0x58, 0x04, 0x03, 0x03, 0x18, 0x08, // 3/8
+		0x00, 0xFF, 0x2F, 0x00,
+	}
+	assert.Equal(t, conductor, data[14:14+len(conductor)])
+
+	notes := []byte{
+		'M', 'T', 'r', 'k', 0x00, 0x00, 0x00, 0x1C,
+		0x00, 0xFF, 0x03, 0x04, 'B', 'a', 's', 's',
+		0x00, 0x90, 60, 100,
+		0x60, 0x80, 60, 0x40, // note off sorts before the next note on
+		0x00, 0x90, 62, 90,
+		0x60, 0x80, 62, 0x40,
+		0x00, 0xFF, 0x2F, 0x00,
+	}
+	assert.Equal(t, notes, data[14+len(conductor):])
+}
+
+// TestWriteErrors verifies invalid songs are rejected
+func TestWriteErrors(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.ErrorIs(t, Write(&buf, &Song{Tempo: 120}), ErrNoTracks)
+	assert.ErrorIs(t, Write(&buf, &Song{Tracks: []Track{{}}}), ErrInvalidTempo)
+	assert.ErrorIs(t, Write(&buf, &Song{Tempo: 120, Tracks: []Track{{Channel: 16}}}), ErrInvalidChannel)
+}
+
+// TestLoopNotes verifies session clips are looped across arrangement regions
+func TestLoopNotes(t *testing.T) {
+	notes := []als.Note{
+		{Pitch: 60, StartTime: 0, Duration: 1, Velocity: 100},
+		{Pitch: 62, StartTime: 1.5, Duration: 1, Velocity: 100},
+	}
+
+	result := loopNotes(notes, 2, 8, 3)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, float32(8), result[0].StartTime)
+	assert.Equal(t, float32(9.5), result[1].StartTime)
+	assert.Equal(t, float32(10), result[2].StartTime)
+	assert.Equal(t, float32(1), result[2].Duration)
+}
+
+// TestFromClipByName verifies the resolved clip is the one read, and
+// ambiguous names are rejected
+func TestFromClipByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	live.Set("/live/track/get/clips/name", int32(1), nil, "Bassline", "Bassline B")
+	live.Set("/live/song/get/tempo", float32(120))
+	live.Set("/live/song/get/signature_numerator", int32(4))
+	live.Set("/live/song/get/signature_denominator", int32(4))
+	live.Set("/live/clip/get/name", int32(1), int32(2), "Bassline B")
+	live.Set("/live/clip/get/notes", int32(1), int32(2),
+		int32(36), float32(0), float32(1), int32(100), int32(0))
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	song, err := FromClipByName(client, r, "Bass", "Bassline B")
+	require.NoError(t, err)
+	require.Len(t, song.Tracks, 1)
+	assert.Equal(t, "Bassline B", song.Tracks[0].Name)
+	require.Len(t, song.Tracks[0].Notes, 1)
+	assert.Equal(t, int32(36), song.Tracks[0].Notes[0].Pitch)
+	msgs := live.Received("/live/clip/get/notes")
+	require.NotEmpty(t, msgs)
+	assert.Equal(t, []any{int32(1), int32(2)}, msgs[0].Arguments[:2])
+
+	_, err = FromClipByName(client, r, "Bass", "Bassline*")
+	assert.EqualError(t, err, `clip "Bassline*" is ambiguous, it matches 1 "Bassline", 2 "Bassline B"`)
+}
@@ -0,0 +1,124 @@
+package mod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLFO verifies LFO shapes and phase
+func TestLFO(t *testing.T) {
+	sine := NewLFO(Sine, 4)
+	assert.InDelta(t, 0.5, sine.Value(0), 1e-9)
+	assert.InDelta(t, 1, sine.Value(1), 1e-9)
+	assert.InDelta(t, 0, sine.Value(3), 1e-9)
+
+	triangle := NewLFO(Triangle, 2)
+	assert.InDelta(t, 1, triangle.Value(1), 1e-9)
+	assert.InDelta(t, 0.5, triangle.Value(2.5), 1e-9)
+
+	square := &LFO{Shape: Square, Period: 1, Phase: 0.5}
+	assert.Equal(t, 0.0, square.Value(0))
+	assert.Equal(t, 1.0, square.Value(0.5))
+}
+
+// TestRandomShapes verifies sample and hold and random walks are seeded
+// and stay in range
+func TestRandomShapes(t *testing.T) {
+	sh := &LFO{Shape: SampleHold, Period: 1, Seed: 3}
+	assert.Equal(t, sh.Value(2), sh.Value(2.9))
+	assert.NotEqual(t, sh.Value(2), sh.Value(3))
+
+	a, b := &LFO{Shape: RandomWalk, Period: 1, Seed: 3}, &LFO{Shape: RandomWalk, Period: 1, Seed: 3}
+	for beat := 0.0; beat < 64; beat += 0.25 {
+		v := a.Value(beat)
+		assert.Equal(t, v, b.Value(beat))
+		assert.GreaterOrEqual(t, v, 0.0)
+		assert.LessOrEqual(t, v, 1.0)
+	}
+}
+
+// TestADSR verifies envelope stages and release from mid attack
+func TestADSR(t *testing.T) {
+	env := &ADSR{Attack: 1, Decay: 1, Sustain: 0.5, Release: 2}
+	assert.Equal(t, 0.0, env.Value(0))
+	assert.True(t, env.Done(0))
+
+	env.NoteOn(4)
+	assert.InDelta(t, 0.5, env.Value(4.5), 1e-9)
+	assert.InDelta(t, 0.75, env.Value(5.5), 1e-9)
+	assert.InDelta(t, 0.5, env.Value(10), 1e-9)
+
+	env.NoteOff(10)
+	assert.InDelta(t, 0.25, env.Value(11), 1e-9)
+	assert.Equal(t, 0.0, env.Value(12))
+	assert.True(t, env.Done(12))
+
+	env.NoteOn(20)
+	env.NoteOff(20.5)
+	assert.InDelta(t, 0.25, env.Value(21.5), 1e-9)
+}
+
+// TestModulator verifies scaling, rate limiting and clean stops
+func TestModulator(t *testing.T) {
+	var sent []float32
+	value := float32(0.8)
+	target := Target{
+		Min: 0,
+		Max: 1,
+		Get: func() float32 { return value },
+		Set: func(v float32) { sent = append(sent, v) },
+	}
+
+	m := New(scheduler.New(nil, scheduler.Opts{}), target, NewLFO(Saw, 4), Opts{Min: 0.2, Max: 0.6, MaxRate: 10, Restore: true})
+	assert.InDelta(t, 0.4, m.Value(2), 1e-6)
+
+	m.Start()
+	now := time.Unix(0, 0)
+	m.update(0, now)
+	m.update(1, now.Add(50*time.Millisecond)) // too soon
+	m.update(1, now.Add(100*time.Millisecond))
+	m.update(5, now.Add(200*time.Millisecond)) // same value as beat 1
+	assert.Equal(t, []float32{0.2, 0.3}, sent)
+
+	m.Stop()
+	assert.False(t, m.Running())
+	m.update(2, now.Add(time.Second))
+	assert.Equal(t, []float32{0.2, 0.3, 0.8}, sent)
+
+	assert.Equal(t, 25, Limit(100, 2))
+	assert.Equal(t, DefaultMaxRate, Limit(0, 2))
+
+	// without a MaxRate, the client's rate limit decides
+	client := als.NewClient(oscclient.ClientOpts{RateLimit: 40})
+	defer client.Close()
+	m = New(scheduler.New(client, scheduler.Opts{}), target, NewLFO(Saw, 4), Opts{})
+	assert.Equal(t, 20, m.opts.MaxRate)
+	m = New(scheduler.New(nil, scheduler.Opts{}), target, NewLFO(Saw, 4), Opts{})
+	assert.Equal(t, DefaultMaxRate, m.opts.MaxRate)
+}
+
+// TestTargetByName verifies the resolved track is the one sent, and
+// ambiguous names are rejected
+func TestTargetByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass", "Vox 1", "Vox 2")
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	volume, err := VolumeByName(client.Track, r, "bass")
+	require.NoError(t, err)
+	volume.Set(0.5)
+	msgs := live.WaitFor(t, "/live/track/set/volume")
+	assert.Equal(t, []any{int32(1), float32(0.5)}, msgs[0].Arguments)
+
+	_, err = PanByName(client.Track, r, "Vox*")
+	assert.EqualError(t, err, `track "Vox*" is ambiguous, it matches 2 "Vox 1", 3 "Vox 2"`)
+}
@@ -0,0 +1,151 @@
+package mod
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+)
+
+const (
+	// DefaultResolution is how often a modulator updates, in beats.
+	DefaultResolution = 1.0 / 16
+	// DefaultMaxRate is the most updates a modulator sends per second when
+	// the client isn't rate limited.
+	DefaultMaxRate = 30
+)
+
+// Opts configures a Modulator.
+type Opts struct {
+	// Min and Max are the range the source is scaled to, in target units.
+	// When both are zero the target's full range is used.
+	Min, Max float32
+	// Resolution is how often the source is sampled, in beats.
+	Resolution float64
+	// MaxRate caps updates sent per second. When 0 it is derived from the
+	// scheduler's client with Limit, as if the modulator were the only one;
+	// use Limit to share the rate between several.
+	MaxRate int
+	// Restore puts the target back to its starting value on Stop.
+	Restore bool
+}
+
+// Limit returns a MaxRate that leaves room for other traffic when sharing a
+// client limited to rateLimit messages per second among n modulators.
+func Limit(rateLimit, n int) int {
+	if rateLimit <= 0 || n <= 0 {
+		return DefaultMaxRate
+	}
+	rate := rateLimit / (2 * n)
+	if rate < 1 {
+		rate = 1
+	}
+	return rate
+}
+
+// Modulator drives a target from a source, synced to the song position.
+type Modulator struct {
+	target    Target
+	source    Source
+	opts      Opts
+	scheduler *scheduler.Scheduler
+
+	mu      sync.Mutex
+	job     *scheduler.Job
+	running bool
+	start   float32
+	last    float32
+	sent    time.Time
+}
+
+// New creates a modulator. The scheduler must be running for it to update.
+func New(s *scheduler.Scheduler, target Target, source Source, opts Opts) *Modulator {
+	if opts.Min == 0 && opts.Max == 0 {
+		opts.Min, opts.Max = target.Min, target.Max
+	}
+	if opts.Resolution <= 0 {
+		opts.Resolution = DefaultResolution
+	}
+	if opts.MaxRate <= 0 {
+		opts.MaxRate = DefaultMaxRate
+		if client := s.Client(); client != nil {
+			opts.MaxRate = Limit(client.RateLimit(), 1)
+		}
+	}
+	return &Modulator{
+		target:    target,
+		source:    source,
+		opts:      opts,
+		scheduler: s,
+	}
+}
+
+// Start begins modulating. Starting a running modulator does nothing.
+func (m *Modulator) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.running {
+		return
+	}
+	if m.opts.Restore && m.target.Get != nil {
+		m.start = m.target.Get()
+	}
+	m.running = true
+	m.last = float32(math.NaN())
+	m.job = m.scheduler.Every(m.opts.Resolution, func(tick scheduler.Tick) {
+		m.update(tick.Beat, time.Now())
+	})
+}
+
+// Stop stops modulating. Once Stop returns no further updates are sent.
+func (m *Modulator) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.running {
+		return
+	}
+	m.running = false
+	m.job.Cancel()
+	m.job = nil
+	if m.opts.Restore {
+		m.target.Set(m.start)
+	}
+}
+
+// Running reports whether the modulator is started.
+func (m *Modulator) Running() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.running
+}
+
+// Value returns the target value for a beat.
+func (m *Modulator) Value(beat float64) float32 {
+	v := math.Max(0, math.Min(1, m.source.Value(beat)))
+	return m.opts.Min + float32(v)*(m.opts.Max-m.opts.Min)
+}
+
+// update sends the value for beat unless it's unchanged or too soon after
+// the last update
+func (m *Modulator) update(beat float64, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.running {
+		return
+	}
+	if now.Sub(m.sent) < time.Second/time.Duration(m.opts.MaxRate) {
+		return
+	}
+
+	value := m.Value(beat)
+	if value == m.last {
+		return
+	}
+	m.target.Set(value)
+	m.last = value
+	m.sent = now
+}
@@ -0,0 +1,173 @@
+package mod
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+)
+
+// Source produces a modulation value from 0 to 1 for a song position in
+// beats.
+type Source interface {
+	Value(beat float64) float64
+}
+
+// Shape is an LFO waveform.
+type Shape int
+
+const (
+	Sine Shape = iota
+	Triangle
+	Saw
+	Square
+	SampleHold // a new random value every cycle
+	RandomWalk // drifts randomly, moving at most Rate per cycle
+)
+
+// LFO is a tempo-synced low frequency oscillator.
+type LFO struct {
+	Shape  Shape
+	Period float64 // cycle length in beats
+	Phase  float64 // offset as a fraction of a cycle
+	Seed   uint64  // seed for SampleHold and RandomWalk
+
+	mu   sync.Mutex
+	walk float64
+	last float64
+	rng  *rand.Rand
+}
+
+// NewLFO creates an LFO with a cycle of period beats.
+func NewLFO(shape Shape, period float64) *LFO {
+	return &LFO{Shape: shape, Period: period}
+}
+
+func (l *LFO) Value(beat float64) float64 {
+	if l.Period <= 0 {
+		return 0.5
+	}
+	position := beat/l.Period + l.Phase
+	phase := position - math.Floor(position)
+
+	switch l.Shape {
+	case Sine:
+		return 0.5 + 0.5*math.Sin(2*math.Pi*phase)
+	case Triangle:
+		if phase < 0.5 {
+			return phase * 2
+		}
+		return 2 - phase*2
+	case Saw:
+		return phase
+	case Square:
+		if phase < 0.5 {
+			return 1
+		}
+		return 0
+	case SampleHold:
+		cycle := uint64(int64(math.Floor(position)))
+		return rand.New(rand.NewPCG(l.Seed, cycle)).Float64()
+	case RandomWalk:
+		return l.step(beat)
+	}
+	return 0.5
+}
+
+// step moves the random walk by a random amount scaled by the time since
+// the last value, reflecting off 0 and 1
+func (l *LFO) step(beat float64) float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rng == nil {
+		l.rng = rand.New(rand.NewPCG(l.Seed, l.Seed))
+		l.walk = 0.5
+		l.last = beat
+	}
+
+	elapsed := math.Abs(beat-l.last) / l.Period
+	l.last = beat
+	l.walk += (l.rng.Float64()*2 - 1) * elapsed
+
+	for l.walk < 0 || l.walk > 1 {
+		if l.walk < 0 {
+			l.walk = -l.walk
+		}
+		if l.walk > 1 {
+			l.walk = 2 - l.walk
+		}
+	}
+	return l.walk
+}
+
+// ADSR is an envelope triggered by NoteOn and released by NoteOff. Times
+// are in beats and Sustain is a level from 0 to 1.
+type ADSR struct {
+	Attack  float64
+	Decay   float64
+	Sustain float64
+	Release float64
+
+	mu      sync.Mutex
+	on      float64
+	off     float64
+	started bool
+	held    bool
+}
+
+// NoteOn starts the envelope at beat.
+func (e *ADSR) NoteOn(beat float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.on = beat
+	e.started = true
+	e.held = true
+}
+
+// NoteOff releases the envelope at beat.
+func (e *ADSR) NoteOff(beat float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.held {
+		e.off = beat
+		e.held = false
+	}
+}
+
+// Done reports whether the envelope has finished releasing at beat.
+func (e *ADSR) Done(beat float64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !e.started || (!e.held && beat >= e.off+e.Release)
+}
+
+func (e *ADSR) Value(beat float64) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.started || beat < e.on {
+		return 0
+	}
+	if e.held || beat < e.off {
+		return e.level(beat - e.on)
+	}
+
+	// release from wherever the envelope was when the note ended
+	from := e.level(e.off - e.on)
+	if e.Release <= 0 || beat >= e.off+e.Release {
+		return 0
+	}
+	return from * (1 - (beat-e.off)/e.Release)
+}
+
+// level is the attack, decay and sustain stages t beats after note on
+func (e *ADSR) level(t float64) float64 {
+	if t < e.Attack {
+		return t / e.Attack
+	}
+	t -= e.Attack
+	if t < e.Decay {
+		return 1 - (1-e.Sustain)*t/e.Decay
+	}
+	return e.Sustain
+}
@@ -0,0 +1,117 @@
+package mod
+
+import (
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+)
+
+// Target is a parameter a modulator drives.
+type Target struct {
+	Name string
+	Min  float32
+	Max  float32
+	Get  func() float32
+	Set  func(value float32)
+}
+
+// Volume targets a track's volume fader.
+func Volume(api *als.TrackAPI, trackID int32) Target {
+	return Target{
+		Name: "volume",
+		Min:  0,
+		Max:  1,
+		Get:  func() float32 { return api.GetVolume(trackID) },
+		Set:  func(v float32) { api.SetVolume(trackID, v) },
+	}
+}
+
+// Pan targets a track's panning.
+func Pan(api *als.TrackAPI, trackID int32) Target {
+	return Target{
+		Name: "pan",
+		Min:  -1,
+		Max:  1,
+		Get:  func() float32 { return api.GetPanning(trackID) },
+		Set:  func(v float32) { api.SetPanning(trackID, v) },
+	}
+}
+
+// Send targets one of a track's sends.
+func Send(api *als.TrackAPI, trackID, sendID int32) Target {
+	return Target{
+		Name: "send",
+		Min:  0,
+		Max:  1,
+		Get:  func() float32 { return api.GetSend(trackID, sendID) },
+		Set:  func(v float32) { api.SetSend(trackID, sendID, v) },
+	}
+}
+
+// Parameter targets a device parameter, reading its range from the device.
+func Parameter(api *als.DeviceAPI, trackID, deviceID, parameterID int32) Target {
+	t := Target{
+		Name: "parameter",
+		Min:  0,
+		Max:  1,
+		Get:  func() float32 { return api.GetParameterValue(trackID, deviceID, parameterID) },
+		Set:  func(v float32) { api.SetParameterValue(trackID, deviceID, parameterID, v) },
+	}
+	if names := api.GetParametersName(trackID, deviceID); int(parameterID) < len(names) {
+		t.Name = names[parameterID]
+	}
+	mins := api.GetParametersMin(trackID, deviceID)
+	maxs := api.GetParametersMax(trackID, deviceID)
+	if int(parameterID) < len(mins) && int(parameterID) < len(maxs) {
+		t.Min, t.Max = mins[parameterID], maxs[parameterID]
+	}
+	return t
+}
+
+// VolumeByName targets the volume fader of the track matching name. Like
+// the other ByName targets, the name is resolved when the target is made
+// and the target keeps that index, so build targets again after changing
+// the set's tracks or devices.
+func VolumeByName(api *als.TrackAPI, r *resolve.Resolver, track string) (Target, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return Target{}, err
+	}
+	return Volume(api, trackID), nil
+}
+
+// PanByName targets the panning of the track matching name.
+func PanByName(api *als.TrackAPI, r *resolve.Resolver, track string) (Target, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return Target{}, err
+	}
+	return Pan(api, trackID), nil
+}
+
+// SendByName targets one of the sends of the track matching name. Sends
+// are numbered, not named.
+func SendByName(api *als.TrackAPI, r *resolve.Resolver, track string, sendID int32) (Target, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return Target{}, err
+	}
+	return Send(api, trackID, sendID), nil
+}
+
+// ParameterByName targets a device parameter by track, device and
+// parameter name.
+func ParameterByName(api *als.DeviceAPI, r *resolve.Resolver, track, device, parameter string) (Target, error) {
+	trackID, err := r.Track(track)
+	if err != nil {
+		return Target{}, err
+	}
+	deviceID, err := r.Device(trackID, device)
+	if err != nil {
+		return Target{}, err
+	}
+	parameterID, err := r.Parameter(trackID, deviceID, parameter)
+	if err != nil {
+		return Target{}, err
+	}
+	return Parameter(api, trackID, deviceID, parameterID), nil
+}
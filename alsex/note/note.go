@@ -5,6 +5,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
+)
+
+const (
+	DefaultDuration float32 = 0.25
+	DefaultVelocity int32   = 100
 )
 
 type Note struct {
@@ -15,61 +21,99 @@ type Note struct {
 	Mute      bool
 }
 
-func New(note string) *Note {
-	return &Note{}
-}
+// New parses a note name with optional duration in beats and velocity,
+// e.g. "C4", "C4:0.5", "Eb3@90" or "F#2:1.5@110". Duration and velocity
+// default to DefaultDuration and DefaultVelocity.
+func New(note string) (*Note, error) {
+	n := &Note{
+		Duration: DefaultDuration,
+		Velocity: DefaultVelocity,
+	}
 
-var noteValues = map[string]int{
-	"C":  0,
-	"C#": 1,
-	"Db": 1,
-	"D":  2,
-	"D#": 3,
-	"Eb": 3,
-	"E":  4,
-	"F":  5,
-	"F#": 6,
-	"Gb": 6,
-	"G":  7,
-	"G#": 8,
-	"Ab": 8,
-	"A":  9,
-	"A#": 10,
-	"Bb": 10,
-	"B":  11,
-}
+	rest := strings.TrimSpace(note)
 
-func ToMidi(note string) (int32, error) {
-	if len(note) < 2 || len(note) > 4 {
-		return 0, errors.New("invalid note format")
+	if i := strings.IndexByte(rest, '@'); i >= 0 {
+		velocity, err := strconv.Atoi(strings.TrimSpace(rest[i+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid velocity: %w", err)
+		}
+		if velocity < 0 || velocity > 127 {
+			return nil, fmt.Errorf("velocity %d out of range (0-127)", velocity)
+		}
+		n.Velocity = int32(velocity)
+		rest = rest[:i]
 	}
 
-	octaveStart := -1
-	for i, c := range note {
-		if c >= '0' && c <= '9' || c == '-' {
-			octaveStart = i
-			break
+	if i := strings.IndexByte(rest, ':'); i >= 0 {
+		duration, err := strconv.ParseFloat(strings.TrimSpace(rest[i+1:]), 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
 		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("duration must be positive: %v", duration)
+		}
+		n.Duration = float32(duration)
+		rest = rest[:i]
+	}
+
+	pitch, err := ToMidi(rest)
+	if err != nil {
+		return nil, err
+	}
+	n.Pitch = pitch
+
+	return n, nil
+}
+
+var letterValues = map[rune]int32{'C': 0, 'D': 2, 'E': 4, 'F': 5, 'G': 7, 'A': 9, 'B': 11}
+
+var sharpNames = [12]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+var flatNames = [12]string{"C", "Db", "D", "Eb", "E", "F", "Gb", "G", "Ab", "A", "Bb", "B"}
+
+// PitchClass converts a note name without octave to a pitch class (0-11).
+// Any number of sharps or flats may follow the letter, e.g. "F#", "Bb", "C##".
+func PitchClass(name string) (int32, error) {
+	value, err := parseName(strings.TrimSpace(name))
+	if err != nil {
+		return 0, err
 	}
+	return ((value % 12) + 12) % 12, nil
+}
+
+// PitchClassName returns the name of a pitch class, spelled with sharps or
+// flats.
+func PitchClassName(pitchClass int32, flats bool) string {
+	pc := ((pitchClass % 12) + 12) % 12
+	if flats {
+		return flatNames[pc]
+	}
+	return sharpNames[pc]
+}
+
+// ToMidi converts a note name with octave to a MIDI pitch, where C4 is 60.
+// Names are case-insensitive and accept any number of sharps (#, ♯) or
+// flats (b, ♭), e.g. "C##4", "cb-1", "E♭3".
+func ToMidi(note string) (int32, error) {
+	note = strings.TrimSpace(note)
 
+	octaveStart := strings.IndexFunc(note, func(r rune) bool {
+		return unicode.IsDigit(r) || r == '-'
+	})
 	if octaveStart == -1 {
 		return 0, errors.New("no octave number found")
 	}
 
-	noteName := strings.TrimSpace(note[:octaveStart])
-	octaveStr := note[octaveStart:]
-
-	baseValue, exists := noteValues[noteName]
-	if !exists {
-		return 0, fmt.Errorf("unknown note: %s", noteName)
+	value, err := parseName(note[:octaveStart])
+	if err != nil {
+		return 0, err
 	}
 
-	octave, err := strconv.Atoi(octaveStr)
+	octave, err := strconv.Atoi(note[octaveStart:])
 	if err != nil {
 		return 0, fmt.Errorf("invalid octave: %w", err)
 	}
 
-	midiValue := int32((octave+1)*12 + baseValue)
+	midiValue := int32(octave+1)*12 + value
 
 	if midiValue < 0 || midiValue > 127 {
 		return 0, fmt.Errorf("MIDI value %d out of range (0-127)", midiValue)
@@ -77,3 +121,42 @@ func ToMidi(note string) (int32, error) {
 
 	return midiValue, nil
 }
+
+// FromMidi converts a MIDI pitch to a note name with octave, e.g. 61 is
+// "C#4", or "Db4" with flats.
+func FromMidi(pitch int32, flats bool) (string, error) {
+	if pitch < 0 || pitch > 127 {
+		return "", fmt.Errorf("MIDI value %d out of range (0-127)", pitch)
+	}
+	return PitchClassName(pitch, flats) + strconv.Itoa(int(pitch/12-1)), nil
+}
+
+// parseName returns the semitone offset of a note name from C, without
+// wrapping, so "Cb" is -1 and "B#" is 12.
+func parseName(name string) (int32, error) {
+	if name == "" {
+		return 0, errors.New("empty note name")
+	}
+
+	var value int32
+	for i, c := range name {
+		if i == 0 {
+			base, ok := letterValues[unicode.ToUpper(c)]
+			if !ok {
+				return 0, fmt.Errorf("unknown note: %s", name)
+			}
+			value = base
+			continue
+		}
+		switch c {
+		case '#', '♯':
+			value++
+		case 'b', '♭':
+			value--
+		default:
+			return 0, fmt.Errorf("unknown note: %s", name)
+		}
+	}
+
+	return value, nil
+}
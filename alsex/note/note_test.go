@@ -0,0 +1,108 @@
+package note
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestToMidi verifies note name parsing across spellings
+func TestToMidi(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int32
+	}{
+		{"C4", 60},
+		{"c4", 60},
+		{"C#4", 61},
+		{"Db4", 61},
+		{"C##4", 62},
+		{"Ebb4", 62},
+		{"C♯4", 61},
+		{"D♭4", 61},
+		{"B#3", 60},
+		{"Cb4", 59},
+		{"cb0", 11},
+		{"C-1", 0},
+		{"C#-1", 1},
+		{"G9", 127},
+		{" A4 ", 69},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pitch, err := ToMidi(tt.name)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, pitch)
+		})
+	}
+}
+
+// TestToMidiErrors verifies malformed and out of range names are rejected
+func TestToMidiErrors(t *testing.T) {
+	for _, name := range []string{"", "C", "H4", "C$4", "Cx", "G#9", "Cb-1", "C--1"} {
+		_, err := ToMidi(name)
+		assert.Error(t, err, name)
+	}
+}
+
+// TestRoundTrip verifies every MIDI pitch survives FromMidi -> ToMidi
+func TestRoundTrip(t *testing.T) {
+	for pitch := int32(0); pitch <= 127; pitch++ {
+		for _, flats := range []bool{false, true} {
+			name, err := FromMidi(pitch, flats)
+			require.NoError(t, err)
+
+			parsed, err := ToMidi(name)
+			require.NoError(t, err, name)
+			assert.Equal(t, pitch, parsed, name)
+		}
+	}
+}
+
+// TestFromMidi verifies sharp and flat spelling
+func TestFromMidi(t *testing.T) {
+	name, err := FromMidi(61, false)
+	require.NoError(t, err)
+	assert.Equal(t, "C#4", name)
+
+	name, err = FromMidi(61, true)
+	require.NoError(t, err)
+	assert.Equal(t, "Db4", name)
+
+	name, err = FromMidi(0, true)
+	require.NoError(t, err)
+	assert.Equal(t, "C-1", name)
+
+	_, err = FromMidi(128, false)
+	assert.Error(t, err)
+	_, err = FromMidi(-1, false)
+	assert.Error(t, err)
+}
+
+// TestNew verifies duration and velocity notation
+func TestNew(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Note
+	}{
+		{"C4", Note{Pitch: 60, Duration: DefaultDuration, Velocity: DefaultVelocity}},
+		{"C4:0.5@100", Note{Pitch: 60, Duration: 0.5, Velocity: 100}},
+		{"eb3:2", Note{Pitch: 51, Duration: 2, Velocity: DefaultVelocity}},
+		{"F#2@90", Note{Pitch: 42, Duration: DefaultDuration, Velocity: 90}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := New(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *n)
+		})
+	}
+
+	for _, input := range []string{"", "C4:", "C4:-1", "C4@", "C4@128", "X4:1@100"} {
+		_, err := New(input)
+		assert.Error(t, err, input)
+	}
+}
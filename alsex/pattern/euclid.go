@@ -0,0 +1,45 @@
+package pattern
+
+// Euclid distributes hits as evenly as possible over steps using Bjorklund's
+// algorithm, rotated left by rotation steps. Euclid(3, 8, 0) is x..x..x.
+func Euclid(hits, steps, rotation int) []bool {
+	if steps <= 0 {
+		return nil
+	}
+	if hits < 0 {
+		hits = 0
+	} else if hits > steps {
+		hits = steps
+	}
+
+	a := make([][]bool, hits)
+	for i := range a {
+		a[i] = []bool{true}
+	}
+	b := make([][]bool, steps-hits)
+	for i := range b {
+		b[i] = []bool{false}
+	}
+
+	for len(b) > 1 && len(a) > 0 {
+		m := min(len(a), len(b))
+		merged := make([][]bool, m)
+		for i := 0; i < m; i++ {
+			merged[i] = append(append([]bool{}, a[i]...), b[i]...)
+		}
+		if len(a) > m {
+			b = a[m:]
+		} else {
+			b = b[m:]
+		}
+		a = merged
+	}
+
+	result := make([]bool, 0, steps)
+	for _, group := range append(a, b...) {
+		result = append(result, group...)
+	}
+
+	r := ((rotation % steps) + steps) % steps
+	return append(result[r:], result[:r]...)
+}
@@ -0,0 +1,409 @@
+package pattern
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/matt0792/ableton-ctrl/alsex/note"
+)
+
+// Parse parses mini-notation in the spirit of Tidal and Strudel. Each cycle
+// is divided evenly between the steps of a sequence:
+//
+//	c3 e3 g3        three notes per cycle
+//	c3 [e3 g3]      subdivide a step
+//	c3 ~ e3 ~       rests
+//	[c3, e3, g3]    play layers together
+//	<c3 e3 g3>      one element per cycle
+//	{c3 e3 g3, c2 g2}%4  polymeter, four steps per cycle
+//	c3*2  c3/2      speed up or slow down a step
+//	c3!3  c3 !      replicate a step
+//	c3@3  c3 _ _    lengthen a step
+//	c3(3,8,2)       euclidean rhythm with optional rotation
+//
+// Notes are names as accepted by note.ToMidi, or MIDI numbers. Counts such
+// as repeats, speeds and euclid steps are limited to MaxCount.
+func Parse(src string) (*Pattern, error) {
+	p := &parser{src: []rune(src)}
+	root, err := p.layers(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos])
+	}
+	return &Pattern{src: src, root: root}, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(src string) *Pattern {
+	p, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// MaxCount is the largest count Parse accepts, so a typo like c3*10000000
+// is an error rather than millions of events.
+const MaxCount = 256
+
+type parser struct {
+	src []rune
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("pattern: position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) peek() rune {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) expect(r rune) error {
+	if p.peek() != r {
+		if p.pos >= len(p.src) {
+			return p.errorf("expected %q, got end of pattern", r)
+		}
+		return p.errorf("expected %q, got %q", r, p.src[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+// layers parses comma separated sequences up to close, stacking them
+func (p *parser) layers(close rune) (node, error) {
+	seqs, err := p.sequences(close)
+	if err != nil {
+		return nil, err
+	}
+	if len(seqs) == 1 {
+		return sequence{steps: seqs[0]}, nil
+	}
+
+	s := stack{}
+	for _, steps := range seqs {
+		s.layers = append(s.layers, sequence{steps: steps})
+	}
+	return s, nil
+}
+
+func (p *parser) sequences(close rune) ([][]step, error) {
+	seqs := make([][]step, 0, 1)
+	for {
+		steps, err := p.sequence(close)
+		if err != nil {
+			return nil, err
+		}
+		seqs = append(seqs, steps)
+
+		if p.peek() != ',' {
+			return seqs, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *parser) sequence(close rune) ([]step, error) {
+	steps := make([]step, 0)
+	for {
+		r := p.peek()
+		switch {
+		case r == 0 || r == ',' || r == close:
+			if len(steps) == 0 {
+				return nil, p.errorf("empty sequence")
+			}
+			return steps, nil
+
+		case r == ']' || r == '>' || r == '}':
+			return nil, p.errorf("unexpected %q", r)
+
+		case r == '_':
+			if len(steps) == 0 {
+				return nil, p.errorf("'_' without a preceding step")
+			}
+			p.pos++
+			steps[len(steps)-1].weight++
+
+		case r == '!' && len(steps) > 0:
+			p.pos++
+			steps = append(steps, steps[len(steps)-1])
+
+		default:
+			s, n, err := p.step()
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < n; i++ {
+				steps = append(steps, s)
+			}
+		}
+	}
+}
+
+// step parses an atom and its modifiers, returning the number of times it
+// is replicated
+func (p *parser) step() (step, int, error) {
+	n, err := p.atom()
+	if err != nil {
+		return step{}, 0, err
+	}
+
+	s := step{node: n, weight: 1}
+	count := 1
+
+	// modifiers bind directly to the atom, without whitespace
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '*':
+			p.pos++
+			factor, err := p.positive()
+			if err != nil {
+				return step{}, 0, err
+			}
+			s.node = fast{node: s.node, factor: factor}
+
+		case '/':
+			p.pos++
+			factor, err := p.positive()
+			if err != nil {
+				return step{}, 0, err
+			}
+			s.node = slow{node: s.node, factor: factor}
+
+		case '@':
+			p.pos++
+			weight, err := p.number()
+			if err != nil {
+				return step{}, 0, err
+			}
+			s.weight = weight
+
+		case '!':
+			p.pos++
+			if p.pos < len(p.src) && unicode.IsDigit(p.src[p.pos]) {
+				times, err := p.positive()
+				if err != nil {
+					return step{}, 0, err
+				}
+				count = times
+			} else {
+				count++
+			}
+
+		case '(':
+			p.pos++
+			hits, err := p.euclid()
+			if err != nil {
+				return step{}, 0, err
+			}
+			s.node = euclid{node: s.node, hits: hits}
+
+		default:
+			return s, count, nil
+		}
+	}
+
+	return s, count, nil
+}
+
+func (p *parser) atom() (node, error) {
+	r := p.peek()
+	switch {
+	case r == '~':
+		p.pos++
+		return rest{}, nil
+
+	case r == '[':
+		p.pos++
+		n, err := p.layers(']')
+		if err != nil {
+			return nil, err
+		}
+		return n, p.expect(']')
+
+	case r == '<':
+		p.pos++
+		seqs, err := p.sequences('>')
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect('>'); err != nil {
+			return nil, err
+		}
+		return alternations(seqs), nil
+
+	case r == '{':
+		p.pos++
+		return p.polymeter()
+
+	case isWordRune(r):
+		return p.word()
+
+	case r == 0:
+		return nil, p.errorf("unexpected end of pattern")
+	}
+
+	return nil, p.errorf("unexpected %q", r)
+}
+
+// alternations turns each layer of <...> into an alternation, stacking them
+func alternations(seqs [][]step) node {
+	layers := make([]node, 0, len(seqs))
+	for _, steps := range seqs {
+		a := alternation{}
+		for _, s := range steps {
+			a.options = append(a.options, s.node)
+		}
+		layers = append(layers, a)
+	}
+	if len(layers) == 1 {
+		return layers[0]
+	}
+	return stack{layers: layers}
+}
+
+func (p *parser) polymeter() (node, error) {
+	seqs, err := p.sequences('}')
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect('}'); err != nil {
+		return nil, err
+	}
+
+	pm := polymeter{steps: len(seqs[0])}
+	for _, steps := range seqs {
+		layer := make([]node, 0, len(steps))
+		for _, s := range steps {
+			layer = append(layer, s.node)
+		}
+		pm.layers = append(pm.layers, layer)
+	}
+
+	if p.pos < len(p.src) && p.src[p.pos] == '%' {
+		p.pos++
+		steps, err := p.positive()
+		if err != nil {
+			return nil, err
+		}
+		pm.steps = steps
+	}
+
+	return pm, nil
+}
+
+func (p *parser) euclid() ([]bool, error) {
+	args := make([]int, 0, 3)
+	for {
+		p.skipSpace()
+		v, err := p.integer()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			if len(args) < 2 || len(args) > 3 {
+				return nil, p.errorf("euclid takes 2 or 3 arguments, got %d", len(args))
+			}
+			if args[1] == 0 || args[0] > args[1] {
+				return nil, p.errorf("invalid euclid (%d,%d)", args[0], args[1])
+			}
+			rotation := 0
+			if len(args) == 3 {
+				rotation = args[2]
+			}
+			return Euclid(args[0], args[1], rotation), nil
+		default:
+			return nil, p.errorf("expected ',' or ')' in euclid")
+		}
+	}
+}
+
+func (p *parser) word() (node, error) {
+	start := p.pos
+	for p.pos < len(p.src) && isWordRune(p.src[p.pos]) {
+		p.pos++
+	}
+	w := string(p.src[start:p.pos])
+
+	if pitch, err := strconv.Atoi(w); err == nil {
+		if pitch < 0 || pitch > 127 {
+			p.pos = start
+			return nil, p.errorf("MIDI value %d out of range (0-127)", pitch)
+		}
+		return atom{pitch: int32(pitch)}, nil
+	}
+
+	pitch, err := note.ToMidi(w)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("%q: %v", w, err)
+	}
+	return atom{pitch: pitch}, nil
+}
+
+func (p *parser) integer() (int, error) {
+	start := p.pos
+	for p.pos < len(p.src) && unicode.IsDigit(p.src[p.pos]) {
+		p.pos++
+	}
+	digits := string(p.src[start:p.pos])
+	if digits == "" {
+		return 0, p.errorf("expected an integer")
+	}
+	v, err := strconv.Atoi(digits)
+	if err != nil || v > MaxCount {
+		p.pos = start
+		return 0, p.errorf("%s is more than %d", digits, MaxCount)
+	}
+	return v, nil
+}
+
+func (p *parser) positive() (int, error) {
+	start := p.pos
+	v, err := p.integer()
+	if err != nil {
+		return 0, err
+	}
+	if v == 0 {
+		p.pos = start
+		return 0, p.errorf("expected a positive integer")
+	}
+	return v, nil
+}
+
+func (p *parser) number() (float64, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	v, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+	if err != nil || v <= 0 {
+		p.pos = start
+		return 0, p.errorf("expected a positive number")
+	}
+	return v, nil
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("#-♯♭", r)
+}
@@ -0,0 +1,221 @@
+package pattern
+
+import (
+	"sort"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// DefaultVelocity is the velocity of every note a pattern produces.
+const DefaultVelocity int32 = 100
+
+// Event is a note onset within a cycle, in fractions of a cycle.
+type Event struct {
+	Pitch    int32
+	Start    float64
+	Duration float64
+}
+
+// Pattern is a parsed mini-notation pattern.
+type Pattern struct {
+	src  string
+	root node
+}
+
+// String returns the source the pattern was parsed from.
+func (p *Pattern) String() string {
+	return p.src
+}
+
+// Events returns the events of a single cycle, sorted by start time.
+// Alternations such as <a b> pick their element from the cycle number.
+func (p *Pattern) Events(cycle int) []Event {
+	events := p.root.render(cycle)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start < events[j].Start
+	})
+	return events
+}
+
+// Notes renders the given number of cycles back to back, each lasting
+// cycleLength beats.
+func (p *Pattern) Notes(cycles int, cycleLength float32) []als.Note {
+	notes := make([]als.Note, 0)
+	for c := 0; c < cycles; c++ {
+		offset := float64(c) * float64(cycleLength)
+		for _, e := range p.Events(c) {
+			notes = append(notes, als.Note{
+				Pitch:     e.Pitch,
+				StartTime: float32(offset + e.Start*float64(cycleLength)),
+				Duration:  float32(e.Duration * float64(cycleLength)),
+				Velocity:  DefaultVelocity,
+			})
+		}
+	}
+	return notes
+}
+
+// node renders one cycle of events in [0, 1)
+type node interface {
+	render(cycle int) []Event
+}
+
+type atom struct {
+	pitch int32
+}
+
+func (a atom) render(int) []Event {
+	return []Event{{Pitch: a.pitch, Start: 0, Duration: 1}}
+}
+
+type rest struct{}
+
+func (rest) render(int) []Event {
+	return nil
+}
+
+type step struct {
+	node   node
+	weight float64
+}
+
+// sequence divides the cycle between its steps by weight
+type sequence struct {
+	steps []step
+}
+
+func (s sequence) render(cycle int) []Event {
+	var total float64
+	for _, st := range s.steps {
+		total += st.weight
+	}
+	if total == 0 {
+		return nil
+	}
+
+	events := make([]Event, 0)
+	var pos float64
+	for _, st := range s.steps {
+		span := st.weight / total
+		events = append(events, place(st.node.render(cycle), pos, span)...)
+		pos += span
+	}
+	return events
+}
+
+// stack plays its layers simultaneously
+type stack struct {
+	layers []node
+}
+
+func (s stack) render(cycle int) []Event {
+	events := make([]Event, 0)
+	for _, layer := range s.layers {
+		events = append(events, layer.render(cycle)...)
+	}
+	return events
+}
+
+// alternation plays one element per cycle
+type alternation struct {
+	options []node
+}
+
+func (a alternation) render(cycle int) []Event {
+	n := len(a.options)
+	i := ((cycle % n) + n) % n
+	return a.options[i].render(floorDiv(cycle, n))
+}
+
+// polymeter steps through each layer at steps per cycle, so layers of
+// different lengths drift against each other
+type polymeter struct {
+	layers [][]node
+	steps  int
+}
+
+func (p polymeter) render(cycle int) []Event {
+	events := make([]Event, 0)
+	span := 1 / float64(p.steps)
+	for _, layer := range p.layers {
+		for j := 0; j < p.steps; j++ {
+			abs := cycle*p.steps + j
+			n := len(layer)
+			i := ((abs % n) + n) % n
+			events = append(events, place(layer[i].render(floorDiv(abs, n)), float64(j)*span, span)...)
+		}
+	}
+	return events
+}
+
+// fast repeats its node factor times per cycle
+type fast struct {
+	node   node
+	factor int
+}
+
+func (f fast) render(cycle int) []Event {
+	events := make([]Event, 0)
+	span := 1 / float64(f.factor)
+	for k := 0; k < f.factor; k++ {
+		events = append(events, place(f.node.render(cycle*f.factor+k), float64(k)*span, span)...)
+	}
+	return events
+}
+
+// slow stretches its node over factor cycles
+type slow struct {
+	node   node
+	factor int
+}
+
+func (s slow) render(cycle int) []Event {
+	part := ((cycle % s.factor) + s.factor) % s.factor
+	from := float64(part) / float64(s.factor)
+	to := float64(part+1) / float64(s.factor)
+
+	events := make([]Event, 0)
+	for _, e := range s.node.render(floorDiv(cycle, s.factor)) {
+		if e.Start < from || e.Start >= to {
+			continue
+		}
+		e.Start = (e.Start - from) * float64(s.factor)
+		e.Duration *= float64(s.factor)
+		events = append(events, e)
+	}
+	return events
+}
+
+// euclid plays its node on the hits of a euclidean rhythm
+type euclid struct {
+	node node
+	hits []bool
+}
+
+func (e euclid) render(cycle int) []Event {
+	events := make([]Event, 0)
+	span := 1 / float64(len(e.hits))
+	for i, hit := range e.hits {
+		if hit {
+			events = append(events, place(e.node.render(cycle), float64(i)*span, span)...)
+		}
+	}
+	return events
+}
+
+// place scales events from a whole cycle into [pos, pos+span)
+func place(events []Event, pos, span float64) []Event {
+	for i := range events {
+		events[i].Start = pos + events[i].Start*span
+		events[i].Duration *= span
+	}
+	return events
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
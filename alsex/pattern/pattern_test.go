@@ -0,0 +1,185 @@
+package pattern
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertOnsets compares pitch and start pairs
+func assertOnsets(t *testing.T, expected [][2]float64, events []Event) {
+	t.Helper()
+	require.Len(t, events, len(expected))
+	for i, e := range events {
+		assert.Equal(t, int32(expected[i][0]), e.Pitch, "event %d", i)
+		assert.InDelta(t, expected[i][1], e.Start, 1e-9, "event %d", i)
+	}
+}
+
+// TestParse verifies each notation renders the expected first cycle
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected [][2]float64
+	}{
+		{"sequence", "c3 e3 g3 c4", [][2]float64{{48, 0}, {52, 0.25}, {55, 0.5}, {60, 0.75}}},
+		{"midi numbers", "60 64", [][2]float64{{60, 0}, {64, 0.5}}},
+		{"subdivision", "c3 [e3 g3]", [][2]float64{{48, 0}, {52, 0.5}, {55, 0.75}}},
+		{"rest", "c3 ~ e3 ~", [][2]float64{{48, 0}, {52, 0.5}}},
+		{"stack", "[c3, e3]", [][2]float64{{48, 0}, {52, 0}}},
+		{"top level stack", "c3 e3, g2", [][2]float64{{48, 0}, {43, 0}, {52, 0.5}}},
+		{"fast", "c3*2 e3", [][2]float64{{48, 0}, {48, 0.25}, {52, 0.5}}},
+		{"replicate", "c3!3 e3", [][2]float64{{48, 0}, {48, 0.25}, {48, 0.5}, {52, 0.75}}},
+		{"bare replicate", "c3 ! e3", [][2]float64{{48, 0}, {48, 1.0 / 3}, {52, 2.0 / 3}}},
+		{"elongate", "c3@3 e3", [][2]float64{{48, 0}, {52, 0.75}}},
+		{"underscore", "c3 _ _ e3", [][2]float64{{48, 0}, {52, 0.75}}},
+		{"euclid", "c3(3,8)", [][2]float64{{48, 0}, {48, 0.375}, {48, 0.75}}},
+		{"euclid rotated", "c3(3,8,1)", [][2]float64{{48, 0.25}, {48, 0.625}, {48, 0.875}}},
+		{"polymeter", "{c3 e3 g3}%4", [][2]float64{{48, 0}, {52, 0.25}, {55, 0.5}, {48, 0.75}}},
+		{"alternation", "c3 <e3 g3>", [][2]float64{{48, 0}, {52, 0.5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.src)
+			require.NoError(t, err)
+			assertOnsets(t, tt.expected, p.Events(0))
+		})
+	}
+}
+
+// TestDurations verifies event lengths follow their step sizes
+func TestDurations(t *testing.T) {
+	events := MustParse("c3@3 [e3 g3]").Events(0)
+	require.Len(t, events, 3)
+	assert.InDelta(t, 0.75, events[0].Duration, 1e-9)
+	assert.InDelta(t, 0.125, events[1].Duration, 1e-9)
+	assert.InDelta(t, 0.125, events[2].Duration, 1e-9)
+}
+
+// TestAlternationAcrossCycles verifies <> advances once per cycle
+func TestAlternationAcrossCycles(t *testing.T) {
+	p := MustParse("c3 ~ e3 ~ <a3 b3>")
+
+	pitches := func(cycle int) []int32 {
+		var result []int32
+		for _, e := range p.Events(cycle) {
+			result = append(result, e.Pitch)
+		}
+		return result
+	}
+
+	assert.Equal(t, []int32{48, 52, 57}, pitches(0))
+	assert.Equal(t, []int32{48, 52, 59}, pitches(1))
+	assert.Equal(t, []int32{48, 52, 57}, pitches(2))
+
+	// speeding up an alternation steps through it within a cycle
+	fast := MustParse("<c3 e3>*2").Events(0)
+	require.Len(t, fast, 2)
+	assert.Equal(t, int32(48), fast[0].Pitch)
+	assert.Equal(t, int32(52), fast[1].Pitch)
+}
+
+// TestPolymeterAcrossCycles verifies layers continue where they left off
+func TestPolymeterAcrossCycles(t *testing.T) {
+	p := MustParse("{c3 e3 g3, c2 g2}")
+
+	var first, second []int32
+	for _, e := range p.Events(1) {
+		if e.Pitch >= 48 {
+			first = append(first, e.Pitch)
+		} else {
+			second = append(second, e.Pitch)
+		}
+	}
+
+	assert.Equal(t, []int32{48, 52, 55}, first)
+	assert.Equal(t, []int32{43, 36, 43}, second)
+}
+
+// TestSlow verifies a slowed step spans several cycles
+func TestSlow(t *testing.T) {
+	p := MustParse("[c3 e3]/2")
+
+	first := p.Events(0)
+	require.Len(t, first, 1)
+	assert.Equal(t, int32(48), first[0].Pitch)
+	assert.InDelta(t, 1, first[0].Duration, 1e-9)
+
+	second := p.Events(1)
+	require.Len(t, second, 1)
+	assert.Equal(t, int32(52), second[0].Pitch)
+}
+
+// TestNotes verifies rendering cycles into beats
+func TestNotes(t *testing.T) {
+	notes := MustParse("c3 <e3 g3>").Notes(2, 4)
+	require.Len(t, notes, 4)
+
+	assert.Equal(t, float32(0), notes[0].StartTime)
+	assert.Equal(t, float32(2), notes[0].Duration)
+	assert.Equal(t, float32(2), notes[1].StartTime)
+	assert.Equal(t, int32(52), notes[1].Pitch)
+	assert.Equal(t, float32(6), notes[3].StartTime)
+	assert.Equal(t, int32(55), notes[3].Pitch)
+	assert.Equal(t, DefaultVelocity, notes[3].Velocity)
+}
+
+// TestEuclid verifies Bjorklund distributions
+func TestEuclid(t *testing.T) {
+	str := func(hits []bool) string {
+		b := make([]byte, len(hits))
+		for i, h := range hits {
+			b[i] = '.'
+			if h {
+				b[i] = 'x'
+			}
+		}
+		return string(b)
+	}
+
+	assert.Equal(t, "x..x..x.", str(Euclid(3, 8, 0)))
+	assert.Equal(t, "x.xx.xx.", str(Euclid(5, 8, 0)))
+	assert.Equal(t, "x.x.x", str(Euclid(3, 5, 0)))
+	assert.Equal(t, "..x..x.x", str(Euclid(3, 8, 1)))
+	assert.Equal(t, "....", str(Euclid(0, 4, 0)))
+	assert.Equal(t, "xxxx", str(Euclid(4, 4, 0)))
+	assert.Nil(t, Euclid(1, 0, 0))
+}
+
+// TestParseErrors verifies malformed patterns are rejected
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		"",
+		"c3 [e3",
+		"c3 e3]",
+		"<c3",
+		"{c3 e3",
+		"c3*",
+		"c3*0",
+		"c3(3)",
+		"c3(9,8)",
+		"c3(3,8",
+		"x9",
+		"200",
+		"_ c3",
+		"c3 [] e3",
+		"c3, , e3",
+		"c3 @2",
+		"c3*100000000",
+		"c3/257",
+		"c3!1000",
+		"{c3 e3}%99999999999999999999",
+		"c3(3,100000000)",
+	} {
+		_, err := Parse(src)
+		assert.Error(t, err, src)
+	}
+
+	_, err := Parse("c3*100000000")
+	assert.EqualError(t, err, "pattern: position 3: 100000000 is more than 256")
+	_, err = Parse("c3*256")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,83 @@
+package resolve
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Pattern matches object names, ignoring case. Plain text matches a whole
+// name, text with * ? or [ is a glob, and text between slashes is a regular
+// expression:
+//
+//	Bass            the track named Bass
+//	Drum*           Drums, Drum Bus
+//	Vox [12]        Vox 1, Vox 2
+//	/^(kick|snare)/ Kick In, Snare Top
+type Pattern struct {
+	text string
+	re   *regexp.Regexp // nil for plain names
+}
+
+// Compile parses a pattern.
+func Compile(s string) (Pattern, error) {
+	p := Pattern{text: s}
+	switch {
+	case len(s) >= 2 && strings.HasPrefix(s, "/") && strings.HasSuffix(s, "/"):
+		re, err := regexp.Compile("(?i)" + s[1:len(s)-1])
+		if err != nil {
+			return p, fmt.Errorf("invalid pattern %q: %w", s, err)
+		}
+		p.re = re
+	case strings.ContainsAny(s, "*?["):
+		re, err := glob(s)
+		if err != nil {
+			return p, fmt.Errorf("invalid pattern %q: %w", s, err)
+		}
+		p.re = re
+	}
+	return p, nil
+}
+
+// Match reports whether name matches.
+func (p Pattern) Match(name string) bool {
+	if p.re == nil {
+		return strings.EqualFold(name, p.text)
+	}
+	return p.re.MatchString(name)
+}
+
+func (p Pattern) String() string {
+	return p.text
+}
+
+// glob converts a glob to an anchored regular expression. Unlike
+// path.Match, * also matches slashes, which are just characters in names.
+func glob(s string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("(?i)^")
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '[':
+			end := strings.IndexRune(string(runes[i+1:]), ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [")
+			}
+			class := []rune(string(runes[i+1:])[:end])
+			if len(class) > 0 && class[0] == '!' {
+				class[0] = '^'
+			}
+			b.WriteString("[" + strings.ReplaceAll(string(class), `\`, `\\`) + "]")
+			i += len(class) + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
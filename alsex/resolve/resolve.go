@@ -0,0 +1,382 @@
+// Package resolve addresses tracks, scenes, clips, devices and parameters by
+// name instead of index, so code keeps working when someone inserts a track.
+//
+// Names are read from Live once and cached. Started resolvers follow Live's
+// listeners: adding or removing tracks or scenes drops every cached name,
+// and renaming a track, scene or clip drops its list. Devices and
+// parameters have no listeners, so a lookup that finds nothing reads them
+// again before failing.
+//
+// A name is only resolved when looked up. Handles made from one, such as
+// track.NewByName, keep the index found then; ident follows objects as they
+// move.
+package resolve
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// Resolver maps names to indices.
+type Resolver struct {
+	client *als.Client
+	hub    *listen.Hub
+
+	mu      sync.Mutex
+	started bool
+	tracks  []string // nil when not read yet
+	scenes  []string
+	clips   map[int32][]string
+	devices map[int32][]string
+	params  map[[2]int32][]string
+
+	// song listeners, and listeners on names by index, which are dropped
+	// when indices move
+	unsubscribe []func()
+	named       map[string]func()
+	moved       bool
+}
+
+// New creates a resolver. Names are cached once read, so call Start to
+// follow changes in Live, or Invalidate after changing the set.
+func New(client *als.Client) *Resolver {
+	r := &Resolver{client: client, hub: listen.Shared(client)}
+	r.reset()
+	return r
+}
+
+// Start listens for structural changes to keep the cache current.
+func (r *Resolver) Start() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.started {
+		return nil
+	}
+	for _, prop := range []string{"num_tracks", "num_scenes"} {
+		unsubscribe, err := r.hub.Subscribe(listen.Topic{Object: "song", Property: prop}, func(listen.Event) {
+			r.mu.Lock()
+			r.reset()
+			r.moved = true
+			r.mu.Unlock()
+		})
+		if err != nil {
+			return err
+		}
+		r.unsubscribe = append(r.unsubscribe, unsubscribe)
+	}
+	r.started = true
+	return nil
+}
+
+// Stop stops listening. Names cached after Stop go stale.
+func (r *Resolver) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, unsubscribe := range r.unsubscribe {
+		unsubscribe()
+	}
+	r.unsubscribe = nil
+	r.dropNamed()
+	r.started = false
+}
+
+// Invalidate drops every cached name.
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reset()
+}
+
+// reset empties the cache. The caller holds mu.
+func (r *Resolver) reset() {
+	r.tracks = nil
+	r.scenes = nil
+	r.clips = map[int32][]string{}
+	r.devices = map[int32][]string{}
+	r.params = map[[2]int32][]string{}
+}
+
+// Track returns the index of the one track matching pattern.
+func (r *Resolver) Track(pattern string) (int32, error) {
+	return r.one("track", pattern, r.trackNames)
+}
+
+// Tracks returns the indices of the tracks matching pattern, in order.
+func (r *Resolver) Tracks(pattern string) ([]int32, error) {
+	return r.all(pattern, r.trackNames)
+}
+
+// Scene returns the index of the one scene matching pattern.
+func (r *Resolver) Scene(pattern string) (int32, error) {
+	return r.one("scene", pattern, r.sceneNames)
+}
+
+// Scenes returns the indices of the scenes matching pattern.
+func (r *Resolver) Scenes(pattern string) ([]int32, error) {
+	return r.all(pattern, r.sceneNames)
+}
+
+// Clip returns the slot of the one clip on the track matching pattern.
+func (r *Resolver) Clip(track int32, pattern string) (int32, error) {
+	return r.one("clip", pattern, r.clipNames(track))
+}
+
+// Clips returns the slots of the clips on the track matching pattern.
+func (r *Resolver) Clips(track int32, pattern string) ([]int32, error) {
+	return r.all(pattern, r.clipNames(track))
+}
+
+// Device returns the index of the one device on the track matching pattern.
+func (r *Resolver) Device(track int32, pattern string) (int32, error) {
+	return r.one("device", pattern, r.deviceNames(track))
+}
+
+// Devices returns the indices of the devices on the track matching pattern.
+func (r *Resolver) Devices(track int32, pattern string) ([]int32, error) {
+	return r.all(pattern, r.deviceNames(track))
+}
+
+// Parameter returns the index of the one parameter of the device matching
+// pattern.
+func (r *Resolver) Parameter(track, device int32, pattern string) (int32, error) {
+	return r.one("parameter", pattern, r.paramNames(track, device))
+}
+
+// TrackNames returns the track names by index, for listing or completion.
+func (r *Resolver) TrackNames() ([]string, error) {
+	return r.trackNames(false)
+}
+
+// SceneNames returns the scene names by index.
+func (r *Resolver) SceneNames() ([]string, error) {
+	return r.sceneNames(false)
+}
+
+// DeviceNames returns the names of the devices on the track by index.
+func (r *Resolver) DeviceNames(track int32) ([]string, error) {
+	return r.deviceNames(track)(false)
+}
+
+// names reads a list of names, fresh when refresh is set
+type names func(refresh bool) ([]string, error)
+
+// one resolves a pattern that must match exactly one name
+func (r *Resolver) one(kind, pattern string, list names) (int32, error) {
+	matches, all, err := r.match(pattern, list)
+	if err != nil {
+		return 0, err
+	}
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no %s matches %q", kind, pattern)
+	case 1:
+		return matches[0], nil
+	}
+
+	found := make([]string, len(matches))
+	for i, index := range matches {
+		found[i] = fmt.Sprintf("%d %q", index, all[index])
+	}
+	return 0, fmt.Errorf("%s %q is ambiguous, it matches %s", kind, pattern, strings.Join(found, ", "))
+}
+
+// all resolves a pattern to every matching index
+func (r *Resolver) all(pattern string, list names) ([]int32, error) {
+	matches, _, err := r.match(pattern, list)
+	return matches, err
+}
+
+// match returns the indices matching pattern and the names they index.
+// Finding nothing reads the names again, in case they changed without a
+// listener noticing.
+func (r *Resolver) match(pattern string, list names) ([]int32, []string, error) {
+	p, err := Compile(pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matches []int32
+	var all []string
+	for _, refresh := range []bool{false, true} {
+		if all, err = list(refresh); err != nil {
+			return nil, nil, err
+		}
+		matches = matches[:0]
+		for i, name := range all {
+			if name != "" && p.Match(name) {
+				matches = append(matches, int32(i))
+			}
+		}
+		if len(matches) > 0 {
+			break
+		}
+	}
+	return matches, all, nil
+}
+
+func (r *Resolver) trackNames(refresh bool) ([]string, error) {
+	r.sync()
+	r.mu.Lock()
+	cached := r.tracks
+	r.mu.Unlock()
+	if cached != nil && !refresh {
+		return cached, nil
+	}
+
+	args, err := r.client.Get("/live/song/get/track_names")
+	if err != nil {
+		return nil, err
+	}
+	list := als.Strings(args)
+	r.mu.Lock()
+	r.tracks = list
+	r.mu.Unlock()
+
+	for i := range list {
+		r.watch(listen.Topic{Object: "track", Property: "name", Indices: []int32{int32(i)}}, func() { r.tracks = nil })
+	}
+	return list, nil
+}
+
+func (r *Resolver) sceneNames(refresh bool) ([]string, error) {
+	r.sync()
+	r.mu.Lock()
+	cached := r.scenes
+	r.mu.Unlock()
+	if cached != nil && !refresh {
+		return cached, nil
+	}
+
+	list, err := r.client.SceneNames()
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.scenes = list
+	r.mu.Unlock()
+
+	for i := range list {
+		r.watch(listen.Topic{Object: "scene", Property: "name", Indices: []int32{int32(i)}}, func() { r.scenes = nil })
+	}
+	return list, nil
+}
+
+// clipNames lists a track's clip names by slot, empty for empty slots
+func (r *Resolver) clipNames(track int32) names {
+	return func(refresh bool) ([]string, error) {
+		r.sync()
+		r.mu.Lock()
+		cached, ok := r.clips[track]
+		r.mu.Unlock()
+		if ok && !refresh {
+			return cached, nil
+		}
+
+		args, err := r.client.Get("/live/track/get/clips/name", track)
+		if err != nil {
+			return nil, err
+		}
+		list := als.Strings(args)
+		r.mu.Lock()
+		r.clips[track] = list
+		r.mu.Unlock()
+
+		for slot, name := range list {
+			if name != "" {
+				r.watch(listen.Topic{Object: "clip", Property: "name", Indices: []int32{track, int32(slot)}}, func() { delete(r.clips, track) })
+			}
+		}
+		return list, nil
+	}
+}
+
+func (r *Resolver) deviceNames(track int32) names {
+	return func(refresh bool) ([]string, error) {
+		r.mu.Lock()
+		cached, ok := r.devices[track]
+		r.mu.Unlock()
+		if ok && !refresh {
+			return cached, nil
+		}
+
+		args, err := r.client.Get("/live/track/get/devices/name", track)
+		if err != nil {
+			return nil, err
+		}
+		list := als.Strings(args)
+		r.mu.Lock()
+		r.devices[track] = list
+		r.mu.Unlock()
+		return list, nil
+	}
+}
+
+func (r *Resolver) paramNames(track, device int32) names {
+	key := [2]int32{track, device}
+	return func(refresh bool) ([]string, error) {
+		r.mu.Lock()
+		cached, ok := r.params[key]
+		r.mu.Unlock()
+		if ok && !refresh {
+			return cached, nil
+		}
+
+		args, err := r.client.Get("/live/device/get/parameters/name", track, device)
+		if err != nil {
+			return nil, err
+		}
+		list := als.Strings(args)
+		r.mu.Lock()
+		r.params[key] = list
+		r.mu.Unlock()
+		return list, nil
+	}
+}
+
+// watch drops part of the cache when a name changes. drop runs with mu
+// held. Only started resolvers watch.
+func (r *Resolver) watch(t listen.Topic, drop func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := t.String()
+	if !r.started || r.named[key] != nil {
+		return
+	}
+
+	unsubscribe, err := r.hub.Subscribe(t, func(listen.Event) {
+		r.mu.Lock()
+		drop()
+		r.mu.Unlock()
+	})
+	if err != nil {
+		return
+	}
+	if r.named == nil {
+		r.named = map[string]func(){}
+	}
+	r.named[key] = unsubscribe
+}
+
+// sync drops the name listeners after indices have moved, as they may now
+// watch the wrong objects. It runs on the caller's goroutine rather than in
+// the listener, which must not block.
+func (r *Resolver) sync() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.moved {
+		r.dropNamed()
+		r.moved = false
+	}
+}
+
+// dropNamed stops the name listeners. The caller holds mu.
+func (r *Resolver) dropNamed() {
+	for _, unsubscribe := range r.named {
+		unsubscribe()
+	}
+	r.named = nil
+}
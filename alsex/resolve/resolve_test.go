@@ -0,0 +1,129 @@
+package resolve
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPattern verifies plain, glob and regex patterns
+func TestPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"bass", "Bass", true},
+		{"bass", "Bass 2", false},
+		{"Drum*", "drum bus", true},
+		{"Drum*", "Kick Drum", false},
+		{"Vox [12]", "Vox 2", true},
+		{"Vox [!12]", "Vox 2", false},
+		{"Vox ?", "Vox 3", true},
+		{"*/*", "A/B", true},
+		{"/^(kick|snare)/", "Snare Top", true},
+		{"/^(kick|snare)/", "Hat", false},
+		{"Ä*", "äb", true},
+	}
+	for _, c := range cases {
+		p, err := Compile(c.pattern)
+		require.NoError(t, err, c.pattern)
+		assert.Equal(t, c.match, p.Match(c.name), "%s ~ %s", c.pattern, c.name)
+	}
+
+	_, err := Compile("/(/")
+	assert.Error(t, err)
+	_, err = Compile("Vox [12")
+	assert.Error(t, err)
+}
+
+func newTestSet(t *testing.T) *alstest.Server {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass", "Vox 1", "Vox 2")
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Verse")
+	live.Set("/live/track/get/clips/name", int32(1), nil, "Bassline", "Bassline B")
+	live.Set("/live/track/get/devices/name", int32(0), "Drum Rack", "Glue")
+	live.Set("/live/device/get/parameters/name", int32(0), int32(1), "Device On", "Threshold")
+	return live
+}
+
+// TestResolve verifies lookups, ambiguity and errors
+func TestResolve(t *testing.T) {
+	live := newTestSet(t)
+	r := New(live.Client(t))
+
+	track, err := r.Track("bass")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), track)
+
+	tracks, err := r.Tracks("Vox*")
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2, 3}, tracks)
+
+	_, err = r.Track("Vox*")
+	assert.EqualError(t, err, `track "Vox*" is ambiguous, it matches 2 "Vox 1", 3 "Vox 2"`)
+	_, err = r.Track("Keys")
+	assert.EqualError(t, err, `no track matches "Keys"`)
+
+	scene, err := r.Scene("verse")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), scene)
+
+	clip, err := r.Clip(1, "Bassline")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), clip)
+	clips, err := r.Clips(1, "/^bassline/")
+	require.NoError(t, err)
+	assert.Equal(t, []int32{1, 2}, clips)
+
+	device, err := r.Device(0, "glue")
+	require.NoError(t, err)
+	parameter, err := r.Parameter(0, device, "threshold")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), parameter)
+}
+
+// TestRefresh verifies a miss reads names again and listeners drop the cache
+func TestRefresh(t *testing.T) {
+	live := newTestSet(t)
+	r := New(live.Client(t))
+
+	_, err := r.Track("Drums")
+	require.NoError(t, err)
+
+	// not started: a miss reads the names again
+	live.Set("/live/song/get/track_names", "Keys", "Drums")
+	track, err := r.Track("Keys")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), track)
+
+	// cached until something changes
+	track, err = r.Track("Drums")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), track)
+
+	require.NoError(t, r.Start())
+	t.Cleanup(r.Stop)
+	live.WaitFor(t, "/live/song/start_listen/num_tracks")
+
+	live.Set("/live/song/get/track_names", "Drums", "Keys")
+	live.Emit("/live/song/get/num_tracks", int32(2))
+	assert.Eventually(t, func() bool {
+		track, err := r.Track("Drums")
+		return err == nil && track == 0
+	}, time.Second, 5*time.Millisecond)
+
+	// renaming a track drops the names, even though the old ones still match
+	live.WaitFor(t, "/live/track/start_listen/name")
+	live.Set("/live/song/get/track_names", "Keys", "Drums")
+	live.Emit("/live/track/get/name", int32(0), "Keys")
+	assert.Eventually(t, func() bool {
+		track, err := r.Track("Drums")
+		return err == nil && track == 1
+	}, time.Second, 5*time.Millisecond)
+}
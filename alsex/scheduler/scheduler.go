@@ -0,0 +1,396 @@
+package scheduler
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+const (
+	// DefaultLookahead is how many beats early callbacks run.
+	DefaultLookahead = 0.5
+	// DefaultResolution is how often the playhead position is checked.
+	DefaultResolution = 5 * time.Millisecond
+)
+
+// Opts configures a Scheduler.
+type Opts struct {
+	// Lookahead is how many beats before its position a callback runs, so
+	// clip edits land before the playhead arrives.
+	Lookahead float64
+	// Resolution is how often the playhead position is checked.
+	Resolution time.Duration
+}
+
+// Tick is passed to callbacks.
+type Tick struct {
+	Beat  float64 // the position the callback was scheduled for
+	Now   float64 // the estimated playhead position, up to Lookahead earlier
+	Tempo float64 // the tempo in BPM
+	Job   *Job    // the job being run, so a callback can cancel it
+}
+
+// Delay returns how long until the playhead reaches Beat, for changes that
+// must land exactly on time rather than ahead of it.
+func (t Tick) Delay() time.Duration {
+	if t.Tempo <= 0 || t.Beat <= t.Now {
+		return 0
+	}
+	return time.Duration((t.Beat - t.Now) / t.Tempo * float64(time.Minute))
+}
+
+// Scheduler runs callbacks at musical positions of the song, following the
+// playhead with Live's beat listener. Between beats the position is
+// estimated from the tempo.
+//
+// Callbacks run one at a time on the scheduler's goroutine, so slow work
+// delays later callbacks and should be started in its own goroutine.
+type Scheduler struct {
+	client *als.Client
+	opts   Opts
+	now    func() time.Time
+
+	mu         sync.Mutex
+	jobs       []*Job
+	anchorBeat float64
+	anchorTime time.Time
+	tempo      float64
+	playing    bool
+	// fresh is set until a tick runs after Start, playback starting or a
+	// jump, so new jobs align from the position itself rather than past the
+	// lookahead and beat 0 isn't skipped
+	fresh bool
+
+	// runMu guards starting and stopping
+	runMu       sync.Mutex
+	unsubscribe []func()
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+// Job is a scheduled callback.
+type Job struct {
+	interval float64 // 0 for one-shot jobs
+	offset   float64
+	next     float64
+	aligned  bool
+	fn       func(Tick)
+	s        *Scheduler
+}
+
+// New creates a scheduler for the client's song. Call Start to begin running
+// jobs.
+func New(client *als.Client, opts Opts) *Scheduler {
+	if opts.Lookahead <= 0 {
+		opts.Lookahead = DefaultLookahead
+	}
+	if opts.Resolution <= 0 {
+		opts.Resolution = DefaultResolution
+	}
+	return &Scheduler{
+		client: client,
+		opts:   opts,
+		now:    time.Now,
+		tempo:  120,
+		fresh:  true,
+	}
+}
+
+// Client returns the client whose song the scheduler follows.
+func (s *Scheduler) Client() *als.Client {
+	return s.client
+}
+
+// Every runs fn every interval beats, on multiples of interval counted from
+// the song start, e.g. Every(4, fn) runs on beats 0, 4, 8, ... Like
+// time.NewTicker, it panics if interval isn't positive; use At for a
+// callback that runs once.
+func (s *Scheduler) Every(interval float64, fn func(Tick)) *Job {
+	if interval <= 0 {
+		panic("scheduler: non-positive interval for Every")
+	}
+	return s.add(&Job{interval: interval, fn: fn})
+}
+
+// EveryBar runs fn at the start of every bar, using the song's current time
+// signature.
+func (s *Scheduler) EveryBar(fn func(Tick)) *Job {
+	song := s.client.Song
+	return s.Every(BeatsPerBar(song.GetSignatureNumerator(), song.GetSignatureDenominator()), fn)
+}
+
+// BeatsPerBar returns the length of a bar in beats, which Live counts in
+// quarter notes: 4/4 is 4 beats and 7/8 is 3.5. Signatures that aren't
+// positive count as 4/4.
+func BeatsPerBar(numerator, denominator int32) float64 {
+	if numerator <= 0 || denominator <= 0 {
+		return 4
+	}
+	return float64(numerator) * 4 / float64(denominator)
+}
+
+// At runs fn once when the playhead reaches beat, or straight away if it's
+// already past it.
+func (s *Scheduler) At(beat float64, fn func(Tick)) *Job {
+	return s.add(&Job{offset: beat, next: beat, aligned: true, fn: fn})
+}
+
+func (s *Scheduler) add(j *Job) *Job {
+	j.s = s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, j)
+	return j
+}
+
+// Offset shifts a repeating job by beats, e.g. Every(4, fn).Offset(2.5)
+// runs on beats 2.5, 6.5, 10.5, ...
+func (j *Job) Offset(beats float64) *Job {
+	j.s.mu.Lock()
+	defer j.s.mu.Unlock()
+	j.offset = beats
+	j.aligned = false
+	return j
+}
+
+// Cancel stops the job from running again.
+func (j *Job) Cancel() {
+	j.s.mu.Lock()
+	defer j.s.mu.Unlock()
+	j.s.remove(j)
+}
+
+func (s *Scheduler) remove(j *Job) {
+	for i, job := range s.jobs {
+		if job == j {
+			s.jobs = append(s.jobs[:i], s.jobs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Start reads the song position and tempo and begins following the
+// playhead. The listeners are shared through listen.Shared, so stopping
+// doesn't stop them for anything else using the client.
+func (s *Scheduler) Start() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.stop != nil {
+		return
+	}
+
+	song := s.client.Song
+	tempo := song.GetTempo()
+	position := song.GetCurrentSongTime()
+	playing := song.GetIsPlaying()
+
+	s.mu.Lock()
+	if tempo > 0 {
+		s.tempo = float64(tempo)
+	}
+	s.anchorBeat = float64(position)
+	s.anchorTime = s.now()
+	s.playing = playing
+	s.fresh = true
+	s.mu.Unlock()
+
+	// handlers run on the OSC server goroutine, so must never wait for
+	// a response
+	hub := listen.Shared(s.client)
+	handlers := map[string]func(listen.Event){
+		"beat": func(e listen.Event) {
+			if val, ok := als.First(e.Values).(int32); ok {
+				s.onBeat(val)
+			}
+		},
+		"tempo": func(e listen.Event) {
+			if val, ok := als.First(e.Values).(float32); ok {
+				s.onTempo(float64(val))
+			}
+		},
+		"is_playing": func(e listen.Event) {
+			if val, ok := als.First(e.Values).(int32); ok {
+				s.onPlaying(val != 0)
+			}
+		},
+	}
+	s.unsubscribe = nil
+	for property, fn := range handlers {
+		// song topics are always valid
+		unsubscribe, _ := hub.Subscribe(listen.Topic{Object: "song", Property: property}, fn)
+		s.unsubscribe = append(s.unsubscribe, unsubscribe)
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.stop, s.done)
+}
+
+// Stop stops following the playhead. Jobs are kept and resume on Start.
+func (s *Scheduler) Stop() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.stop == nil {
+		return
+	}
+
+	close(s.stop)
+	<-s.done
+	s.stop = nil
+
+	for _, unsubscribe := range s.unsubscribe {
+		unsubscribe()
+	}
+	s.unsubscribe = nil
+}
+
+// Position returns the estimated playhead position in beats.
+func (s *Scheduler) Position() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.position()
+}
+
+// Tempo returns the tempo in BPM the scheduler is following.
+func (s *Scheduler) Tempo() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tempo
+}
+
+func (s *Scheduler) run(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.opts.Resolution)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.tick()
+		}
+	}
+}
+
+// tick runs the callbacks that are due at the current position
+func (s *Scheduler) tick() {
+	s.mu.Lock()
+	if !s.playing {
+		s.mu.Unlock()
+		return
+	}
+	position := s.position()
+	tempo := s.tempo
+	due := s.due(position)
+	s.fresh = false
+	s.mu.Unlock()
+
+	for _, d := range due {
+		d.job.fn(Tick{Beat: d.beat, Now: position, Tempo: tempo, Job: d.job})
+	}
+}
+
+type call struct {
+	job  *Job
+	beat float64
+}
+
+// due advances jobs past position plus the lookahead, returning the
+// callbacks to run. Missed repeats are skipped so only the latest runs.
+func (s *Scheduler) due(position float64) []call {
+	horizon := position + s.opts.Lookahead
+	from := horizon
+	if s.fresh {
+		from = position
+	}
+	calls := make([]call, 0)
+	jobs := append([]*Job(nil), s.jobs...)
+
+	for _, j := range jobs {
+		if !j.aligned {
+			j.align(from)
+		}
+		if j.next > horizon {
+			continue
+		}
+
+		if j.interval <= 0 {
+			calls = append(calls, call{job: j, beat: j.next})
+			s.remove(j)
+			continue
+		}
+
+		missed := math.Floor((horizon - j.next) / j.interval)
+		beat := j.next + missed*j.interval
+		calls = append(calls, call{job: j, beat: beat})
+		j.next = beat + j.interval
+	}
+
+	return calls
+}
+
+// align moves a repeating job to its first position at or after from
+func (j *Job) align(from float64) {
+	j.aligned = true
+	if j.interval <= 0 {
+		return
+	}
+	steps := math.Ceil((from-j.offset)/j.interval - 1e-9)
+	j.next = j.offset + steps*j.interval
+}
+
+func (s *Scheduler) position() float64 {
+	if !s.playing {
+		return s.anchorBeat
+	}
+	elapsed := s.now().Sub(s.anchorTime).Minutes()
+	return s.anchorBeat + elapsed*s.tempo
+}
+
+func (s *Scheduler) onBeat(beat int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// a jump means the playhead was moved, so start repeating jobs again
+	// from the new position
+	if math.Abs(s.position()-float64(beat)) > 1 {
+		for _, j := range s.jobs {
+			if j.interval > 0 {
+				j.aligned = false
+			}
+		}
+		s.fresh = true
+	}
+
+	s.anchorBeat = float64(beat)
+	s.anchorTime = s.now()
+	s.playing = true
+}
+
+func (s *Scheduler) onTempo(tempo float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.anchorBeat = s.position()
+	s.anchorTime = s.now()
+	if tempo > 0 {
+		s.tempo = tempo
+	}
+}
+
+func (s *Scheduler) onPlaying(playing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.anchorBeat = s.position()
+	s.anchorTime = s.now()
+	if playing && !s.playing {
+		s.fresh = true
+	}
+	s.playing = playing
+}
@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock starts a scheduler playing at 120 bpm, so a beat is 500ms
+func fakeClock(s *Scheduler) *time.Time {
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+	s.onPlaying(true)
+	return &now
+}
+
+// TestEvery verifies repeating jobs run once per interval ahead of time
+func TestEvery(t *testing.T) {
+	s := New(nil, Opts{Lookahead: 0.5})
+	now := fakeClock(s)
+
+	var beats []float64
+	s.Every(4, func(tick Tick) { beats = append(beats, tick.Beat) })
+	s.Every(4, func(tick Tick) { beats = append(beats, tick.Beat) }).Offset(2.5)
+
+	for i := 0; i < 20; i++ {
+		s.tick()
+		*now = now.Add(250 * time.Millisecond)
+	}
+
+	assert.Equal(t, []float64{0, 2.5, 4, 6.5, 8}, beats)
+}
+
+// TestEveryStopped verifies a job added before playback starts runs on the
+// first beat rather than skipping it for the lookahead
+func TestEveryStopped(t *testing.T) {
+	s := New(nil, Opts{Lookahead: 0.5})
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	var beats []float64
+	s.Every(1, func(tick Tick) { beats = append(beats, tick.Beat) })
+	s.tick()
+	assert.Empty(t, beats)
+
+	s.onPlaying(true)
+	s.tick()
+	now = now.Add(250 * time.Millisecond)
+	s.tick()
+	assert.Equal(t, []float64{0, 1}, beats)
+
+	// a job added while playing starts after what's already been run ahead
+	s.Every(1, func(tick Tick) { beats = append(beats, -tick.Beat) })
+	now = now.Add(500 * time.Millisecond)
+	s.tick()
+	assert.Equal(t, []float64{0, 1, 2, -2}, beats)
+}
+
+// TestEveryBar verifies bars are counted in quarter notes
+func TestEveryBar(t *testing.T) {
+	assert.Equal(t, 4.0, BeatsPerBar(4, 4))
+	assert.Equal(t, 3.5, BeatsPerBar(7, 8))
+	assert.Equal(t, 6.0, BeatsPerBar(3, 2))
+	assert.Equal(t, 4.0, BeatsPerBar(0, 0))
+
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/signature_numerator", int32(7))
+	live.Set("/live/song/get/signature_denominator", int32(8))
+	s := New(live.Client(t), Opts{})
+	job := s.EveryBar(func(Tick) {})
+	assert.Equal(t, 3.5, job.interval)
+}
+
+// TestEveryInterval verifies an interval that isn't positive is rejected
+// rather than running once
+func TestEveryInterval(t *testing.T) {
+	s := New(nil, Opts{})
+	assert.Panics(t, func() { s.Every(0, func(Tick) {}) })
+	assert.Panics(t, func() { s.Every(-1, func(Tick) {}) })
+}
+
+// TestAt verifies one-shot jobs run once and can be cancelled
+func TestAt(t *testing.T) {
+	s := New(nil, Opts{Lookahead: 0.25})
+	now := fakeClock(s)
+
+	var ticks []Tick
+	job := s.At(3.5, func(tick Tick) { ticks = append(ticks, tick) })
+	s.At(2, func(tick Tick) { ticks = append(ticks, tick) }).Cancel()
+
+	for i := 0; i < 10; i++ {
+		s.tick()
+		*now = now.Add(500 * time.Millisecond)
+	}
+
+	assert.Equal(t, []Tick{{Beat: 3.5, Now: 4, Tempo: 120, Job: job}}, ticks)
+	assert.Equal(t, 250*time.Millisecond, Tick{Beat: 4.5, Now: 4, Tempo: 120}.Delay())
+}
+
+// TestTransport verifies the position follows beats, tempo and stops
+func TestTransport(t *testing.T) {
+	s := New(nil, Opts{})
+	now := fakeClock(s)
+
+	*now = now.Add(time.Second)
+	assert.InDelta(t, 2, s.Position(), 1e-9)
+
+	s.onBeat(3)
+	*now = now.Add(250 * time.Millisecond)
+	assert.InDelta(t, 3.5, s.Position(), 1e-9)
+
+	s.onTempo(60)
+	*now = now.Add(500 * time.Millisecond)
+	assert.InDelta(t, 4, s.Position(), 1e-9)
+
+	s.onPlaying(false)
+	*now = now.Add(time.Second)
+	assert.InDelta(t, 4, s.Position(), 1e-9)
+}
+
+// TestJump verifies repeating jobs realign when the playhead is moved
+func TestJump(t *testing.T) {
+	s := New(nil, Opts{Lookahead: 0.5})
+	now := fakeClock(s)
+
+	var beats []float64
+	s.Every(4, func(tick Tick) { beats = append(beats, tick.Beat) })
+
+	s.tick()
+	*now = now.Add(2 * time.Second) // beat 4
+	s.tick()
+	s.onBeat(16)
+	s.tick()
+	*now = now.Add(2 * time.Second) // beat 20
+	s.tick()
+
+	// the beat the playhead jumps to runs too
+	assert.Equal(t, []float64{0, 4, 16, 20}, beats)
+}
+
+// TestListeners verifies the scheduler follows Live through the shared hub,
+// so stopping it leaves listeners others use running
+func TestListeners(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/tempo", float32(90))
+	live.Set("/live/song/get/current_song_time", float32(8))
+	live.Set("/live/song/get/is_playing", int32(0))
+	client := live.Client(t)
+
+	tempo := listen.Topic{Object: "song", Property: "tempo"}
+	unsubscribe, err := listen.Shared(client).Subscribe(tempo, func(listen.Event) {})
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	s := New(client, Opts{})
+	s.Start()
+	s.Start()
+	assert.Equal(t, float64(90), s.Tempo())
+	assert.Equal(t, float64(8), s.Position())
+
+	live.Emit("/live/song/get/tempo", float32(140))
+	assert.Eventually(t, func() bool { return s.Tempo() == 140 }, time.Second, 5*time.Millisecond)
+
+	s.Stop()
+	s.Stop()
+	live.WaitFor(t, "/live/song/stop_listen/beat")
+	assert.Empty(t, live.Received("/live/song/stop_listen/tempo"))
+	assert.Equal(t, 1, listen.Shared(client).Count(tempo))
+}
@@ -0,0 +1,350 @@
+package show
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+	"github.com/matt0792/ableton-ctrl/alsex/tempo"
+)
+
+// ErrEnd is returned by Next after the last cue of the show.
+var ErrEnd = errors.New("end of show")
+
+// Position is a cue of the show.
+type Position struct {
+	Song int
+	Cue  int
+}
+
+// Opts configures a Runner.
+type Opts struct {
+	// Timed advances past cues with Bars set once they've played. Cues
+	// without Bars, and all cues when not timed, wait for Next.
+	Timed bool
+	// OnCue is called after each cue is fired.
+	OnCue func(Position)
+	// OnError is called when a timed advance fails, e.g. because a track
+	// or scene was renamed since the runner was created. The show stays on
+	// the current cue.
+	OnError func(error)
+	// Scheduler follows the playhead, e.g. one shared with a script engine.
+	// The runner starts it but leaves stopping it to the caller. Defaults to
+	// a scheduler of the runner's own, stopped by Stop.
+	Scheduler *scheduler.Scheduler
+}
+
+// Runner steps through a show. Starting a song sets its tempo, signature,
+// locator and mixer, then each cue fires its scene.
+//
+// Timed cues are counted in bars from the bar the cue starts on, and the
+// next cue fires just ahead of the bar line so Live's launch quantization
+// starts it on time.
+type Runner struct {
+	client    *als.Client
+	show      *Show
+	set       *set
+	opts      Opts
+	scheduler *scheduler.Scheduler
+	own       bool // the scheduler is the runner's own, stopped by Stop
+	tempo     *tempo.Controller
+
+	mu      sync.Mutex
+	pos     Position
+	started bool
+	advance *scheduler.Job // the timed advance to the next cue
+	ramp    *scheduler.Job
+}
+
+// NewRunner creates a runner for the show, checking it against the Live
+// set first.
+func NewRunner(client *als.Client, s *Show, opts Opts) (*Runner, error) {
+	st, err := readSet(client)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.check(st); err != nil {
+		return nil, err
+	}
+
+	sched := opts.Scheduler
+	own := sched == nil
+	if own {
+		sched = scheduler.New(client, scheduler.Opts{})
+	}
+	return &Runner{
+		client:    client,
+		show:      s,
+		set:       st,
+		opts:      opts,
+		scheduler: sched,
+		own:       own,
+		tempo:     tempo.New(client, sched),
+	}, nil
+}
+
+// Start follows the playhead and fires the first cue.
+func (r *Runner) Start() error {
+	r.scheduler.Start()
+	return r.GoTo(Position{})
+}
+
+// Stop cancels timed advances and tempo ramps, leaving Live playing.
+func (r *Runner) Stop() {
+	r.mu.Lock()
+	r.cancel()
+	r.started = false
+	r.mu.Unlock()
+	if r.own {
+		r.scheduler.Stop()
+	}
+}
+
+// Position returns the current cue.
+func (r *Runner) Position() Position {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pos
+}
+
+// Next fires the next cue, moving on to the next song after the last cue of
+// a song with its transition.
+func (r *Runner) Next() error {
+	r.mu.Lock()
+	pos, err := r.next(nextBar)
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	r.notify(pos)
+	return nil
+}
+
+// GoTo jumps to a cue, setting up its song. Transitions are skipped. A cue
+// whose song no longer fits the set is an error, and Live is left as it was.
+func (r *Runner) GoTo(pos Position) error {
+	if pos.Song < 0 || pos.Song >= len(r.show.Songs) {
+		return fmt.Errorf("song %d not found, there are %d", pos.Song, len(r.show.Songs))
+	}
+	if cues := r.show.Songs[pos.Song].Cues; pos.Cue < 0 || pos.Cue >= len(cues) {
+		return fmt.Errorf("cue %d not found, there are %d", pos.Cue, len(cues))
+	}
+
+	r.mu.Lock()
+	scene, err := r.scene(pos)
+	if err == nil && (!r.started || r.pos.Song != pos.Song) {
+		r.cancel()
+		err = r.startSong(pos.Song, nil)
+	}
+	if err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	r.started = true
+	r.fire(pos, scene, nextBar)
+	r.mu.Unlock()
+
+	r.notify(pos)
+	return nil
+}
+
+// next moves to the cue after the current one, which starts at beat or
+// nextBar. The caller holds mu.
+func (r *Runner) next(beat float64) (Position, error) {
+	if !r.started {
+		return Position{}, errors.New("show not started")
+	}
+
+	pos := r.pos
+	pos.Cue++
+	if pos.Cue >= len(r.show.Songs[pos.Song].Cues) {
+		if pos.Song+1 >= len(r.show.Songs) {
+			return r.pos, ErrEnd
+		}
+		prev := &r.show.Songs[pos.Song]
+		pos = Position{Song: pos.Song + 1}
+		scene, err := r.scene(pos)
+		if err != nil {
+			return r.pos, err
+		}
+		r.cancel()
+		if err := r.startSong(pos.Song, prev); err != nil {
+			return r.pos, err
+		}
+		r.fire(pos, scene, beat)
+		return pos, nil
+	}
+
+	scene, err := r.scene(pos)
+	if err != nil {
+		return r.pos, err
+	}
+	r.fire(pos, scene, beat)
+	return pos, nil
+}
+
+// scene finds the scene of a cue. The caller holds mu.
+func (r *Runner) scene(pos Position) (int32, error) {
+	song := r.show.Songs[pos.Song]
+	name := song.Cues[pos.Cue].Scene
+	scene, ok := r.set.scene(name)
+	if !ok {
+		return 0, fmt.Errorf("%s: cues[%d]: no scene named %q", song.label(pos.Song), pos.Cue, name)
+	}
+	return scene, nil
+}
+
+// startSong sets up a song, leading in from prev when it follows it. Its
+// signature, locator and tracks are looked up before anything is sent, so a
+// song that doesn't fit the set changes nothing. The caller holds mu.
+func (r *Runner) startSong(index int, prev *Song) error {
+	song := r.show.Songs[index]
+	fail := func(format string, args ...any) error {
+		return fmt.Errorf("%s: %s", song.label(index), fmt.Sprintf(format, args...))
+	}
+
+	var numerator, denominator int32
+	if song.Signature != "" {
+		var err error
+		if numerator, denominator, err = ParseSignature(song.Signature); err != nil {
+			return fail("%v", err)
+		}
+	}
+	var locator int32
+	if song.Locator != "" {
+		var ok bool
+		if locator, ok = r.set.locator(song.Locator); !ok {
+			return fail("no locator named %q", song.Locator)
+		}
+	}
+	names := sortedTracks(song.Mixer)
+	tracks := make([]int32, len(names))
+	for i, name := range names {
+		var ok bool
+		if tracks[i], ok = r.set.track(name); !ok {
+			return fail("mixer: no track named %q", name)
+		}
+	}
+
+	if prev != nil && prev.Transition.StopClips {
+		r.client.Song.StopAllClips()
+	}
+	if song.Tempo > 0 {
+		if prev != nil && prev.Transition.Ramp > 0 {
+			r.ramp = r.tempo.Ramp(song.Tempo, prev.Transition.Ramp, tempo.Linear)
+		} else {
+			r.client.Song.SetTempo(float32(song.Tempo))
+		}
+	}
+	if song.Signature != "" {
+		r.client.Song.SetSignatureNumerator(numerator)
+		r.client.Song.SetSignatureDenominator(denominator)
+	}
+	if song.Locator != "" {
+		r.client.Song.JumpToCuePoint(locator)
+	}
+	for i, name := range names {
+		r.applyStrip(tracks[i], song.Mixer[name])
+	}
+	return nil
+}
+
+func (r *Runner) applyStrip(track int32, strip Strip) {
+	if strip.Volume != nil {
+		r.client.Track.SetVolume(track, float32(*strip.Volume))
+	}
+	if strip.Pan != nil {
+		r.client.Track.SetPanning(track, float32(*strip.Pan))
+	}
+	if strip.Mute != nil {
+		r.client.Track.SetMute(track, *strip.Mute)
+	}
+	if strip.Solo != nil {
+		r.client.Track.SetSolo(track, *strip.Solo)
+	}
+	if strip.Arm != nil {
+		r.client.Track.SetArm(track, *strip.Arm)
+	}
+}
+
+// nextBar starts a cue at the next bar line, for cues fired by hand
+const nextBar = -1
+
+// fire fires a cue's scene, which starts at beat, scheduling the next cue
+// when timed. The caller holds mu.
+func (r *Runner) fire(pos Position, scene int32, beat float64) {
+	r.pos = pos
+	song := r.show.Songs[pos.Song]
+	cue := song.Cues[pos.Cue]
+
+	if cue.Tempo > 0 {
+		if r.ramp != nil {
+			r.ramp.Cancel()
+			r.ramp = nil
+		}
+		r.client.Song.SetTempo(float32(cue.Tempo))
+	}
+	r.client.Scene.Fire(scene)
+
+	if r.advance != nil {
+		r.advance.Cancel()
+		r.advance = nil
+	}
+	if !r.opts.Timed || cue.Bars <= 0 {
+		return
+	}
+
+	bar := r.beatsPerBar(song)
+	if beat == nextBar {
+		beat = math.Ceil(r.scheduler.Position()/bar-1e-9) * bar
+	}
+	end := beat + cue.Bars*bar
+	var job *scheduler.Job
+	job = r.scheduler.At(end, func(tick scheduler.Tick) {
+		r.mu.Lock()
+		if r.advance != job {
+			// superseded by a manual move
+			r.mu.Unlock()
+			return
+		}
+		r.advance = nil
+		pos, err := r.next(tick.Beat)
+		r.mu.Unlock()
+		switch {
+		case err == nil:
+			r.notify(pos)
+		case !errors.Is(err, ErrEnd) && r.opts.OnError != nil:
+			r.opts.OnError(err)
+		}
+	})
+	r.advance = job
+}
+
+// cancel stops the timed advance and any tempo ramp. The caller holds mu.
+func (r *Runner) cancel() {
+	if r.advance != nil {
+		r.advance.Cancel()
+		r.advance = nil
+	}
+	if r.ramp != nil {
+		r.ramp.Cancel()
+		r.ramp = nil
+	}
+}
+
+// beatsPerBar uses the song's signature, or Live's when it has none
+func (r *Runner) beatsPerBar(song Song) float64 {
+	if numerator, denominator, err := ParseSignature(song.Signature); err == nil {
+		return scheduler.BeatsPerBar(numerator, denominator)
+	}
+	return scheduler.BeatsPerBar(r.client.Song.GetSignatureNumerator(), r.client.Song.GetSignatureDenominator())
+}
+
+func (r *Runner) notify(pos Position) {
+	if r.opts.OnCue != nil {
+		r.opts.OnCue(pos)
+	}
+}
@@ -0,0 +1,128 @@
+package show
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// set indexes the names in a Live set, lowercased
+type set struct {
+	tracks   map[string]int32
+	scenes   map[string]int32
+	locators map[string]int32
+}
+
+// readSet reads the track, scene and cue point names of the set. Unlike the
+// typed getters, Live not answering is an error.
+func readSet(client *als.Client) (*set, error) {
+	st := &set{
+		tracks:   map[string]int32{},
+		scenes:   map[string]int32{},
+		locators: map[string]int32{},
+	}
+
+	names, err := client.Get("/live/song/get/track_names")
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range names {
+		addName(st.tracks, name, int32(i))
+	}
+
+	scenes, err := client.SceneNames()
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range scenes {
+		addName(st.scenes, name, int32(i))
+	}
+
+	// cue points come as name, time pairs
+	cues, err := client.Get("/live/song/get/cue_points")
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i+1 < len(cues); i += 2 {
+		addName(st.locators, cues[i], int32(i/2))
+	}
+	return st, nil
+}
+
+// addName indexes a name, keeping the first of duplicates as Live's name
+// lookups do
+func addName(m map[string]int32, name any, index int32) {
+	s, ok := name.(string)
+	if !ok || s == "" {
+		return
+	}
+	key := strings.ToLower(s)
+	if _, ok := m[key]; !ok {
+		m[key] = index
+	}
+}
+
+func (st *set) track(name string) (int32, bool) {
+	i, ok := st.tracks[strings.ToLower(name)]
+	return i, ok
+}
+
+func (st *set) scene(name string) (int32, bool) {
+	i, ok := st.scenes[strings.ToLower(name)]
+	return i, ok
+}
+
+func (st *set) locator(name string) (int32, bool) {
+	i, ok := st.locators[strings.ToLower(name)]
+	return i, ok
+}
+
+// Check validates the show against the Live set, reporting every track,
+// scene and locator it names that the set doesn't have.
+func (s *Show) Check(client *als.Client) error {
+	st, err := readSet(client)
+	if err != nil {
+		return err
+	}
+	return s.check(st)
+}
+
+func (s *Show) check(st *set) error {
+	var errs []error
+	for i, song := range s.Songs {
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("%s: %s", song.label(i), fmt.Sprintf(format, args...)))
+		}
+
+		if song.Locator != "" {
+			if _, ok := st.locator(song.Locator); !ok {
+				fail("no locator named %q", song.Locator)
+			}
+		}
+		for _, name := range sortedTracks(song.Mixer) {
+			if _, ok := st.track(name); !ok {
+				fail("mixer: no track named %q", name)
+			}
+		}
+		for j, cue := range song.Cues {
+			if _, ok := st.scene(cue.Scene); !ok {
+				fail("cues[%d]: no scene named %q", j, cue.Scene)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortedTracks returns the mixer's track names in order, so errors and
+// messages are repeatable
+func sortedTracks(mixer map[string]Strip) []string {
+	names := make([]string, 0, len(mixer))
+	for name := range mixer {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
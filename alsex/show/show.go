@@ -0,0 +1,192 @@
+// Package show describes a setlist as a show file and steps through it
+// against the current Live set.
+//
+// A show file is YAML, or JSON as its subset:
+//
+//	name: Friday
+//	songs:
+//	  - name: Opener
+//	    tempo: 124
+//	    signature: 4/4
+//	    locator: Opener        # cue point to jump to
+//	    mixer:
+//	      Drums: {volume: 0.8}
+//	      Vox: {mute: false, pan: -0.1}
+//	    cues:
+//	      - scene: Opener Intro
+//	        bars: 8            # advance after 8 bars when timed
+//	      - scene: Opener Verse  # no bars: wait for Next
+//	    transition:
+//	      ramp: 8              # ramp into the next song's tempo over 8 beats
+//	      stop_clips: true
+//
+// Tracks, scenes and locators are named as in Live, ignoring case.
+package show
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Show is a setlist.
+type Show struct {
+	Name  string `yaml:"name,omitempty"`
+	Songs []Song `yaml:"songs"`
+}
+
+// Song is an entry of the setlist. Everything but the cues is optional and
+// left as it is in Live when unset.
+type Song struct {
+	Name string `yaml:"name"`
+	// Tempo in BPM.
+	Tempo float64 `yaml:"tempo,omitempty"`
+	// Signature like "4/4" or "7/8".
+	Signature string `yaml:"signature,omitempty"`
+	// Locator is the name of a cue point to jump to.
+	Locator string `yaml:"locator,omitempty"`
+	// Mixer sets tracks by name when the song starts.
+	Mixer map[string]Strip `yaml:"mixer,omitempty"`
+	// Cues are the scenes fired in order.
+	Cues []Cue `yaml:"cues"`
+	// Transition is how the song leads into the next one.
+	Transition Transition `yaml:"transition,omitempty"`
+}
+
+// Strip is a track's mixer settings. Unset fields are left alone.
+type Strip struct {
+	Volume *float64 `yaml:"volume,omitempty"`
+	Pan    *float64 `yaml:"pan,omitempty"`
+	Mute   *bool    `yaml:"mute,omitempty"`
+	Solo   *bool    `yaml:"solo,omitempty"`
+	Arm    *bool    `yaml:"arm,omitempty"`
+}
+
+// Cue fires a scene.
+type Cue struct {
+	Scene string `yaml:"scene"`
+	// Bars is how long the cue plays before a timed runner advances. Zero
+	// waits for Next.
+	Bars float64 `yaml:"bars,omitempty"`
+	// Tempo changes the tempo with the cue, in BPM.
+	Tempo float64 `yaml:"tempo,omitempty"`
+}
+
+// Transition is how a song leads into the next.
+type Transition struct {
+	// Ramp is how many beats the tempo ramps into the next song's over.
+	// Zero jumps to it.
+	Ramp float64 `yaml:"ramp,omitempty"`
+	// StopClips stops all clips before the next song starts.
+	StopClips bool `yaml:"stop_clips,omitempty"`
+}
+
+// Load reads a show file.
+func Load(path string) (*Show, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Parse reads a show from YAML or JSON and validates it. Unknown fields are
+// errors, so typos don't silently do nothing on stage.
+func Parse(r io.Reader) (*Show, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	s := &Show{}
+	if err := dec.Decode(s); err != nil {
+		return nil, err
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Validate checks the show makes sense on its own. Check validates it
+// against a Live set.
+func (s *Show) Validate() error {
+	if len(s.Songs) == 0 {
+		return errors.New("show has no songs")
+	}
+
+	var errs []error
+	for i, song := range s.Songs {
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("%s: %s", song.label(i), fmt.Sprintf(format, args...)))
+		}
+
+		if song.Tempo != 0 && (song.Tempo < 20 || song.Tempo > 999) {
+			fail("tempo %g out of range 20-999", song.Tempo)
+		}
+		if song.Signature != "" {
+			if _, _, err := ParseSignature(song.Signature); err != nil {
+				fail("%v", err)
+			}
+		}
+		if len(song.Cues) == 0 {
+			fail("no cues")
+		}
+		for j, cue := range song.Cues {
+			if cue.Scene == "" {
+				fail("cues[%d]: scene is required", j)
+			}
+			if cue.Bars < 0 {
+				fail("cues[%d]: bars must not be negative", j)
+			}
+			if cue.Tempo != 0 && (cue.Tempo < 20 || cue.Tempo > 999) {
+				fail("cues[%d]: tempo %g out of range 20-999", j, cue.Tempo)
+			}
+		}
+		for name, strip := range song.Mixer {
+			if v := strip.Volume; v != nil && (*v < 0 || *v > 1) {
+				fail("mixer %q: volume %g out of range 0-1", name, *v)
+			}
+			if p := strip.Pan; p != nil && (*p < -1 || *p > 1) {
+				fail("mixer %q: pan %g out of range -1-1", name, *p)
+			}
+		}
+		if song.Transition.Ramp < 0 {
+			fail("transition ramp must not be negative")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// label names a song in errors
+func (s Song) label(i int) string {
+	if s.Name == "" {
+		return fmt.Sprintf("songs[%d]", i)
+	}
+	return fmt.Sprintf("songs[%d] %q", i, s.Name)
+}
+
+// ParseSignature parses a time signature like "7/8".
+func ParseSignature(s string) (numerator, denominator int32, err error) {
+	num, den, ok := strings.Cut(s, "/")
+	n, err1 := strconv.Atoi(strings.TrimSpace(num))
+	d, err2 := strconv.Atoi(strings.TrimSpace(den))
+	if !ok || err1 != nil || err2 != nil || n < 1 || n > 99 || d < 1 || d&(d-1) != 0 || d > 16 {
+		return 0, 0, fmt.Errorf("invalid signature %q, expected like 4/4 or 7/8", s)
+	}
+	return int32(n), int32(d), nil
+}
@@ -0,0 +1,259 @@
+package show
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testShow = `
+name: Friday
+songs:
+  - name: Opener
+    tempo: 124
+    signature: 7/8
+    locator: closer
+    mixer:
+      Drums: {volume: 0.8}
+      bass: {mute: true, pan: -0.5}
+    cues:
+      - scene: Intro
+        bars: 1
+      - scene: verse
+    transition:
+      stop_clips: true
+  - name: Closer
+    cues:
+      - scene: Drop
+        tempo: 130
+`
+
+func newTestSet(t *testing.T) *alstest.Server {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	live.Set("/live/song/get/num_scenes", int32(3))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Verse")
+	live.Set("/live/scene/get/name", int32(2), "Drop")
+	live.Set("/live/song/get/cue_points", "Opener", float32(0), "Closer", float32(64))
+	live.Set("/live/song/get/tempo", float32(120))
+	live.Set("/live/song/get/is_playing", int32(0))
+	live.Set("/live/song/get/current_song_time", float32(0))
+	live.Set("/live/song/get/signature_numerator", int32(4))
+	return live
+}
+
+// TestParse verifies YAML and JSON shows parse and are validated
+func TestParse(t *testing.T) {
+	s, err := Parse(strings.NewReader(testShow))
+	require.NoError(t, err)
+	require.Len(t, s.Songs, 2)
+	assert.Equal(t, 124.0, s.Songs[0].Tempo)
+	assert.Equal(t, 0.8, *s.Songs[0].Mixer["Drums"].Volume)
+	assert.True(t, *s.Songs[0].Mixer["bass"].Mute)
+	assert.Nil(t, s.Songs[0].Mixer["bass"].Solo)
+	assert.True(t, s.Songs[0].Transition.StopClips)
+
+	s, err = Parse(strings.NewReader(`{"songs": [{"name": "A", "cues": [{"scene": "Intro", "bars": 8}]}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, s.Songs[0].Cues[0].Bars)
+
+	_, err = Parse(strings.NewReader("songs:\n  - name: A\n    tempi: 120\n"))
+	assert.ErrorContains(t, err, "field tempi not found")
+
+	_, err = Parse(strings.NewReader(`
+songs:
+  - name: A
+    tempo: 1200
+    signature: 4/3
+    mixer:
+      Drums: {volume: 2}
+    cues: []
+`))
+	require.Error(t, err)
+	assert.Equal(t, `songs[0] "A": tempo 1200 out of range 20-999
+songs[0] "A": invalid signature "4/3", expected like 4/4 or 7/8
+songs[0] "A": no cues
+songs[0] "A": mixer "Drums": volume 2 out of range 0-1`, err.Error())
+}
+
+// TestCheck verifies names are checked against the set
+func TestCheck(t *testing.T) {
+	live := newTestSet(t)
+	client := live.Client(t)
+
+	s, err := Parse(strings.NewReader(testShow))
+	require.NoError(t, err)
+	assert.NoError(t, s.Check(client))
+
+	s.Songs[0].Locator = "Encore"
+	s.Songs[0].Mixer["Keys"] = Strip{}
+	s.Songs[1].Cues[0].Scene = "Outro"
+	assert.EqualError(t, s.Check(client), `songs[0] "Opener": no locator named "Encore"
+songs[0] "Opener": mixer: no track named "Keys"
+songs[1] "Closer": cues[0]: no scene named "Outro"`)
+
+	_, err = NewRunner(client, s, Opts{})
+	assert.Error(t, err)
+}
+
+// TestRunner verifies songs are set up and cues fired in order
+func TestRunner(t *testing.T) {
+	live := newTestSet(t)
+	s, err := Parse(strings.NewReader(testShow))
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var cues []Position
+	r, err := NewRunner(live.Client(t), s, Opts{OnCue: func(p Position) {
+		mu.Lock()
+		defer mu.Unlock()
+		cues = append(cues, p)
+	}})
+	require.NoError(t, err)
+	t.Cleanup(r.Stop)
+
+	assert.EqualError(t, r.Next(), "show not started")
+	require.NoError(t, r.Start())
+
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	assert.Equal(t, []any{float32(124)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/song/set/signature_numerator")
+	assert.Equal(t, []any{int32(7)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/song/cue_point/jump")
+	assert.Equal(t, []any{int32(1)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/track/set/volume")
+	assert.Equal(t, []any{int32(0), float32(0.8)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/track/set/mute")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/scene/fire")
+	assert.Equal(t, []any{int32(0)}, msgs[0].Arguments)
+
+	require.NoError(t, r.Next())
+	require.NoError(t, r.Next())
+	assert.Equal(t, Position{Song: 1}, r.Position())
+	live.WaitFor(t, "/live/song/stop_all_clips")
+	assert.Eventually(t, func() bool {
+		return len(live.Received("/live/scene/fire")) == 3
+	}, time.Second, 5*time.Millisecond)
+
+	assert.ErrorIs(t, r.Next(), ErrEnd)
+	assert.EqualError(t, r.GoTo(Position{Song: 2}), "song 2 not found, there are 2")
+	require.NoError(t, r.GoTo(Position{Song: 0, Cue: 1}))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []Position{{0, 0}, {0, 1}, {1, 0}, {0, 1}}, cues)
+}
+
+// TestTimed verifies cues with bars advance by themselves
+func TestTimed(t *testing.T) {
+	live := newTestSet(t)
+	// 100 beats a second
+	live.Set("/live/song/get/tempo", float32(6000))
+	live.Set("/live/song/get/is_playing", int32(1))
+
+	s, err := Parse(strings.NewReader(`
+songs:
+  - name: A
+    cues:
+      - {scene: Intro, bars: 2}
+      - {scene: Verse, bars: 1}
+      - {scene: Drop}
+`))
+	require.NoError(t, err)
+	r, err := NewRunner(live.Client(t), s, Opts{Timed: true})
+	require.NoError(t, err)
+	t.Cleanup(r.Stop)
+
+	require.NoError(t, r.Start())
+	assert.Eventually(t, func() bool {
+		return r.Position() == Position{Cue: 2}
+	}, 2*time.Second, 5*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		return len(live.Received("/live/scene/fire")) == 3
+	}, time.Second, 5*time.Millisecond)
+	// Drop waits for Next
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, live.Received("/live/scene/fire"), 3)
+}
+
+// TestRunnerErrors verifies a song that no longer fits the set is an error
+// and sends nothing
+func TestRunnerErrors(t *testing.T) {
+	live := newTestSet(t)
+	s, err := Parse(strings.NewReader(testShow))
+	require.NoError(t, err)
+	r, err := NewRunner(live.Client(t), s, Opts{})
+	require.NoError(t, err)
+	t.Cleanup(r.Stop)
+
+	s.Songs[0].Signature = "7/7"
+	assert.EqualError(t, r.Start(), `songs[0] "Opener": invalid signature "7/7", expected like 4/4 or 7/8`)
+	s.Songs[0].Signature = ""
+	s.Songs[0].Mixer["Keys"] = Strip{}
+	assert.EqualError(t, r.Start(), `songs[0] "Opener": mixer: no track named "Keys"`)
+	s.Songs[1].Cues[0].Scene = "Outro"
+	assert.EqualError(t, r.GoTo(Position{Song: 1}), `songs[1] "Closer": cues[0]: no scene named "Outro"`)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, live.Received("/live/song/set/tempo"))
+	assert.Empty(t, live.Received("/live/scene/fire"))
+}
+
+// TestBeatsPerBar verifies bars are counted in quarter notes, so a 7/8 bar
+// is 3.5 beats
+func TestBeatsPerBar(t *testing.T) {
+	live := newTestSet(t)
+	live.Set("/live/song/get/signature_denominator", int32(4))
+	s, err := Parse(strings.NewReader(testShow))
+	require.NoError(t, err)
+	r, err := NewRunner(live.Client(t), s, Opts{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3.5, r.beatsPerBar(s.Songs[0]))
+	assert.Equal(t, 4.0, r.beatsPerBar(s.Songs[1]))
+}
+
+// TestTransitionRamp verifies a transition ramps the tempo into the next
+// song
+func TestTransitionRamp(t *testing.T) {
+	live := newTestSet(t)
+	// about 16 beats a second
+	live.Set("/live/song/get/tempo", float32(999))
+	live.Set("/live/song/get/is_playing", int32(1))
+
+	s, err := Parse(strings.NewReader(`
+songs:
+  - name: A
+    cues:
+      - scene: Intro
+    transition:
+      ramp: 4
+  - name: B
+    tempo: 600
+    cues:
+      - scene: Drop
+`))
+	require.NoError(t, err)
+	r, err := NewRunner(live.Client(t), s, Opts{})
+	require.NoError(t, err)
+	t.Cleanup(r.Stop)
+
+	require.NoError(t, r.Start())
+	require.NoError(t, r.Next())
+	assert.Eventually(t, func() bool {
+		msgs := live.Received("/live/song/set/tempo")
+		return len(msgs) > 0 && msgs[len(msgs)-1].Arguments[0] == float32(600)
+	}, 2*time.Second, 5*time.Millisecond)
+
+	msgs := live.Received("/live/song/set/tempo")
+	require.Greater(t, len(msgs), 2)
+	assert.Greater(t, msgs[0].Arguments[0], float32(600))
+}
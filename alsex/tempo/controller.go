@@ -0,0 +1,141 @@
+package tempo
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+)
+
+// DefaultResolution is how often a ramp updates the tempo, in beats.
+const DefaultResolution = 0.25
+
+// Controller plays tempo maps and signature changes against the song,
+// timed by a scheduler.
+type Controller struct {
+	client     *als.Client
+	scheduler  *scheduler.Scheduler
+	resolution float64
+}
+
+// New creates a controller. The scheduler must be started for changes to
+// play.
+func New(client *als.Client, s *scheduler.Scheduler) *Controller {
+	return &Controller{
+		client:     client,
+		scheduler:  s,
+		resolution: DefaultResolution,
+	}
+}
+
+// Resolution sets how often ramps update the tempo, in beats. Values that
+// aren't positive restore DefaultResolution.
+func (c *Controller) Resolution(beats float64) *Controller {
+	if beats <= 0 {
+		beats = DefaultResolution
+	}
+	c.resolution = beats
+	return c
+}
+
+// Bars converts bars to beats using the song's current time signature, see
+// scheduler.BeatsPerBar.
+func (c *Controller) Bars(bars float64) float64 {
+	song := c.client.Song
+	return bars * scheduler.BeatsPerBar(song.GetSignatureNumerator(), song.GetSignatureDenominator())
+}
+
+// Ramp moves from the current tempo to another over length beats, starting
+// at the next beat.
+func (c *Controller) Ramp(to, length float64, curve Curve) *scheduler.Job {
+	from := float64(c.client.Song.GetTempo())
+	start := math.Ceil(c.scheduler.Position())
+	return c.Play(Ramp(from, to, start, length, curve))
+}
+
+// Play follows a tempo map until its last point. The tempo is only sent when
+// it changes, and like Signature, when the playhead reaches the beat rather
+// than when the scheduler runs ahead of it.
+func (c *Controller) Play(m Map) *scheduler.Job {
+	var last float64
+	sent := false
+	return c.scheduler.Every(c.resolution, func(tick scheduler.Tick) {
+		if tick.Beat < m.Start() {
+			return
+		}
+
+		tempo := m.At(tick.Beat)
+		if !sent || math.Abs(tempo-last) >= 0.01 {
+			time.AfterFunc(tick.Delay(), func() {
+				c.client.Song.SetTempo(float32(tempo))
+			})
+			last, sent = tempo, true
+		}
+		if tick.Beat >= m.End() {
+			tick.Job.Cancel()
+		}
+	})
+}
+
+// Signature changes the time signature when the playhead reaches beat.
+func (c *Controller) Signature(beat float64, numerator, denominator int32) *scheduler.Job {
+	return c.scheduler.At(beat, func(tick scheduler.Tick) {
+		// the scheduler runs early, so wait for the beat itself
+		time.AfterFunc(tick.Delay(), func() {
+			c.client.Song.SetSignatureNumerator(numerator)
+			c.client.Song.SetSignatureDenominator(denominator)
+		})
+	})
+}
+
+// FromScenes builds a tempo map from the tempos of scenes played one after
+// another, each lasting length beats. Tempos ramp linearly over the ramp
+// beats leading into each scene, or jump when ramp is 0. Scenes without a
+// tempo keep the previous one.
+func FromScenes(api *als.SceneAPI, sceneIDs []int32, length, ramp float64) Map {
+	m := Map{}
+	for i, id := range sceneIDs {
+		if !api.GetTempoEnabled(id) {
+			continue
+		}
+		tempo := float64(api.GetTempo(id))
+		start := float64(i) * length
+
+		if len(m) == 0 {
+			m = append(m, Point{Beat: start, Tempo: tempo})
+			continue
+		}
+
+		prev := m[len(m)-1].Tempo
+		if ramp <= 0 {
+			m = append(m, Point{Beat: start, Tempo: tempo, Curve: Step})
+			continue
+		}
+		rampStart := math.Max(start-ramp, m[len(m)-1].Beat)
+		m = append(m,
+			Point{Beat: rampStart, Tempo: prev, Curve: Step},
+			Point{Beat: start, Tempo: tempo, Curve: Linear},
+		)
+	}
+	return m
+}
+
+// FromScenesByName is FromScenes with scenes given by name. A pattern
+// matching several scenes, like "Verse*", adds them all in set order.
+func FromScenesByName(api *als.SceneAPI, r *resolve.Resolver, scenes []string, length, ramp float64) (Map, error) {
+	var sceneIDs []int32
+	for _, pattern := range scenes {
+		ids, err := r.Scenes(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("no scene matches %q", pattern)
+		}
+		sceneIDs = append(sceneIDs, ids...)
+	}
+	return FromScenes(api, sceneIDs, length, ramp), nil
+}
@@ -0,0 +1,89 @@
+package tempo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestController(t *testing.T) (*alstest.Server, *Controller) {
+	live := alstest.NewServer(t)
+	// 100 beats a second
+	live.Set("/live/song/get/tempo", float32(6000))
+	live.Set("/live/song/get/is_playing", int32(1))
+	live.Set("/live/song/get/current_song_time", float32(0))
+	client := live.Client(t)
+
+	s := scheduler.New(client, scheduler.Opts{})
+	s.Start()
+	t.Cleanup(s.Stop)
+	return live, New(client, s)
+}
+
+// TestControllerRamp verifies a ramp sends the tempo as it moves and ends on
+// the target
+func TestControllerRamp(t *testing.T) {
+	live, c := newTestController(t)
+
+	job := c.Resolution(1).Ramp(6600, 8, Linear)
+	defer job.Cancel()
+
+	assert.Eventually(t, func() bool {
+		msgs := live.Received("/live/song/set/tempo")
+		return len(msgs) > 0 && msgs[len(msgs)-1].Arguments[0] == float32(6600)
+	}, 2*time.Second, 5*time.Millisecond)
+
+	msgs := live.Received("/live/song/set/tempo")
+	require.Greater(t, len(msgs), 2)
+	first := msgs[0].Arguments[0].(float32)
+	assert.GreaterOrEqual(t, first, float32(6000))
+	assert.Less(t, first, float32(6600))
+}
+
+// TestControllerPlay verifies a map's first tempo is sent even when it
+// doesn't change
+func TestControllerPlay(t *testing.T) {
+	live, c := newTestController(t)
+
+	job := c.Play(NewMap(Point{Beat: 0, Tempo: 128}))
+	defer job.Cancel()
+
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	assert.Equal(t, []any{float32(128)}, msgs[0].Arguments)
+}
+
+// TestControllerBars verifies bars are counted in quarter notes
+func TestControllerBars(t *testing.T) {
+	live, c := newTestController(t)
+	live.Set("/live/song/get/signature_numerator", int32(7))
+	live.Set("/live/song/get/signature_denominator", int32(8))
+	assert.Equal(t, 7.0, c.Bars(2))
+}
+
+// TestFromScenesByName verifies a pattern adds every scene it matches in
+// set order, reading their tempos, and one matching nothing is rejected
+func TestFromScenesByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/num_scenes", int32(3))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Verse 1")
+	live.Set("/live/scene/get/name", int32(2), "Verse 2")
+	for i, tempo := range []float32{90, 100, 120} {
+		live.Set("/live/scene/get/tempo_enabled", int32(i), int32(1))
+		live.Set("/live/scene/get/tempo", int32(i), tempo)
+	}
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	m, err := FromScenesByName(client.Scene, r, []string{"Verse*"}, 16, 0)
+	require.NoError(t, err)
+	assert.Equal(t, Map{{Beat: 0, Tempo: 100}, {Beat: 16, Tempo: 120, Curve: Step}}, m)
+
+	_, err = FromScenesByName(client.Scene, r, []string{"Chorus"}, 16, 0)
+	assert.EqualError(t, err, `no scene matches "Chorus"`)
+}
@@ -0,0 +1,101 @@
+package tempo
+
+import (
+	"math"
+	"sort"
+)
+
+// Curve is the shape of a tempo change.
+type Curve int
+
+const (
+	Step        Curve = iota // jump to the new tempo
+	Linear                   // ramp evenly
+	Exponential              // ramp by a constant ratio per beat, which sounds even
+)
+
+// Point is a tempo reached at a beat, approached from the previous point
+// along Curve.
+type Point struct {
+	Beat  float64
+	Tempo float64
+	Curve Curve
+}
+
+// Map is a tempo map, a series of points sorted by beat.
+type Map []Point
+
+// NewMap creates a map from points, sorting them by beat.
+func NewMap(points ...Point) Map {
+	m := append(Map(nil), points...)
+	sort.SliceStable(m, func(i, j int) bool { return m[i].Beat < m[j].Beat })
+	return m
+}
+
+// Ramp creates a map that moves from one tempo to another over length beats
+// starting at start.
+func Ramp(from, to, start, length float64, curve Curve) Map {
+	return Map{
+		{Beat: start, Tempo: from},
+		{Beat: start + length, Tempo: to, Curve: curve},
+	}
+}
+
+// At returns the tempo at a beat. Before the first point the first tempo
+// applies and after the last point the last tempo.
+func (m Map) At(beat float64) float64 {
+	if len(m) == 0 {
+		return 0
+	}
+
+	i := sort.Search(len(m), func(i int) bool { return m[i].Beat > beat })
+	if i == 0 {
+		return m[0].Tempo
+	}
+	if i == len(m) {
+		return m[len(m)-1].Tempo
+	}
+
+	prev, next := m[i-1], m[i]
+	t := (beat - prev.Beat) / (next.Beat - prev.Beat)
+	return interpolate(prev.Tempo, next.Tempo, t, next.Curve)
+}
+
+// Start returns the beat of the first point.
+func (m Map) Start() float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	return m[0].Beat
+}
+
+// End returns the beat of the last point.
+func (m Map) End() float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	return m[len(m)-1].Beat
+}
+
+// Shift returns the map moved by beats.
+func (m Map) Shift(beats float64) Map {
+	result := append(Map(nil), m...)
+	for i := range result {
+		result[i].Beat += beats
+	}
+	return result
+}
+
+func interpolate(from, to, t float64, curve Curve) float64 {
+	switch curve {
+	case Linear:
+		return from + (to-from)*t
+	case Exponential:
+		if from <= 0 || to <= 0 {
+			return from + (to-from)*t
+		}
+		return from * math.Pow(to/from, t)
+	default:
+		return from
+	}
+}
@@ -0,0 +1,40 @@
+package tempo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRamp verifies linear and exponential ramps
+func TestRamp(t *testing.T) {
+	linear := Ramp(100, 140, 8, 16, Linear)
+	assert.Equal(t, 100.0, linear.At(0))
+	assert.Equal(t, 100.0, linear.At(8))
+	assert.InDelta(t, 120, linear.At(16), 1e-9)
+	assert.Equal(t, 140.0, linear.At(24))
+	assert.Equal(t, 140.0, linear.At(100))
+
+	exponential := Ramp(80, 160, 0, 8, Exponential)
+	assert.InDelta(t, 80*1.4142135, exponential.At(4), 1e-4)
+	assert.InDelta(t, 160, exponential.At(8), 1e-9)
+}
+
+// TestMap verifies steps, sorting and shifting
+func TestMap(t *testing.T) {
+	m := NewMap(
+		Point{Beat: 8, Tempo: 130, Curve: Step},
+		Point{Beat: 0, Tempo: 120},
+	)
+	assert.Equal(t, 120.0, m.At(7.99))
+	assert.Equal(t, 130.0, m.At(8))
+	assert.Equal(t, 0.0, m.Start())
+	assert.Equal(t, 8.0, m.End())
+
+	shifted := m.Shift(4)
+	assert.Equal(t, 120.0, shifted.At(11))
+	assert.Equal(t, 130.0, shifted.At(12))
+	assert.Equal(t, 8.0, m.End())
+
+	assert.Equal(t, 0.0, Map{}.At(4))
+}
@@ -0,0 +1,169 @@
+package theory
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matt0792/ableton-ctrl/alsex/note"
+)
+
+var chordIntervals = map[string][]int32{
+	"":        {0, 4, 7},
+	"maj":     {0, 4, 7},
+	"M":       {0, 4, 7},
+	"m":       {0, 3, 7},
+	"min":     {0, 3, 7},
+	"-":       {0, 3, 7},
+	"dim":     {0, 3, 6},
+	"°":       {0, 3, 6},
+	"aug":     {0, 4, 8},
+	"+":       {0, 4, 8},
+	"5":       {0, 7},
+	"sus2":    {0, 2, 7},
+	"sus4":    {0, 5, 7},
+	"sus":     {0, 5, 7},
+	"6":       {0, 4, 7, 9},
+	"m6":      {0, 3, 7, 9},
+	"69":      {0, 4, 7, 9, 14},
+	"6/9":     {0, 4, 7, 9, 14},
+	"7":       {0, 4, 7, 10},
+	"maj7":    {0, 4, 7, 11},
+	"M7":      {0, 4, 7, 11},
+	"Δ":       {0, 4, 7, 11},
+	"Δ7":      {0, 4, 7, 11},
+	"m7":      {0, 3, 7, 10},
+	"min7":    {0, 3, 7, 10},
+	"-7":      {0, 3, 7, 10},
+	"mMaj7":   {0, 3, 7, 11},
+	"mM7":     {0, 3, 7, 11},
+	"m(maj7)": {0, 3, 7, 11},
+	"dim7":    {0, 3, 6, 9},
+	"°7":      {0, 3, 6, 9},
+	"m7b5":    {0, 3, 6, 10},
+	"ø":       {0, 3, 6, 10},
+	"ø7":      {0, 3, 6, 10},
+	"aug7":    {0, 4, 8, 10},
+	"+7":      {0, 4, 8, 10},
+	"7#5":     {0, 4, 8, 10},
+	"7b5":     {0, 4, 6, 10},
+	"7sus4":   {0, 5, 7, 10},
+	"7b9":     {0, 4, 7, 10, 13},
+	"7#9":     {0, 4, 7, 10, 15},
+	"add9":    {0, 4, 7, 14},
+	"madd9":   {0, 3, 7, 14},
+	"9":       {0, 4, 7, 10, 14},
+	"maj9":    {0, 4, 7, 11, 14},
+	"M9":      {0, 4, 7, 11, 14},
+	"m9":      {0, 3, 7, 10, 14},
+	"11":      {0, 4, 7, 10, 14, 17},
+	"m11":     {0, 3, 7, 10, 14, 17},
+	"13":      {0, 4, 7, 10, 14, 21},
+	"maj13":   {0, 4, 7, 11, 14, 21},
+	"m13":     {0, 3, 7, 10, 14, 21},
+}
+
+// Chord is a parsed chord symbol.
+type Chord struct {
+	Symbol    string
+	Root      int32 // pitch class 0-11
+	Quality   string
+	Intervals []int32
+	Bass      int32 // pitch class of a slash bass, -1 if none
+}
+
+// ParseChord parses a chord symbol such as "Cmaj7/G", "F#m9" or "Bb7sus4".
+func ParseChord(symbol string) (*Chord, error) {
+	symbol = strings.TrimSpace(symbol)
+	rootName, rest := splitRoot(symbol)
+	if rootName == "" {
+		return nil, fmt.Errorf("invalid chord: %q", symbol)
+	}
+
+	root, err := note.PitchClass(rootName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chord: %q: %w", symbol, err)
+	}
+
+	c := &Chord{Symbol: symbol, Root: root, Bass: -1}
+
+	// a slash followed by a note name is a bass note, anything else (6/9) is
+	// part of the quality
+	if i := strings.LastIndex(rest, "/"); i >= 0 {
+		if bassName, tail := splitRoot(rest[i+1:]); bassName != "" && tail == "" {
+			bass, err := note.PitchClass(bassName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid chord: %q: %w", symbol, err)
+			}
+			c.Bass = bass
+			rest = rest[:i]
+		}
+	}
+
+	intervals, ok := chordIntervals[rest]
+	if !ok {
+		return nil, fmt.Errorf("unknown chord quality: %q", rest)
+	}
+	c.Quality = rest
+	c.Intervals = intervals
+
+	return c, nil
+}
+
+// ParseChords parses a whitespace separated progression, e.g. "Am7 D7 Gmaj7".
+func ParseChords(progression string) ([]*Chord, error) {
+	fields := strings.Fields(progression)
+	chords := make([]*Chord, 0, len(fields))
+	for _, symbol := range fields {
+		c, err := ParseChord(symbol)
+		if err != nil {
+			return nil, err
+		}
+		chords = append(chords, c)
+	}
+	return chords, nil
+}
+
+// Pitches returns the chord in root position with the root in the given
+// octave. A slash bass is placed below the root.
+func (c *Chord) Pitches(octave int32) []int32 {
+	root := (octave+1)*12 + c.Root
+	pitches := make([]int32, 0, len(c.Intervals)+1)
+
+	if c.Bass >= 0 && c.Bass != c.Root {
+		pitches = append(pitches, root-mod12(c.Root-c.Bass))
+	}
+	for _, interval := range c.Intervals {
+		pitches = append(pitches, root+interval)
+	}
+
+	return pitches
+}
+
+// Inversion returns the chord voiced in the nth inversion. A slash bass stays
+// in the bass.
+func (c *Chord) Inversion(n int, octave int32) []int32 {
+	pitches := c.Pitches(octave)
+	if c.Bass >= 0 && c.Bass != c.Root {
+		return append(pitches[:1:1], Invert(pitches[1:], n)...)
+	}
+	return Invert(pitches, n)
+}
+
+// splitRoot splits a leading note name (letter plus accidentals) from s.
+func splitRoot(s string) (root, rest string) {
+	if s == "" || s[0] < 'A' || s[0] > 'G' {
+		return "", s
+	}
+
+	i := 1
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != '#' && r != 'b' && r != '♯' && r != '♭' {
+			break
+		}
+		i += size
+	}
+
+	return s[:i], s[i:]
+}
@@ -0,0 +1,14 @@
+package theory
+
+import (
+	"strconv"
+
+	"github.com/matt0792/ableton-ctrl/alsex/note"
+)
+
+// Name converts a MIDI pitch to a note name with octave, e.g. 61 -> "C#4" or
+// "Db4" when flats is set. Unlike note.FromMidi, pitches outside 0-127 are
+// named rather than rejected.
+func Name(pitch int32, flats bool) string {
+	return note.PitchClassName(pitch, flats) + strconv.Itoa(floorDiv(int(pitch), 12)-1)
+}
@@ -0,0 +1,167 @@
+package theory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matt0792/ableton-ctrl/alsex/note"
+)
+
+var scaleIntervals = map[string][]int32{
+	"major":            {0, 2, 4, 5, 7, 9, 11},
+	"ionian":           {0, 2, 4, 5, 7, 9, 11},
+	"dorian":           {0, 2, 3, 5, 7, 9, 10},
+	"phrygian":         {0, 1, 3, 5, 7, 8, 10},
+	"lydian":           {0, 2, 4, 6, 7, 9, 11},
+	"mixolydian":       {0, 2, 4, 5, 7, 9, 10},
+	"minor":            {0, 2, 3, 5, 7, 8, 10},
+	"aeolian":          {0, 2, 3, 5, 7, 8, 10},
+	"locrian":          {0, 1, 3, 5, 6, 8, 10},
+	"harmonic minor":   {0, 2, 3, 5, 7, 8, 11},
+	"melodic minor":    {0, 2, 3, 5, 7, 9, 11},
+	"major pentatonic": {0, 2, 4, 7, 9},
+	"minor pentatonic": {0, 3, 5, 7, 10},
+	"blues":            {0, 3, 5, 6, 7, 10},
+	"whole tone":       {0, 2, 4, 6, 8, 10},
+	"chromatic":        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+}
+
+// semitones from the mode's root down to its parent major key, used to pick
+// sharps or flats when spelling notes
+var modeOffsets = map[string]int32{
+	"dorian":     2,
+	"phrygian":   4,
+	"lydian":     5,
+	"mixolydian": 7,
+	"minor":      9,
+	"aeolian":    9,
+	"locrian":    11,
+}
+
+// major keys written with flats
+var flatKeys = map[int32]bool{5: true, 10: true, 3: true, 8: true, 1: true, 6: true}
+
+// Scale is a set of pitch classes built on a root.
+type Scale struct {
+	Name      string
+	Root      int32 // pitch class 0-11
+	Intervals []int32
+	flats     bool
+}
+
+// NewScale builds a scale from a root name and a mode, e.g. ("F#", "dorian").
+func NewScale(root, mode string) (*Scale, error) {
+	pc, err := note.PitchClass(root)
+	if err != nil {
+		return nil, err
+	}
+
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		mode = "major"
+	}
+	intervals, ok := scaleIntervals[mode]
+	if !ok {
+		return nil, fmt.Errorf("unknown scale: %s", mode)
+	}
+
+	flats := strings.ContainsAny(root, "b♭")
+	if !flats && !strings.ContainsAny(root, "#♯") {
+		parent := mod12(pc - modeOffsets[mode])
+		flats = flatKeys[parent]
+	}
+
+	return &Scale{
+		Name:      strings.TrimSpace(root) + " " + mode,
+		Root:      pc,
+		Intervals: intervals,
+		flats:     flats,
+	}, nil
+}
+
+// ParseScale parses a root and mode separated by a space, e.g. "Bb minor".
+// The mode defaults to major.
+func ParseScale(s string) (*Scale, error) {
+	root, mode, _ := strings.Cut(strings.TrimSpace(s), " ")
+	return NewScale(root, mode)
+}
+
+// Contains reports whether the pitch belongs to the scale.
+func (s *Scale) Contains(pitch int32) bool {
+	return s.index(pitch) >= 0
+}
+
+// Pitches returns the scale's notes in the octave starting at root.
+func (s *Scale) Pitches(octave int32) []int32 {
+	base := (octave+1)*12 + s.Root
+	pitches := make([]int32, len(s.Intervals))
+	for i, interval := range s.Intervals {
+		pitches[i] = base + interval
+	}
+	return pitches
+}
+
+// Degree returns the pitch of a zero-based scale degree above the root in the
+// given octave. Degrees outside 0..len-1 wrap into neighbouring octaves.
+func (s *Scale) Degree(degree int, octave int32) int32 {
+	n := len(s.Intervals)
+	oct := floorDiv(degree, n)
+	step := degree - oct*n
+	return (octave+1+int32(oct))*12 + s.Root + s.Intervals[step]
+}
+
+// Snap moves a pitch to the nearest scale tone, preferring the lower one on
+// ties.
+func (s *Scale) Snap(pitch int32) int32 {
+	for d := int32(0); d < 12; d++ {
+		if s.Contains(pitch - d) {
+			return pitch - d
+		}
+		if s.Contains(pitch + d) {
+			return pitch + d
+		}
+	}
+	return pitch
+}
+
+// Transpose moves a pitch by scale degrees, keeping it in key. Pitches
+// outside the scale are snapped first.
+func (s *Scale) Transpose(pitch int32, degrees int) int32 {
+	pitch = s.Snap(pitch)
+	n := len(s.Intervals)
+	idx := s.index(pitch)
+
+	rel := pitch - s.Root
+	octave := floorDiv(int(rel), 12)
+
+	total := octave*n + idx + degrees
+	oct := floorDiv(total, n)
+	return s.Root + int32(oct)*12 + s.Intervals[total-oct*n]
+}
+
+// Spell returns the pitch's name using the key's accidentals, e.g. "Bb3".
+func (s *Scale) Spell(pitch int32) string {
+	return Name(pitch, s.flats)
+}
+
+func (s *Scale) index(pitch int32) int {
+	pc := mod12(pitch - s.Root)
+	for i, interval := range s.Intervals {
+		if interval == pc {
+			return i
+		}
+	}
+	return -1
+}
+
+func mod12(v int32) int32 {
+	return ((v % 12) + 12) % 12
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
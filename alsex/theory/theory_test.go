@@ -0,0 +1,124 @@
+package theory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseChord verifies chord symbols are parsed into root position pitches
+func TestParseChord(t *testing.T) {
+	tests := []struct {
+		symbol   string
+		expected []int32
+	}{
+		{"C", []int32{48, 52, 55}},
+		{"Am7", []int32{57, 60, 64, 67}},
+		{"F#m9", []int32{54, 57, 61, 64, 68}},
+		{"Bbmaj7", []int32{58, 62, 65, 69}},
+		{"Cmaj7/G", []int32{43, 48, 52, 55, 59}},
+		{"C6/9", []int32{48, 52, 55, 57, 62}},
+		{"D♭7", []int32{49, 53, 56, 59}},
+		{"Gdim7", []int32{55, 58, 61, 64}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			c, err := ParseChord(tt.symbol)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, c.Pitches(3))
+		})
+	}
+}
+
+// TestParseChordErrors verifies invalid symbols are rejected
+func TestParseChordErrors(t *testing.T) {
+	for _, symbol := range []string{"", "H7", "Cxyz", "c"} {
+		_, err := ParseChord(symbol)
+		assert.Error(t, err, symbol)
+	}
+
+	_, err := ParseChords("Am7 D7 Q")
+	assert.Error(t, err)
+}
+
+// TestInversion verifies inversions in both directions
+func TestInversion(t *testing.T) {
+	c, err := ParseChord("C")
+	require.NoError(t, err)
+
+	assert.Equal(t, []int32{52, 55, 60}, c.Inversion(1, 3))
+	assert.Equal(t, []int32{55, 60, 64}, c.Inversion(2, 3))
+	assert.Equal(t, []int32{43, 48, 52}, c.Inversion(-1, 3))
+
+	slash, err := ParseChord("C/E")
+	require.NoError(t, err)
+	assert.Equal(t, []int32{40, 52, 55, 60}, slash.Inversion(1, 3))
+}
+
+// TestVoicings verifies drop and spread voicings
+func TestVoicings(t *testing.T) {
+	cmaj7 := []int32{60, 64, 67, 71}
+
+	assert.Equal(t, []int32{55, 60, 64, 71}, Drop2(cmaj7))
+	assert.Equal(t, []int32{52, 60, 67, 71}, Drop3(cmaj7))
+	assert.Equal(t, []int32{60, 67, 76, 83}, Spread(cmaj7))
+}
+
+// TestVoiceLead verifies the closest inversion is chosen
+func TestVoiceLead(t *testing.T) {
+	c := []int32{48, 52, 55}
+	f := []int32{53, 57, 60}
+
+	assert.Equal(t, []int32{48, 53, 57}, VoiceLead(c, f))
+}
+
+// TestScale verifies scale membership, degrees and snapping
+func TestScale(t *testing.T) {
+	s, err := ParseScale("D dorian")
+	require.NoError(t, err)
+
+	assert.Equal(t, []int32{62, 64, 65, 67, 69, 71, 72}, s.Pitches(4))
+	assert.True(t, s.Contains(71))
+	assert.False(t, s.Contains(70))
+	assert.Equal(t, int32(74), s.Degree(7, 4))
+	assert.Equal(t, int32(60), s.Degree(-1, 4))
+	assert.Equal(t, int32(69), s.Snap(70))
+
+	_, err = NewScale("C", "bebop")
+	assert.Error(t, err)
+}
+
+// TestTranspose verifies key-aware transposition by scale degrees
+func TestTranspose(t *testing.T) {
+	s, err := NewScale("C", "major")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(64), s.Transpose(60, 2))  // C -> E
+	assert.Equal(t, int32(65), s.Transpose(64, 1))  // E -> F
+	assert.Equal(t, int32(72), s.Transpose(60, 7))  // up an octave
+	assert.Equal(t, int32(59), s.Transpose(60, -1)) // C -> B below
+	assert.Equal(t, int32(64), s.Transpose(61, 2))  // C# snaps to C
+}
+
+// TestName verifies MIDI to name conversion and key-aware spelling
+func TestName(t *testing.T) {
+	assert.Equal(t, "C4", Name(60, false))
+	assert.Equal(t, "C#4", Name(61, false))
+	assert.Equal(t, "Db4", Name(61, true))
+	assert.Equal(t, "C-1", Name(0, false))
+	assert.Equal(t, "G9", Name(127, false))
+
+	f, err := NewScale("F", "major")
+	require.NoError(t, err)
+	assert.Equal(t, "Bb3", f.Spell(58))
+
+	d, err := NewScale("D", "minor")
+	require.NoError(t, err)
+	assert.Equal(t, "Bb3", d.Spell(58))
+
+	e, err := NewScale("E", "major")
+	require.NoError(t, err)
+	assert.Equal(t, "G#4", e.Spell(68))
+}
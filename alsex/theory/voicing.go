@@ -0,0 +1,111 @@
+package theory
+
+import "sort"
+
+// Voicing rearranges chord pitches.
+type Voicing func(pitches []int32) []int32
+
+// Close keeps the pitches as they are, sorted.
+func Close(pitches []int32) []int32 {
+	return sorted(pitches)
+}
+
+// Invert moves the lowest note up an octave n times. Negative n moves the
+// highest note down instead.
+func Invert(pitches []int32, n int) []int32 {
+	result := sorted(pitches)
+	if len(result) < 2 {
+		return result
+	}
+
+	for ; n > 0; n-- {
+		result = append(result[1:], result[0]+12)
+		for result[len(result)-1] <= result[len(result)-2] {
+			result[len(result)-1] += 12
+		}
+	}
+	for ; n < 0; n++ {
+		last := result[len(result)-1] - 12
+		for last >= result[0] {
+			last -= 12
+		}
+		result = append([]int32{last}, result[:len(result)-1]...)
+	}
+
+	return result
+}
+
+// Drop2 drops the second highest note an octave.
+func Drop2(pitches []int32) []int32 {
+	return drop(pitches, 2)
+}
+
+// Drop3 drops the third highest note an octave.
+func Drop3(pitches []int32) []int32 {
+	return drop(pitches, 3)
+}
+
+// Spread alternately raises every other note above the root an octave for an
+// open voicing.
+func Spread(pitches []int32) []int32 {
+	result := sorted(pitches)
+	for i := 1; i < len(result); i += 2 {
+		result[i] += 12
+	}
+	return sorted(result)
+}
+
+// VoiceLead returns the inversion of next, within an octave of its root
+// position, that moves the least total distance from prev.
+func VoiceLead(prev, next []int32) []int32 {
+	if len(prev) == 0 || len(next) == 0 {
+		return sorted(next)
+	}
+
+	best := sorted(next)
+	bestCost := leadCost(prev, best)
+	for n := -len(next); n <= len(next); n++ {
+		candidate := Invert(next, n)
+		if cost := leadCost(prev, candidate); cost < bestCost {
+			best, bestCost = candidate, cost
+		}
+	}
+	return best
+}
+
+// leadCost sums the distance from each note to its nearest note in prev
+func leadCost(prev, next []int32) int32 {
+	var cost int32
+	for _, p := range next {
+		nearest := int32(1 << 30)
+		for _, q := range prev {
+			if d := abs(p - q); d < nearest {
+				nearest = d
+			}
+		}
+		cost += nearest
+	}
+	return cost
+}
+
+func drop(pitches []int32, n int) []int32 {
+	result := sorted(pitches)
+	if len(result) < n {
+		return result
+	}
+	result[len(result)-n] -= 12
+	return sorted(result)
+}
+
+func sorted(pitches []int32) []int32 {
+	result := append([]int32(nil), pitches...)
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
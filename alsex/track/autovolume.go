@@ -0,0 +1,237 @@
+package track
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Fader is the volume control a leveler rides.
+type Fader interface {
+	Get() float32
+	Set(value float32)
+}
+
+// State is the state of an AutoVolume.
+type State int
+
+const (
+	Stopped  State = iota
+	Leveling       // riding the fader towards the target
+	Holding        // signal below the floor, fader left alone
+)
+
+func (s State) String() string {
+	switch s {
+	case Leveling:
+		return "leveling"
+	case Holding:
+		return "holding"
+	default:
+		return "stopped"
+	}
+}
+
+// AutoVolumeOpts configures an AutoVolume. Levels are in dB.
+type AutoVolumeOpts struct {
+	Target    float32       // loudness to level to
+	Tolerance float32       // distance from target that is left alone
+	Floor     float32       // loudness below which the fader is held
+	Attack    time.Duration // time to correct a level that is too loud
+	Release   time.Duration // time to correct a level that is too quiet
+	MaxStep   float32       // largest change per adjustment
+	MaxBoost  float32       // furthest above the starting volume to ride
+	MaxCut    float32       // furthest below the starting volume to ride
+	MinVolume float32       // fader range, 0 to 1
+	MaxVolume float32
+	Interval  time.Duration // time between adjustments
+}
+
+// DefaultAutoVolumeOpts returns gentle leveling to -15 dB.
+func DefaultAutoVolumeOpts() AutoVolumeOpts {
+	return AutoVolumeOpts{
+		Target:    -15,
+		Tolerance: 3,
+		Floor:     -60,
+		Attack:    2 * time.Second,
+		Release:   4 * time.Second,
+		MaxStep:   0.5,
+		MaxBoost:  6,
+		MaxCut:    12,
+		MinVolume: 0.11, // about -40dB
+		MaxVolume: 1,
+		Interval:  100 * time.Millisecond,
+	}
+}
+
+// withDefaults fills in options that can't be zero
+func (o AutoVolumeOpts) withDefaults() AutoVolumeOpts {
+	if o.Interval <= 0 {
+		o.Interval = DefaultAutoVolumeOpts().Interval
+	}
+	return o
+}
+
+// AutoVolume handles auto volume adjustment, similar to a slow compressor
+// with makeup gain. It is safe to use from multiple goroutines.
+type AutoVolume struct {
+	monitor *VolumeMonitor
+	fader   Fader
+
+	mu     sync.Mutex // guards the lifecycle, held across Start and Stop
+	opts   AutoVolumeOpts
+	cancel chan struct{}
+	done   chan struct{}
+
+	stateMu sync.Mutex // guards leveling state used by the loop
+	state   State
+	start   float32 // fader volume in dB when started
+	ride    float32 // dB applied relative to start
+}
+
+// NewAutoVolume creates a leveler riding fader to hold the loudness measured
+// by monitor at the target. An Interval that isn't positive uses the
+// default.
+func NewAutoVolume(monitor *VolumeMonitor, fader Fader, opts AutoVolumeOpts) *AutoVolume {
+	return &AutoVolume{
+		monitor: monitor,
+		fader:   fader,
+		opts:    opts.withDefaults(),
+	}
+}
+
+// Configure replaces the options, taking effect from the next adjustment,
+// or the next Start for Interval.
+func (av *AutoVolume) Configure(opts AutoVolumeOpts) {
+	av.mu.Lock()
+	defer av.mu.Unlock()
+	av.stateMu.Lock()
+	defer av.stateMu.Unlock()
+	av.opts = opts.withDefaults()
+}
+
+// Opts returns the current options.
+func (av *AutoVolume) Opts() AutoVolumeOpts {
+	av.stateMu.Lock()
+	defer av.stateMu.Unlock()
+	return av.opts
+}
+
+// State returns what the leveler is doing.
+func (av *AutoVolume) State() State {
+	av.stateMu.Lock()
+	defer av.stateMu.Unlock()
+	return av.state
+}
+
+// Ride returns the gain applied relative to the starting volume, in dB.
+func (av *AutoVolume) Ride() float32 {
+	av.stateMu.Lock()
+	defer av.stateMu.Unlock()
+	return av.ride
+}
+
+// Start begins leveling from the fader's current volume. Starting a running
+// leveler does nothing.
+func (av *AutoVolume) Start() {
+	av.mu.Lock()
+	defer av.mu.Unlock()
+
+	if av.cancel != nil {
+		return
+	}
+
+	av.stateMu.Lock()
+	av.start = VolumeToDb(av.fader.Get())
+	av.ride = 0
+	av.state = Holding
+	interval := av.opts.Interval
+	av.stateMu.Unlock()
+
+	av.monitor.Start()
+	av.cancel = make(chan struct{})
+	av.done = make(chan struct{})
+	go av.run(interval, av.cancel, av.done)
+}
+
+// Stop stops leveling, leaving the fader where it is. Once Stop returns the
+// fader is no longer touched.
+func (av *AutoVolume) Stop() {
+	av.mu.Lock()
+	defer av.mu.Unlock()
+
+	if av.cancel == nil {
+		return
+	}
+
+	close(av.cancel)
+	<-av.done
+	av.cancel = nil
+	av.done = nil
+	av.monitor.Stop()
+
+	av.stateMu.Lock()
+	av.state = Stopped
+	av.stateMu.Unlock()
+}
+
+func (av *AutoVolume) run(interval time.Duration, cancel, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cancel:
+			return
+		case <-ticker.C:
+			av.adjust()
+		}
+	}
+}
+
+func (av *AutoVolume) adjust() {
+	level := av.monitor.GetCurrentLevel()
+
+	av.stateMu.Lock()
+	defer av.stateMu.Unlock()
+	opts := av.opts
+
+	if level < opts.Floor {
+		av.state = Holding
+		return
+	}
+	av.state = Leveling
+
+	diff := opts.Target - level
+	if float32(math.Abs(float64(diff))) < opts.Tolerance {
+		return
+	}
+
+	timeConstant := opts.Attack
+	if diff > 0 {
+		timeConstant = opts.Release
+	}
+	step := float32(Smooth(0, float64(diff), opts.Interval, timeConstant))
+	step = clamp(step, -opts.MaxStep, opts.MaxStep)
+
+	ride := clamp(av.ride+step, -opts.MaxCut, opts.MaxBoost)
+	volume := clamp(DbToVolume(av.start+ride), opts.MinVolume, opts.MaxVolume)
+	if ride == av.ride {
+		return
+	}
+
+	av.ride = ride
+	av.fader.Set(volume)
+}
+
+func clamp(v, lo, hi float32) float32 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,254 @@
+package track
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// DefaultWindow is the loudness window, similar to short-term loudness.
+const DefaultWindow = 3 * time.Second
+
+// gate is the level below which meter readings are ignored, like the
+// absolute gate of a loudness meter
+const gate = -70.0
+
+// MeterSource delivers output meter readings, 0 to 1 per channel.
+type MeterSource interface {
+	Subscribe(fn func(left, right float32)) (unsubscribe func())
+}
+
+// Meter returns a source reading the track's output meters from Live's
+// listeners, shared with other users of the client through listen.Shared.
+// Tracked tracks move their listeners with the track.
+func (t *Track) Meter() MeterSource {
+	return listenerMeter{t}
+}
+
+type listenerMeter struct {
+	*Track
+}
+
+func (m listenerMeter) Subscribe(fn func(left, right float32)) func() {
+	var mu sync.Mutex
+	var left, right float32
+
+	hub := listen.Shared(m.client)
+	subscribe := func(trackID int32, property string, channel *float32) func() {
+		topic := listen.Topic{Object: "track", Property: property, Indices: []int32{trackID}}
+		// track topics with one index are always valid
+		unsubscribe, _ := hub.Subscribe(topic, func(e listen.Event) {
+			val, ok := als.First(e.Values).(float32)
+			if !ok {
+				return
+			}
+			mu.Lock()
+			*channel = val
+			l, r := left, right
+			mu.Unlock()
+			fn(l, r)
+		})
+		return unsubscribe
+	}
+	start := func(trackID int32) func() {
+		stopLeft := subscribe(trackID, "output_meter_left", &left)
+		stopRight := subscribe(trackID, "output_meter_right", &right)
+		return func() {
+			stopLeft()
+			stopRight()
+		}
+	}
+
+	// stop ends the subscriptions on the track's current index. Moving
+	// subscribes to the new index before leaving the old, so a listener
+	// others share on either index keeps running.
+	var running sync.Mutex
+	stop := func() {}
+	if trackID, ok := m.id(); ok {
+		stop = start(trackID)
+	}
+	cancel := m.ref.Watch(func(trackID int32, err error) {
+		running.Lock()
+		defer running.Unlock()
+		previous := stop
+		stop = func() {}
+		if err == nil {
+			stop = start(trackID)
+		}
+		previous()
+	})
+	return func() {
+		cancel()
+		running.Lock()
+		defer running.Unlock()
+		stop()
+		stop = func() {}
+	}
+}
+
+// VolumeMonitor estimates loudness from meter readings over a sliding
+// window. Live's meters report peak levels rather than samples, so each
+// reading is treated as the peak of a sine, whose power is half the peak
+// squared. There is no K-weighting, so levels are only roughly LUFS.
+type VolumeMonitor struct {
+	source MeterSource
+	window time.Duration
+	now    func() time.Time
+
+	mu          sync.Mutex
+	readings    []reading
+	unsubscribe func()
+	users       int
+}
+
+type reading struct {
+	time  time.Time
+	power float64
+}
+
+// NewVolumeMonitor creates a monitor on the track's meters over
+// DefaultWindow.
+func NewVolumeMonitor(track *Track) *VolumeMonitor {
+	return NewMeterMonitor(track.Meter(), DefaultWindow)
+}
+
+// NewMeterMonitor creates a monitor on any meter source over window, or
+// DefaultWindow if window isn't positive.
+func NewMeterMonitor(source MeterSource, window time.Duration) *VolumeMonitor {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &VolumeMonitor{
+		source: source,
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// Start subscribes to the meter source. Calls are counted, so the monitor
+// keeps listening until Stop has been called as many times as Start.
+func (vm *VolumeMonitor) Start() {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	vm.users++
+	if vm.users == 1 {
+		vm.unsubscribe = vm.source.Subscribe(vm.Add)
+	}
+}
+
+// Stop unsubscribes from the meter source and clears the window.
+func (vm *VolumeMonitor) Stop() {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	if vm.users == 0 {
+		return
+	}
+	vm.users--
+	if vm.users == 0 {
+		vm.unsubscribe()
+		vm.unsubscribe = nil
+		vm.readings = nil
+	}
+}
+
+// Add records a meter reading.
+func (vm *VolumeMonitor) Add(left, right float32) {
+	power := (float64(left)*float64(left) + float64(right)*float64(right)) / 4
+
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	now := vm.now()
+	vm.readings = append(vm.readings, reading{time: now, power: power})
+	vm.expire(now)
+}
+
+// GetCurrentLevel returns the loudness over the window in dB, or -100 when
+// there's no signal above the gate.
+func (vm *VolumeMonitor) GetCurrentLevel() float32 {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	vm.expire(vm.now())
+
+	var sum float64
+	var n int
+	for _, r := range vm.readings {
+		if powerToDb(r.power) > gate {
+			sum += r.power
+			n++
+		}
+	}
+	if n == 0 {
+		return -100
+	}
+	return float32(powerToDb(sum / float64(n)))
+}
+
+func (vm *VolumeMonitor) expire(now time.Time) {
+	cutoff := now.Add(-vm.window)
+	i := 0
+	for i < len(vm.readings) && vm.readings[i].time.Before(cutoff) {
+		i++
+	}
+	vm.readings = vm.readings[i:]
+}
+
+// --- Helpers ---
+func powerToDb(power float64) float64 {
+	if power <= 0 {
+		return -100.0
+	}
+	return 10 * math.Log10(power)
+}
+
+// Live's volume faders are tapered: 0.85 is 0 dB and 1 is +6 dB, with 40 dB
+// per unit down to -18 dB at 0.4, below which the taper turns logarithmic.
+// The conversions follow that closely enough for riding a fader by a few dB.
+const (
+	unityVolume = 0.85
+	taperVolume = 0.4
+	dbPerUnit   = 40
+)
+
+// VolumeToDb converts a volume fader position from 0 to 1 to dB, or -100 for
+// silence.
+func VolumeToDb(volume float32) float32 {
+	v := float64(volume)
+	switch {
+	case v <= 0:
+		return -100
+	case v >= taperVolume:
+		return float32((v - unityVolume) * dbPerUnit)
+	}
+	db := (taperVolume-unityVolume)*dbPerUnit + dbPerUnit*math.Log10(v/taperVolume)
+	return float32(math.Max(db, -100))
+}
+
+// DbToVolume converts dB to a volume fader position, the inverse of
+// VolumeToDb. The result isn't clamped to the fader's range.
+func DbToVolume(db float32) float32 {
+	taper := (taperVolume - unityVolume) * dbPerUnit
+	if float64(db) >= taper {
+		return float32(unityVolume + float64(db)/dbPerUnit)
+	}
+	if db <= -100 {
+		return 0
+	}
+	return float32(taperVolume * math.Pow(10, (float64(db)-taper)/dbPerUnit))
+}
+
+// Smooth returns current moved towards target over dt, like a one-pole
+// filter with time constant tau, as used for attack and release. A tau that
+// isn't positive jumps straight to target.
+func Smooth(current, target float64, dt, tau time.Duration) float64 {
+	if tau <= 0 {
+		return target
+	}
+	return current + (target-current)*(1-math.Exp(-dt.Seconds()/tau.Seconds()))
+}
@@ -1,30 +1,67 @@
 package track
 
 import (
-	"context"
-	"math"
-	"time"
-
 	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/ident"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
 )
 
 type Track struct {
+	client        *als.Client
 	api           *als.TrackAPI
-	trackID       int32
+	ref           *ident.Ref
 	autoVolume    *AutoVolume
 	volumeMonitor *VolumeMonitor
 }
 
 func New(api *als.TrackAPI, trackID int32) *Track {
+	return NewWithClient(api.Client(), trackID)
+}
+
+// NewWithClient creates a Track at a fixed index, like New, from the client
+// rather than its TrackAPI.
+func NewWithClient(client *als.Client, trackID int32) *Track {
+	return NewTracked(client, ident.Fixed(trackID))
+}
+
+// NewTracked creates a Track that follows its track as tracks are inserted
+// and deleted, see ident.Tracker. Once the track is deleted, setters do
+// nothing, getters return zero values and Err reports it.
+func NewTracked(client *als.Client, ref *ident.Ref) *Track {
 	t := &Track{
-		api:     api,
-		trackID: trackID,
+		client: client,
+		api:    client.Track,
+		ref:    ref,
 	}
-	t.autoVolume = &AutoVolume{Track: t}
-	t.volumeMonitor = NewVolumeMonitor(t)
+	t.volumeMonitor = NewMeterMonitor(t.Meter(), DefaultWindow)
+	t.autoVolume = NewAutoVolume(t.volumeMonitor, t.fader(), DefaultAutoVolumeOpts())
 	return t
 }
 
+// NewByName creates a Track for the one track matching name, see
+// resolve.Pattern. The name is resolved once: the Track keeps that index
+// when tracks move. Resolve the index and pass a ref from an ident.Tracker
+// to NewTracked to follow the track instead.
+func NewByName(client *als.Client, r *resolve.Resolver, name string) (*Track, error) {
+	trackID, err := r.Track(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithClient(client, trackID), nil
+}
+
+// Err returns an error wrapping ident.ErrDeleted once the track was deleted.
+func (t *Track) Err() error {
+	_, err := t.ref.Index()
+	return err
+}
+
+// id returns the track's current index, false once it was deleted
+func (t *Track) id() (int32, bool) {
+	trackID, err := t.ref.Index()
+	return trackID, err == nil
+}
+
 type Volume struct {
 	*Track
 }
@@ -41,149 +78,50 @@ func (v *Volume) Auto(isEnabled bool) {
 	}
 }
 
-func (v *Volume) Set(value float32) {
-	v.autoVolume.Stop()
-	v.api.SetVolume(v.trackID, value)
-}
-
-func (v *Volume) Get() float32 {
-	return v.api.GetVolume(v.trackID)
-}
-
-// AutoVolume handles auto volume adjustment, similar to a compressor
-type AutoVolume struct {
-	*Track
-	ctx         context.Context
-	cancel      context.CancelFunc
-	targetRMS   float32
-	maxAdjust   float32
-	attackTime  float32
-	releaseTime float32
-}
-
-func (av *AutoVolume) Start() {
-	if av.cancel != nil {
-		return
-	}
-
-	av.ctx, av.cancel = context.WithCancel(context.Background())
-
-	av.targetRMS = -15.0 // dB RMS
-	av.maxAdjust = 0.5
-	av.attackTime = 2.0 // seconds
-	av.releaseTime = 4.0
-
-	go av.monitor()
+// AutoVolume returns the track's leveler, to configure it before enabling
+// it with Auto.
+func (v *Volume) AutoVolume() *AutoVolume {
+	return v.autoVolume
 }
 
-func (av *AutoVolume) Stop() {
-	if av.cancel != nil {
-		av.cancel()
-		av.cancel = nil
+func (v *Volume) Set(value float32) {
+	v.autoVolume.Stop()
+	if trackID, ok := v.id(); ok {
+		v.api.SetVolume(trackID, value)
 	}
 }
 
-func (av *AutoVolume) monitor() {
-	ticker := time.NewTicker(100 * time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-av.ctx.Done():
-			return
-		case <-ticker.C:
-			av.adjust()
-		}
+func (v *Volume) Get() float32 {
+	trackID, ok := v.id()
+	if !ok {
+		return 0
 	}
+	return v.api.GetVolume(trackID)
 }
 
-func (av *AutoVolume) adjust() {
-	level := av.volumeMonitor.GetCurrentLevel()
-
-	if level < -60.0 {
-		return
-	}
-
-	diff := av.targetRMS - level
-
-	if math.Abs(float64(diff)) < 3.0 {
-		return
-	}
-
-	adjustSpeed := av.attackTime
-	if diff > 0 {
-		adjustSpeed = av.releaseTime
-	}
-
-	adjustment := diff / adjustSpeed
-
-	// clamp
-	if adjustment > av.maxAdjust {
-		adjustment = av.maxAdjust
-	} else if adjustment < -av.maxAdjust {
-		adjustment = -av.maxAdjust
-	}
-
-	// get current & adjust
-	currentVol := av.api.GetVolume(av.trackID)
-	newVol := dbToLinear(linearToDb(currentVol) + adjustment)
-
-	// clamp
-	if newVol < 0.01 { // about -40dB
-		newVol = 0.01
-	} else if newVol > 1.0 { // 0dB
-		newVol = 1.0
-	}
-
-	av.api.SetVolume(av.trackID, newVol)
+// Monitor returns the track's loudness monitor
+func (t *Track) Monitor() *VolumeMonitor {
+	return t.volumeMonitor
 }
 
-// VolumeMonitor tracks audio levels
-type VolumeMonitor struct {
-	track        *Track
-	currentLevel float32
-	rmsWindow    []float32
-	windowSize   int
+func (t *Track) fader() Fader {
+	return trackFader{t}
 }
 
-func NewVolumeMonitor(track *Track) *VolumeMonitor {
-	return &VolumeMonitor{
-		track:      track,
-		rmsWindow:  make([]float32, 0, 20),
-		windowSize: 20, // 2 second avg
-	}
+type trackFader struct {
+	*Track
 }
 
-func (vm *VolumeMonitor) GetCurrentLevel() float32 {
-	// get peak from track
-	peak := vm.track.api.GetOutputMeterLevel(vm.track.trackID)
-
-	// add to rms window
-	vm.rmsWindow = append(vm.rmsWindow, peak)
-	if len(vm.rmsWindow) > vm.windowSize {
-		vm.rmsWindow = vm.rmsWindow[1:]
-	}
-
-	// calc RMS
-	var sum float32
-	for _, v := range vm.rmsWindow {
-		sum += v * v
+func (f trackFader) Get() float32 {
+	trackID, ok := f.id()
+	if !ok {
+		return 0
 	}
-	rms := float32(math.Sqrt(float64(sum / float32(len(vm.rmsWindow)))))
-
-	// to dB
-	vm.currentLevel = linearToDb(rms)
-	return vm.currentLevel
+	return f.api.GetVolume(trackID)
 }
 
-// --- Helpers ---
-func linearToDb(linear float32) float32 {
-	if linear <= 0 {
-		return -100.0
+func (f trackFader) Set(value float32) {
+	if trackID, ok := f.id(); ok {
+		f.api.SetVolume(trackID, value)
 	}
-	return float32(20.0 * math.Log10(float64(linear)))
-}
-
-func dbToLinear(db float32) float32 {
-	return float32(math.Pow(10.0, float64(db)/20.0))
 }
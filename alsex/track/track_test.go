@@ -0,0 +1,253 @@
+package track
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsex/ident"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMeter is a meter source fed by the test
+type fakeMeter struct {
+	mu          sync.Mutex
+	subscribers int
+	fn          func(left, right float32)
+}
+
+func (m *fakeMeter) Subscribe(fn func(left, right float32)) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers++
+	m.fn = fn
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.subscribers--
+	}
+}
+
+func (m *fakeMeter) send(left, right float32) {
+	m.mu.Lock()
+	fn := m.fn
+	m.mu.Unlock()
+	fn(left, right)
+}
+
+type fakeFader struct {
+	mu     sync.Mutex
+	volume float32
+}
+
+func (f *fakeFader) Get() float32 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.volume
+}
+
+func (f *fakeFader) Set(value float32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.volume = value
+}
+
+// TestVolumeMonitor verifies loudness is estimated over the window
+func TestVolumeMonitor(t *testing.T) {
+	meter := &fakeMeter{}
+	vm := NewMeterMonitor(meter, time.Second)
+	now := time.Unix(0, 0)
+	vm.now = func() time.Time { return now }
+
+	vm.Start()
+	vm.Start()
+	assert.Equal(t, 1, meter.subscribers)
+	assert.Equal(t, float32(-100), vm.GetCurrentLevel())
+
+	// a full scale sine peaks at 1 with a power of -3 dB
+	meter.send(1, 1)
+	assert.InDelta(t, -3.01, vm.GetCurrentLevel(), 0.01)
+
+	// silence is gated rather than averaged in
+	meter.send(0, 0)
+	assert.InDelta(t, -3.01, vm.GetCurrentLevel(), 0.01)
+
+	now = now.Add(2 * time.Second)
+	meter.send(0.1, 0.1)
+	assert.InDelta(t, -23.01, vm.GetCurrentLevel(), 0.01)
+
+	vm.Stop()
+	assert.Equal(t, 1, meter.subscribers)
+	vm.Stop()
+	vm.Stop()
+	assert.Equal(t, 0, meter.subscribers)
+}
+
+// TestAutoVolumeAdjust verifies leveling, time constants and ride limits
+func TestAutoVolumeAdjust(t *testing.T) {
+	meter := &fakeMeter{}
+	vm := NewMeterMonitor(meter, time.Second)
+	fader := &fakeFader{volume: 0.5}
+
+	opts := DefaultAutoVolumeOpts()
+	opts.Interval = time.Hour // adjust by hand
+	opts.Attack = 0
+	opts.MaxStep = 2
+	opts.MaxCut = 3
+	av := NewAutoVolume(vm, fader, opts)
+
+	av.Start()
+	defer av.Stop()
+	assert.Equal(t, Holding, av.State())
+
+	// too loud, cut by max step until the ride limit
+	meter.send(1, 1)
+	av.adjust()
+	assert.Equal(t, Leveling, av.State())
+	assert.Equal(t, float32(-2), av.Ride())
+	av.adjust()
+	assert.Equal(t, float32(-3), av.Ride())
+	assert.InDelta(t, VolumeToDb(0.5)-3, VolumeToDb(fader.Get()), 1e-4)
+
+	// within tolerance leaves the fader alone
+	av.Configure(AutoVolumeOpts{Target: -4, Tolerance: 3, Floor: -60, MaxStep: 2, MaxCut: 3, MinVolume: 0.01, MaxVolume: 1})
+	av.adjust()
+	assert.Equal(t, float32(-3), av.Ride())
+
+	// a zero interval adjusts at the default rate rather than not at all
+	av.Configure(AutoVolumeOpts{Target: -20, Tolerance: 1, Floor: -60, Attack: time.Second, MaxStep: 2, MaxCut: 12, MinVolume: 0.01, MaxVolume: 1})
+	assert.Equal(t, 100*time.Millisecond, av.Opts().Interval)
+	av.adjust()
+	assert.InDelta(t, -3-17*(1-math.Exp(-0.1)), av.Ride(), 1e-2)
+}
+
+// TestAutoVolumeConcurrent verifies Start and Stop are safe to race
+func TestAutoVolumeConcurrent(t *testing.T) {
+	meter := &fakeMeter{}
+	opts := DefaultAutoVolumeOpts()
+	opts.Interval = time.Millisecond
+	av := NewAutoVolume(NewMeterMonitor(meter, time.Second), &fakeFader{volume: 0.5}, opts)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			av.Start()
+		}()
+		go func() {
+			defer wg.Done()
+			av.Stop()
+		}()
+	}
+	wg.Wait()
+
+	av.Stop()
+	require.Equal(t, Stopped, av.State())
+	assert.Equal(t, 0, meter.subscribers)
+}
+
+// TestMeter verifies a tracked track's meter moves with the track through
+// the shared hub, leaving listeners others use running
+func TestMeter(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	client := live.Client(t)
+	tracker := ident.New(client)
+
+	left := listen.Topic{Object: "track", Property: "output_meter_left", Indices: []int32{1}}
+	unsubscribe, err := listen.Shared(client).Subscribe(left, func(listen.Event) {})
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	readings := make(chan [2]float32, 4)
+	stop := NewTracked(client, tracker.Track(1)).Meter().Subscribe(func(l, r float32) {
+		readings <- [2]float32{l, r}
+	})
+	live.WaitFor(t, "/live/track/start_listen/output_meter_right")
+
+	require.NoError(t, tracker.CreateMIDITrack(0))
+	assert.Eventually(t, func() bool {
+		for _, msg := range live.Received("/live/track/start_listen/output_meter_left") {
+			if msg.Arguments[0] == int32(2) {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 5*time.Millisecond)
+	live.Emit("/live/track/get/output_meter_left", int32(2), float32(0.25))
+	select {
+	case r := <-readings:
+		assert.Equal(t, [2]float32{0.25, 0}, r)
+	case <-time.After(time.Second):
+		t.Fatal("no reading")
+	}
+
+	stop()
+	live.WaitFor(t, "/live/track/stop_listen/output_meter_left")
+	for _, msg := range live.Received("/live/track/stop_listen/output_meter_left") {
+		assert.Equal(t, []any{int32(2)}, msg.Arguments)
+	}
+	assert.Equal(t, 1, listen.Shared(client).Count(left))
+}
+
+// TestNew verifies the TrackAPI and client constructors address the same
+// track
+func TestNew(t *testing.T) {
+	live := alstest.NewServer(t)
+	client := live.Client(t)
+
+	New(client.Track, 2).Volume().Set(0.5)
+	NewWithClient(client, 2).Volume().Set(0.6)
+	assert.Eventually(t, func() bool {
+		return len(live.Received("/live/track/set/volume")) == 2
+	}, time.Second, 5*time.Millisecond)
+	var sets [][]any
+	for _, msg := range live.Received("/live/track/set/volume") {
+		sets = append(sets, msg.Arguments)
+	}
+	assert.ElementsMatch(t, [][]any{{int32(2), float32(0.5)}, {int32(2), float32(0.6)}}, sets)
+
+	vm := NewVolumeMonitor(New(client.Track, 2))
+	vm.Start()
+	defer vm.Stop()
+	live.WaitFor(t, "/live/track/start_listen/output_meter_left")
+}
+
+// TestVolumeToDb verifies Live's fader taper and its inverse
+func TestVolumeToDb(t *testing.T) {
+	assert.InDelta(t, 0, VolumeToDb(0.85), 1e-4)
+	assert.InDelta(t, 6, VolumeToDb(1), 1e-4)
+	assert.InDelta(t, -18, VolumeToDb(0.4), 1e-4)
+	assert.Equal(t, float32(-100), VolumeToDb(0))
+	for _, v := range []float32{0.05, 0.2, 0.4, 0.6, 0.85, 1} {
+		assert.InDelta(t, v, DbToVolume(VolumeToDb(v)), 1e-5)
+	}
+
+	assert.InDelta(t, 0.85-6.0/40, DbToVolume(VolumeToDb(0.85)-6), 1e-5)
+	assert.Equal(t, 2.0, Smooth(1, 2, time.Second, 0))
+	assert.InDelta(t, 1+(1-math.Exp(-1)), Smooth(1, 2, time.Second, time.Second), 1e-9)
+}
+
+// TestNewByName verifies the resolved index is the one sent, and ambiguous
+// names are rejected
+func TestNewByName(t *testing.T) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Bass", "Vox 1", "Vox 2")
+	client := live.Client(t)
+	r := resolve.New(client)
+
+	bass, err := NewByName(client, r, "bass")
+	require.NoError(t, err)
+	bass.Volume().Set(0.5)
+	msgs := live.WaitFor(t, "/live/track/set/volume")
+	assert.Equal(t, []any{int32(1), float32(0.5)}, msgs[0].Arguments)
+
+	_, err = NewByName(client, r, "Vox*")
+	assert.EqualError(t, err, `track "Vox*" is ambiguous, it matches 2 "Vox 1", 3 "Vox 2"`)
+}
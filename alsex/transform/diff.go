@@ -0,0 +1,100 @@
+package transform
+
+import (
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// DefaultTolerance is the timing tolerance in beats used to match notes.
+const DefaultTolerance float32 = 1e-3
+
+// Change is the difference between two sets of notes. Remove lists notes to
+// delete and Add the notes to write, Keep the notes that match.
+type Change struct {
+	Remove []als.Note
+	Add    []als.Note
+	Keep   []als.Note
+}
+
+// Empty reports whether the change does nothing.
+func (c Change) Empty() bool {
+	return len(c.Remove) == 0 && len(c.Add) == 0
+}
+
+// Diff compares current notes against desired ones. Notes match when pitch,
+// velocity and mute are equal and start and duration are within tolerance
+// beats.
+//
+// Notes are removed by pitch and a start window of ±tolerance, so a kept note
+// sharing a removed note's pitch and window is removed and re-added too.
+func Diff(current, desired []als.Note, tolerance float32) Change {
+	byPitch := make(map[int32][]int, len(current))
+	for i, n := range current {
+		byPitch[n.Pitch] = append(byPitch[n.Pitch], i)
+	}
+
+	matched := make([]bool, len(current))
+	var change Change
+	for _, want := range desired {
+		found := false
+		for _, i := range byPitch[want.Pitch] {
+			if !matched[i] && same(current[i], want, tolerance) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			change.Add = append(change.Add, want)
+		}
+	}
+
+	for i, n := range current {
+		if !matched[i] {
+			change.Remove = append(change.Remove, n)
+		}
+	}
+
+	// removing a note can take out a neighbour, which then needs re-adding
+	for dirty := true; dirty; {
+		dirty = false
+		for i, n := range current {
+			if matched[i] && collides(n, change.Remove, tolerance) {
+				matched[i] = false
+				dirty = true
+				change.Remove = append(change.Remove, n)
+				change.Add = append(change.Add, n)
+			}
+		}
+	}
+
+	for i, n := range current {
+		if matched[i] {
+			change.Keep = append(change.Keep, n)
+		}
+	}
+
+	return change
+}
+
+func same(a, b als.Note, tolerance float32) bool {
+	return a.Pitch == b.Pitch &&
+		a.Velocity == b.Velocity &&
+		a.Mute == b.Mute &&
+		near(a.StartTime, b.StartTime, tolerance) &&
+		near(a.Duration, b.Duration, tolerance)
+}
+
+// collides reports whether removing any of removed would also remove n
+func collides(n als.Note, removed []als.Note, tolerance float32) bool {
+	for _, r := range removed {
+		if r.Pitch == n.Pitch && n.StartTime >= r.StartTime-tolerance && n.StartTime < r.StartTime+tolerance {
+			return true
+		}
+	}
+	return false
+}
+
+func near(a, b, tolerance float32) bool {
+	d := a - b
+	return d <= tolerance && d >= -tolerance
+}
@@ -0,0 +1,32 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiff verifies only changed notes are removed and added
+func TestDiff(t *testing.T) {
+	current := []als.Note{note(60, 0, 1, 100), note(62, 1, 1, 100), note(64, 2, 1, 100)}
+	desired := []als.Note{note(60, 0.0004, 1, 100), note(62, 1, 1, 90), note(67, 3, 1, 100)}
+
+	change := Diff(current, desired, DefaultTolerance)
+	assert.Equal(t, []als.Note{note(60, 0, 1, 100)}, change.Keep)
+	assert.ElementsMatch(t, []als.Note{note(62, 1, 1, 100), note(64, 2, 1, 100)}, change.Remove)
+	assert.ElementsMatch(t, []als.Note{note(62, 1, 1, 90), note(67, 3, 1, 100)}, change.Add)
+
+	assert.True(t, Diff(current, current, DefaultTolerance).Empty())
+}
+
+// TestDiffCollision verifies kept notes caught by a removal are re-added
+func TestDiffCollision(t *testing.T) {
+	current := []als.Note{note(60, 0, 1, 100), note(60, 0, 0.5, 100)}
+	desired := []als.Note{note(60, 0, 1, 100)}
+
+	change := Diff(current, desired, DefaultTolerance)
+	assert.Empty(t, change.Keep)
+	assert.Len(t, change.Remove, 2)
+	assert.Equal(t, desired, change.Add)
+}
@@ -0,0 +1,157 @@
+package transform
+
+import (
+	"math"
+	"sort"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/theory"
+)
+
+// Func transforms a slice of notes, returning a new slice.
+type Func func(notes []als.Note) []als.Note
+
+// Quantize moves note starts towards the nearest multiple of grid. Strength
+// ranges from 0 (unchanged) to 1 (fully on the grid).
+func Quantize(notes []als.Note, grid, strength float32) []als.Note {
+	result := clone(notes)
+	if grid <= 0 {
+		return result
+	}
+	for i, n := range result {
+		target := float32(math.Round(float64(n.StartTime/grid))) * grid
+		result[i].StartTime = n.StartTime + (target-n.StartTime)*strength
+	}
+	return result
+}
+
+// Transpose shifts pitches by semitones. Notes moved outside 0-127 are
+// dropped.
+func Transpose(notes []als.Note, semitones int32) []als.Note {
+	result := make([]als.Note, 0, len(notes))
+	for _, n := range notes {
+		n.Pitch += semitones
+		if n.Pitch >= 0 && n.Pitch <= 127 {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// TransposeScale shifts pitches by scale degrees, keeping them in key.
+// Notes moved outside 0-127 are dropped.
+func TransposeScale(notes []als.Note, scale *theory.Scale, degrees int) []als.Note {
+	result := make([]als.Note, 0, len(notes))
+	for _, n := range notes {
+		n.Pitch = scale.Transpose(n.Pitch, degrees)
+		if n.Pitch >= 0 && n.Pitch <= 127 {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// Reverse mirrors notes in time within a clip of the given length, so a note
+// ending at the clip end starts at zero.
+func Reverse(notes []als.Note, length float32) []als.Note {
+	result := clone(notes)
+	for i, n := range result {
+		start := length - (n.StartTime + n.Duration)
+		if start < 0 {
+			start = 0
+		}
+		result[i].StartTime = start
+	}
+	sortByStart(result)
+	return result
+}
+
+// Stretch scales start times and durations by factor, e.g. 2 plays at half
+// speed.
+func Stretch(notes []als.Note, factor float32) []als.Note {
+	result := clone(notes)
+	for i := range result {
+		result[i].StartTime *= factor
+		result[i].Duration *= factor
+	}
+	return result
+}
+
+// Legato extends each note to the start of the next note. Notes sharing a
+// start time (chords) extend together, and the last notes are unchanged.
+func Legato(notes []als.Note) []als.Note {
+	result := clone(notes)
+	sortByStart(result)
+
+	for i := range result {
+		for j := i + 1; j < len(result); j++ {
+			if result[j].StartTime > result[i].StartTime {
+				result[i].Duration = result[j].StartTime - result[i].StartTime
+				break
+			}
+		}
+	}
+	return result
+}
+
+// ScaleVelocity multiplies velocities by factor.
+func ScaleVelocity(notes []als.Note, factor float32) []als.Note {
+	result := clone(notes)
+	for i, n := range result {
+		result[i].Velocity = clampVelocity(float32(n.Velocity) * factor)
+	}
+	return result
+}
+
+// CompressVelocity reduces the dynamic range of velocities above threshold by
+// ratio, like a compressor, e.g. threshold 80 and ratio 2 turns 120 into 100.
+func CompressVelocity(notes []als.Note, threshold int32, ratio float32) []als.Note {
+	result := clone(notes)
+	if ratio <= 0 {
+		return result
+	}
+	for i, n := range result {
+		if n.Velocity > threshold {
+			v := float32(threshold) + float32(n.Velocity-threshold)/ratio
+			result[i].Velocity = clampVelocity(v)
+		}
+	}
+	return result
+}
+
+// Invert mirrors pitches around axis, e.g. axis 60 turns 64 into 56. Notes
+// moved outside 0-127 are dropped.
+func Invert(notes []als.Note, axis int32) []als.Note {
+	result := make([]als.Note, 0, len(notes))
+	for _, n := range notes {
+		n.Pitch = 2*axis - n.Pitch
+		if n.Pitch >= 0 && n.Pitch <= 127 {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func clone(notes []als.Note) []als.Note {
+	return append([]als.Note(nil), notes...)
+}
+
+func sortByStart(notes []als.Note) {
+	sort.SliceStable(notes, func(i, j int) bool {
+		if notes[i].StartTime != notes[j].StartTime {
+			return notes[i].StartTime < notes[j].StartTime
+		}
+		return notes[i].Pitch < notes[j].Pitch
+	})
+}
+
+func clampVelocity(v float32) int32 {
+	r := int32(math.Round(float64(v)))
+	if r < 1 {
+		return 1
+	}
+	if r > 127 {
+		return 127
+	}
+	return r
+}
@@ -0,0 +1,86 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/theory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func note(pitch int32, start, duration float32, velocity int32) als.Note {
+	return als.Note{Pitch: pitch, StartTime: start, Duration: duration, Velocity: velocity}
+}
+
+// TestQuantize verifies full and partial quantize strength
+func TestQuantize(t *testing.T) {
+	notes := []als.Note{note(60, 0.1, 0.25, 100), note(62, 0.9, 0.25, 100)}
+
+	full := Quantize(notes, 0.5, 1)
+	assert.Equal(t, float32(0), full[0].StartTime)
+	assert.Equal(t, float32(1), full[1].StartTime)
+
+	half := Quantize(notes, 0.5, 0.5)
+	assert.InDelta(t, 0.05, half[0].StartTime, 1e-6)
+	assert.InDelta(t, 0.95, half[1].StartTime, 1e-6)
+
+	// input is untouched
+	assert.Equal(t, float32(0.1), notes[0].StartTime)
+}
+
+// TestTranspose verifies chromatic and diatonic transposition
+func TestTranspose(t *testing.T) {
+	notes := []als.Note{note(60, 0, 1, 100), note(125, 1, 1, 100)}
+
+	up := Transpose(notes, 5)
+	require.Len(t, up, 1)
+	assert.Equal(t, int32(65), up[0].Pitch)
+
+	scale, err := theory.ParseScale("C major")
+	require.NoError(t, err)
+	thirds := TransposeScale([]als.Note{note(60, 0, 1, 100), note(64, 1, 1, 100)}, scale, 2)
+	assert.Equal(t, int32(64), thirds[0].Pitch)
+	assert.Equal(t, int32(67), thirds[1].Pitch)
+}
+
+// TestReverse verifies notes are mirrored within the clip
+func TestReverse(t *testing.T) {
+	notes := []als.Note{note(60, 0, 1, 100), note(62, 2, 0.5, 100)}
+	reversed := Reverse(notes, 4)
+	assert.Equal(t, []als.Note{note(62, 1.5, 0.5, 100), note(60, 3, 1, 100)}, reversed)
+}
+
+// TestStretchLegato verifies stretching and legato
+func TestStretchLegato(t *testing.T) {
+	notes := []als.Note{note(60, 0, 0.25, 100), note(64, 0, 0.25, 100), note(67, 1, 0.25, 100)}
+
+	stretched := Stretch(notes, 2)
+	assert.Equal(t, float32(2), stretched[2].StartTime)
+	assert.Equal(t, float32(0.5), stretched[2].Duration)
+
+	legato := Legato(notes)
+	assert.Equal(t, float32(1), legato[0].Duration)
+	assert.Equal(t, float32(1), legato[1].Duration)
+	assert.Equal(t, float32(0.25), legato[2].Duration)
+}
+
+// TestVelocity verifies velocity scaling and compression
+func TestVelocity(t *testing.T) {
+	notes := []als.Note{note(60, 0, 1, 40), note(60, 1, 1, 120)}
+
+	scaled := ScaleVelocity(notes, 1.5)
+	assert.Equal(t, int32(60), scaled[0].Velocity)
+	assert.Equal(t, int32(127), scaled[1].Velocity)
+
+	compressed := CompressVelocity(notes, 80, 2)
+	assert.Equal(t, int32(40), compressed[0].Velocity)
+	assert.Equal(t, int32(100), compressed[1].Velocity)
+}
+
+// TestInvert verifies pitches are mirrored around the axis
+func TestInvert(t *testing.T) {
+	inverted := Invert([]als.Note{note(64, 0, 1, 100), note(127, 1, 1, 100)}, 60)
+	require.Len(t, inverted, 1)
+	assert.Equal(t, int32(56), inverted[0].Pitch)
+}
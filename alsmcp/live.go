@@ -0,0 +1,95 @@
+package alsmcp
+
+import (
+	"errors"
+	"fmt"
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// errNoClip is returned for an empty slot, which write_notes may fill
+var errNoClip = errors.New("no clip in slot")
+
+func (s *Server) count(addr string, indices ...any) (int32, error) {
+	args, err := s.client.Get(addr, indices...)
+	if err != nil {
+		return 0, err
+	}
+	return als.Int(als.First(args)), nil
+}
+
+// check validates an index argument against the number of objects, naming
+// the valid range so the model can retry
+func (s *Server) check(name string, index *int32, countAddr string, indices ...any) error {
+	if index == nil {
+		return fmt.Errorf("%s is required", name)
+	}
+	n, err := s.count(countAddr, indices...)
+	if err != nil {
+		return err
+	}
+	if *index < 0 || *index >= n {
+		return fmt.Errorf("%s %d not found, there are %d", name, *index, n)
+	}
+	return nil
+}
+
+func (s *Server) checkTrack(track *int32) error {
+	return s.check("track", track, "/live/song/get/num_tracks")
+}
+
+func (s *Server) checkScene(scene *int32) error {
+	return s.check("scene", scene, "/live/song/get/num_scenes")
+}
+
+// checkClip checks the slot exists, returning errNoClip if it's empty
+func (s *Server) checkClip(track, clip *int32) error {
+	if err := s.checkTrack(track); err != nil {
+		return err
+	}
+	if err := s.check("clip", clip, "/live/song/get/num_scenes"); err != nil {
+		return err
+	}
+	args, err := s.client.Get("/live/clip_slot/get/has_clip", *track, *clip)
+	if err != nil {
+		return err
+	}
+	if !als.Bool(als.First(args)) {
+		return errNoClip
+	}
+	return nil
+}
+
+func (s *Server) checkDevice(track, device *int32) error {
+	if err := s.checkTrack(track); err != nil {
+		return err
+	}
+	return s.check("device", device, "/live/track/get/num_devices", *track)
+}
+
+// reader reads properties of one Live object, keeping the first error
+type reader struct {
+	s       *Server
+	prefix  string
+	indices []any
+	err     error
+}
+
+func (s *Server) reader(prefix string, indices ...any) *reader {
+	return &reader{s: s, prefix: prefix, indices: indices}
+}
+
+func (r *reader) arg(prop string) any {
+	if r.err != nil {
+		return nil
+	}
+	args, err := r.s.client.Get(r.prefix+"/get/"+prop, r.indices...)
+	if err != nil {
+		r.err = err
+		return nil
+	}
+	return als.First(args)
+}
+
+func (r *reader) float(prop string) float32 { return als.Float(r.arg(prop)) }
+func (r *reader) int(prop string) int32     { return als.Int(r.arg(prop)) }
+func (r *reader) bool(prop string) bool     { return als.Bool(r.arg(prop)) }
@@ -0,0 +1,84 @@
+package alsmcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// SessionURI is the session snapshot resource.
+const SessionURI = "als://session"
+
+type resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MimeType    string `json:"mimeType"`
+
+	read func() (any, error)
+}
+
+func (s *Server) newResources() []resource {
+	return []resource{
+		{
+			URI:         SessionURI,
+			Name:        "session",
+			Description: "Snapshot of the set: transport, tracks with mixer state and devices, and scenes.",
+			MimeType:    "application/json",
+			read:        s.session,
+		},
+	}
+}
+
+func (s *Server) readResource(uri string) (any, error) {
+	for _, r := range s.resources {
+		if r.URI != uri {
+			continue
+		}
+		v, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		text, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"contents": []map[string]any{{"uri": uri, "mimeType": r.MimeType, "text": string(text)}},
+		}, nil
+	}
+	return nil, &rpcError{codeInvalidParams, fmt.Sprintf("unknown resource %q", uri)}
+}
+
+// session reads everything an assistant needs to address the set
+func (s *Server) session() (any, error) {
+	song, err := s.getSong()
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := s.listTracks()
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range tracks {
+		names, err := s.client.Get("/live/track/get/devices/name", int32(i))
+		if err != nil {
+			return nil, err
+		}
+		devices := make([]string, len(names))
+		for d, name := range names {
+			devices[d] = als.String(name)
+		}
+		t["devices"] = devices
+	}
+	scenes, err := s.listScenes()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"song":   song,
+		"tracks": tracks,
+		"scenes": scenes,
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Package alsmcp serves Live to assistants over the Model Context Protocol.
+// It speaks JSON-RPC 2.0 over newline delimited stdio, exposing a curated set
+// of tools and a session snapshot resource.
+package alsmcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// ProtocolVersion is the MCP revision the server implements.
+const ProtocolVersion = "2025-06-18"
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// Server answers MCP requests using an als client.
+type Server struct {
+	client    *als.Client
+	tools     []tool
+	resources []resource
+
+	mu  sync.Mutex // serialises writes
+	out *json.Encoder
+}
+
+// NewServer creates a server backed by client.
+func NewServer(client *als.Client) *Server {
+	s := &Server{client: client}
+	s.tools = s.newTools()
+	s.resources = s.newResources()
+	return s
+}
+
+// Serve reads requests from r and writes responses to w until r is closed
+// or ctx is cancelled. Requests are handled concurrently, as tools wait on
+// Live, so responses may be out of order.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.out = json.NewEncoder(w)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line := append([]byte(nil), scanner.Bytes()...)
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.write(response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{codeParseError, err.Error()}})
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := s.handle(req)
+			if req.ID == nil {
+				// notifications are never answered
+				return
+			}
+			resp := response{JSONRPC: "2.0", ID: req.ID, Result: result}
+			if err != nil {
+				var rerr *rpcError
+				if !errors.As(err, &rerr) {
+					rerr = &rpcError{codeInternalError, err.Error()}
+				}
+				resp.Result, resp.Error = nil, rerr
+			}
+			s.write(resp)
+		}()
+	}
+	return scanner.Err()
+}
+
+func (s *Server) write(resp response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.out.Encode(resp)
+}
+
+func (s *Server) handle(req request) (any, error) {
+	if req.JSONRPC != "2.0" {
+		return nil, &rpcError{codeInvalidRequest, "jsonrpc must be 2.0"}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return map[string]any{
+			"protocolVersion": ProtocolVersion,
+			"capabilities": map[string]any{
+				"tools":     map[string]any{},
+				"resources": map[string]any{},
+			},
+			"serverInfo": map[string]any{"name": "alsmcp", "version": "0.1.0"},
+			"instructions": "Controls an Ableton Live set. Tracks, scenes, clips and devices " +
+				"are addressed by zero based index; read als://session first to find them.",
+		}, nil
+	case "ping":
+		return map[string]any{}, nil
+	case "tools/list":
+		return map[string]any{"tools": s.tools}, nil
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &rpcError{codeInvalidParams, err.Error()}
+		}
+		return s.callTool(params.Name, params.Arguments)
+	case "resources/list":
+		return map[string]any{"resources": s.resources}, nil
+	case "resources/read":
+		var params struct {
+			URI string `json:"uri"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &rpcError{codeInvalidParams, err.Error()}
+		}
+		return s.readResource(params.URI)
+	default:
+		if strings.HasPrefix(req.Method, "notifications/") {
+			return nil, nil
+		}
+		return nil, &rpcError{codeMethodNotFound, fmt.Sprintf("unknown method %q", req.Method)}
+	}
+}
@@ -0,0 +1,247 @@
+package alsmcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// session runs a server over pipes, like an assistant running the binary
+type session struct {
+	t      *testing.T
+	in     *io.PipeWriter
+	out    *bufio.Scanner
+	nextID int
+}
+
+func newSession(t *testing.T) (*alstest.Server, *session) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/num_tracks", int32(2))
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	for track, name := range []string{"Drums", "Bass"} {
+		live.Set("/live/track/get/mute", int32(track), int32(0))
+		live.Set("/live/track/get/solo", int32(track), int32(0))
+		live.Set("/live/track/get/arm", int32(track), int32(0))
+		live.Set("/live/track/get/volume", int32(track), float32(0.85))
+		live.Set("/live/track/get/name", int32(track), name)
+	}
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	s := NewServer(live.Client(t))
+	go s.Serve(context.Background(), inR, outW)
+	t.Cleanup(func() { inW.Close() })
+
+	return live, &session{t: t, in: inW, out: bufio.NewScanner(outR)}
+}
+
+// call sends a request and waits for its response
+func (s *session) call(method string, params any) response {
+	s.t.Helper()
+	s.nextID++
+	id := s.nextID
+	line, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params})
+	require.NoError(s.t, err)
+	_, err = s.in.Write(append(line, '\n'))
+	require.NoError(s.t, err)
+
+	for s.out.Scan() {
+		var resp response
+		require.NoError(s.t, json.Unmarshal(s.out.Bytes(), &resp))
+		if string(resp.ID) == fmt.Sprint(id) {
+			return resp
+		}
+	}
+	s.t.Fatal("no response")
+	return response{}
+}
+
+// tool calls a tool, returning its text and whether it failed
+func (s *session) tool(name string, args any) (string, bool) {
+	s.t.Helper()
+	resp := s.call("tools/call", map[string]any{"name": name, "arguments": args})
+	require.Nil(s.t, resp.Error)
+
+	var result struct {
+		Content []struct{ Text string }
+		IsError bool
+	}
+	b, _ := json.Marshal(resp.Result)
+	require.NoError(s.t, json.Unmarshal(b, &result))
+	require.Len(s.t, result.Content, 1)
+	return result.Content[0].Text, result.IsError
+}
+
+// TestHandshake verifies initialize, listing and unknown methods
+func TestHandshake(t *testing.T) {
+	_, s := newSession(t)
+
+	resp := s.call("initialize", map[string]any{"protocolVersion": ProtocolVersion})
+	require.Nil(t, resp.Error)
+	assert.Equal(t, ProtocolVersion, resp.Result.(map[string]any)["protocolVersion"])
+
+	// notifications get no response, so the next response is the ping's
+	_, err := s.in.Write([]byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n"))
+	require.NoError(t, err)
+	assert.Nil(t, s.call("ping", nil).Error)
+
+	tools := s.call("tools/list", nil).Result.(map[string]any)["tools"].([]any)
+	names := make([]string, len(tools))
+	for i, tool := range tools {
+		names[i] = tool.(map[string]any)["name"].(string)
+		assert.NotNil(t, tool.(map[string]any)["inputSchema"])
+	}
+	assert.Contains(t, names, "write_notes")
+	assert.Contains(t, names, "get_device_parameters")
+
+	resp = s.call("tools/explode", nil)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, codeMethodNotFound, resp.Error.Code)
+	resp = s.call("tools/call", map[string]any{"name": "explode"})
+	assert.Equal(t, codeInvalidParams, resp.Error.Code)
+}
+
+// TestTools verifies tools read from and write to Live
+func TestTools(t *testing.T) {
+	live, s := newSession(t)
+
+	text, failed := s.tool("list_tracks", nil)
+	require.False(t, failed, text)
+	assert.JSONEq(t, `[
+		{"track":0,"name":"Drums","mute":false,"solo":false,"arm":false,"volume":0.85},
+		{"track":1,"name":"Bass","mute":false,"solo":false,"arm":false,"volume":0.85}
+	]`, text)
+
+	_, failed = s.tool("set_tempo", map[string]any{"bpm": 128})
+	require.False(t, failed)
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	assert.Equal(t, []any{float32(128)}, msgs[0].Arguments)
+
+	text, failed = s.tool("set_tempo", map[string]any{"bpm": 5})
+	assert.True(t, failed)
+	assert.Contains(t, text, "between 20 and 999")
+
+	text, failed = s.tool("fire_scene", map[string]any{"scene": 7})
+	assert.True(t, failed)
+	assert.Contains(t, text, "scene 7 not found, there are 2")
+
+	_, failed = s.tool("fire_scene", map[string]any{"scene": 1})
+	require.False(t, failed)
+	msgs = live.WaitFor(t, "/live/scene/fire")
+	assert.Equal(t, []any{int32(1)}, msgs[0].Arguments)
+}
+
+// TestDeviceParameters verifies parameters are read and writes are ranged
+func TestDeviceParameters(t *testing.T) {
+	live, s := newSession(t)
+	live.Set("/live/track/get/num_devices", int32(1), int32(1))
+	live.Set("/live/device/get/parameters/name", int32(1), int32(0), "Device On", "Cutoff")
+	live.Set("/live/device/get/parameters/value", int32(1), int32(0), float32(1), float32(0.5))
+	live.Set("/live/device/get/parameters/min", int32(1), int32(0), float32(0), float32(0))
+	live.Set("/live/device/get/parameters/max", int32(1), int32(0), float32(1), float32(1))
+
+	text, failed := s.tool("get_device_parameters", map[string]any{"track": 1, "device": 0})
+	require.False(t, failed, text)
+	assert.JSONEq(t, `[
+		{"parameter":0,"name":"Device On","value":1,"min":0,"max":1},
+		{"parameter":1,"name":"Cutoff","value":0.5,"min":0,"max":1}
+	]`, text)
+
+	text, failed = s.tool("set_device_parameter", map[string]any{"track": 1, "device": 0, "parameter": 1, "value": 2})
+	assert.True(t, failed)
+	assert.Contains(t, text, "Cutoff ranges from 0 to 1")
+
+	_, failed = s.tool("set_device_parameter", map[string]any{"track": 1, "device": 0, "parameter": 1, "value": 0.25})
+	require.False(t, failed)
+	msgs := live.WaitFor(t, "/live/device/set/parameter/value")
+	assert.Equal(t, []any{int32(1), int32(0), int32(1), float32(0.25)}, msgs[0].Arguments)
+}
+
+// TestWriteNotes verifies names are parsed, notes validated, empty slots
+// filled and clips not cleared without notes to write
+func TestWriteNotes(t *testing.T) {
+	live, s := newSession(t)
+	live.Set("/live/clip_slot/get/has_clip", int32(1), int32(0), int32(0))
+
+	args := map[string]any{"track": 1, "clip": 0, "notes": []map[string]any{
+		{"pitch": "C4", "start": 0, "duration": 1},
+	}}
+	text, failed := s.tool("write_notes", args)
+	assert.True(t, failed)
+	assert.Contains(t, text, "no clip")
+
+	args["length"] = 4
+	args["notes"] = []map[string]any{
+		{"pitch": "C4", "start": 0, "duration": 1},
+		{"pitch": 67, "start": 1, "duration": 0.5, "velocity": 90},
+	}
+	text, failed = s.tool("write_notes", args)
+	require.False(t, failed, text)
+	assert.JSONEq(t, `{"written":2,"created":true}`, text)
+
+	msgs := live.WaitFor(t, "/live/clip_slot/create_clip")
+	assert.Equal(t, []any{int32(1), int32(0), float32(4)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/clip/add/notes")
+	assert.Equal(t, []any{
+		int32(1), int32(0),
+		int32(60), float32(0), float32(1), int32(100), int32(0),
+		int32(67), float32(1), float32(0.5), int32(90), int32(0),
+	}, msgs[0].Arguments)
+
+	args["notes"] = []map[string]any{{"pitch": "H9", "start": 0, "duration": 1}}
+	_, failed = s.tool("write_notes", args)
+	assert.True(t, failed)
+
+	for _, notes := range []any{nil, []map[string]any{}} {
+		args := map[string]any{"track": 1, "clip": 0, "replace": true, "notes": notes}
+		text, failed = s.tool("write_notes", args)
+		assert.True(t, failed)
+		assert.Contains(t, text, "at least one note")
+	}
+	assert.Empty(t, live.Received("/live/clip/remove/notes"))
+}
+
+// TestSessionResource verifies the snapshot reads the whole set
+func TestSessionResource(t *testing.T) {
+	live, s := newSession(t)
+	live.Set("/live/song/get/tempo", float32(120))
+	live.Set("/live/song/get/is_playing", int32(1))
+	live.Set("/live/song/get/current_song_time", float32(8))
+	live.Set("/live/song/get/signature_numerator", int32(4))
+	live.Set("/live/song/get/signature_denominator", int32(4))
+	live.Set("/live/track/get/devices/name", int32(0), "Drum Rack")
+	live.Set("/live/track/get/devices/name", int32(1), "Operator", "Auto Filter")
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Drop")
+
+	resources := s.call("resources/list", nil).Result.(map[string]any)["resources"].([]any)
+	require.Len(t, resources, 1)
+	assert.Equal(t, SessionURI, resources[0].(map[string]any)["uri"])
+
+	resp := s.call("resources/read", map[string]any{"uri": SessionURI})
+	require.Nil(t, resp.Error)
+	contents := resp.Result.(map[string]any)["contents"].([]any)
+	var snapshot struct {
+		Song   map[string]any
+		Tracks []struct {
+			Name    string
+			Devices []string
+		}
+		Scenes []struct{ Name string }
+	}
+	require.NoError(t, json.Unmarshal([]byte(contents[0].(map[string]any)["text"].(string)), &snapshot))
+	assert.Equal(t, float64(120), snapshot.Song["tempo"])
+	assert.Equal(t, []string{"Operator", "Auto Filter"}, snapshot.Tracks[1].Devices)
+	assert.Equal(t, "Drop", snapshot.Scenes[1].Name)
+
+	resp = s.call("resources/read", map[string]any{"uri": "als://nothing"})
+	assert.NotNil(t, resp.Error)
+}
@@ -0,0 +1,592 @@
+package alsmcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsex/note"
+)
+
+// tool is an MCP tool. Run returns the result shown to the model as JSON, or
+// an error reported as a failed call so the model can correct itself.
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+	Annotations map[string]any `json:"annotations,omitempty"`
+
+	run func(args json.RawMessage) (any, error)
+}
+
+func (s *Server) callTool(name string, args json.RawMessage) (any, error) {
+	for _, t := range s.tools {
+		if t.Name != name {
+			continue
+		}
+		result, err := t.run(args)
+		if err != nil {
+			return toolResult(err.Error(), true), nil
+		}
+		text, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		return toolResult(string(text), false), nil
+	}
+	return nil, &rpcError{codeInvalidParams, fmt.Sprintf("unknown tool %q", name)}
+}
+
+func toolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+// schema builds an object schema from property schemas and required names
+func schema(props map[string]any, required ...string) map[string]any {
+	s := map[string]any{"type": "object", "properties": props, "additionalProperties": false}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func integer(description string) map[string]any {
+	return map[string]any{"type": "integer", "minimum": 0, "description": description}
+}
+
+func number(description string) map[string]any {
+	return map[string]any{"type": "number", "description": description}
+}
+
+func boolean(description string) map[string]any {
+	return map[string]any{"type": "boolean", "description": description}
+}
+
+var readOnly = map[string]any{"readOnlyHint": true}
+
+func (s *Server) newTools() []tool {
+	return []tool{
+		{
+			Name:        "get_song",
+			Description: "Read the transport: tempo, time signature, whether Live is playing and the song position in beats.",
+			InputSchema: schema(map[string]any{}),
+			Annotations: readOnly,
+			run:         noArgs(s.getSong),
+		},
+		{
+			Name:        "list_tracks",
+			Description: "List tracks with their index, name and mixer state.",
+			InputSchema: schema(map[string]any{}),
+			Annotations: readOnly,
+			run:         noArgs(s.listTracks),
+		},
+		{
+			Name:        "list_scenes",
+			Description: "List scenes with their index and name.",
+			InputSchema: schema(map[string]any{}),
+			Annotations: readOnly,
+			run:         noArgs(s.listScenes),
+		},
+		{
+			Name:        "set_tempo",
+			Description: "Set the song tempo in BPM.",
+			InputSchema: schema(map[string]any{
+				"bpm": map[string]any{"type": "number", "minimum": 20, "maximum": 999},
+			}, "bpm"),
+			run: s.setTempo,
+		},
+		{
+			Name:        "transport",
+			Description: "Start, stop or continue playback.",
+			InputSchema: schema(map[string]any{
+				"action": map[string]any{"type": "string", "enum": []string{"play", "stop", "continue"}},
+			}, "action"),
+			run: s.transport,
+		},
+		{
+			Name:        "fire_scene",
+			Description: "Launch a scene, firing every clip in its row.",
+			InputSchema: schema(map[string]any{"scene": integer("scene index")}, "scene"),
+			run:         s.fireScene,
+		},
+		{
+			Name:        "fire_clip",
+			Description: "Launch the clip in a session slot.",
+			InputSchema: schema(map[string]any{
+				"track": integer("track index"),
+				"clip":  integer("clip slot index, the scene row"),
+			}, "track", "clip"),
+			run: s.fireClip,
+		},
+		{
+			Name:        "set_track",
+			Description: "Change a track's mixer: mute, solo, arm and volume (0 to 1, 0.85 is 0 dB). Omitted fields are unchanged.",
+			InputSchema: schema(map[string]any{
+				"track":  integer("track index"),
+				"mute":   boolean("mute the track"),
+				"solo":   boolean("solo the track"),
+				"arm":    boolean("arm the track for recording"),
+				"volume": map[string]any{"type": "number", "minimum": 0, "maximum": 1},
+			}, "track"),
+			run: s.setTrack,
+		},
+		{
+			Name:        "list_devices",
+			Description: "List the devices on a track.",
+			InputSchema: schema(map[string]any{"track": integer("track index")}, "track"),
+			Annotations: readOnly,
+			run:         s.listDevices,
+		},
+		{
+			Name:        "get_device_parameters",
+			Description: "Read a device's parameters with their values and ranges.",
+			InputSchema: schema(map[string]any{
+				"track":  integer("track index"),
+				"device": integer("device index on the track"),
+			}, "track", "device"),
+			Annotations: readOnly,
+			run:         s.getDeviceParameters,
+		},
+		{
+			Name:        "set_device_parameter",
+			Description: "Set a device parameter. The value must be within the parameter's range.",
+			InputSchema: schema(map[string]any{
+				"track":     integer("track index"),
+				"device":    integer("device index on the track"),
+				"parameter": integer("parameter index"),
+				"value":     number("new value"),
+			}, "track", "device", "parameter", "value"),
+			run: s.setDeviceParameter,
+		},
+		{
+			Name:        "read_notes",
+			Description: "Read the notes of a MIDI clip.",
+			InputSchema: schema(map[string]any{
+				"track": integer("track index"),
+				"clip":  integer("clip slot index"),
+			}, "track", "clip"),
+			Annotations: readOnly,
+			run:         s.readNotes,
+		},
+		{
+			Name: "write_notes",
+			Description: "Write notes to a MIDI clip. Pitches are MIDI numbers or names like \"C4\" (60). " +
+				"Times are in beats. Set replace to clear the clip first, and length to create the clip if the slot is empty. " +
+				"At least one note is required.",
+			InputSchema: schema(map[string]any{
+				"track":   integer("track index"),
+				"clip":    integer("clip slot index"),
+				"replace": boolean("remove existing notes first"),
+				"length":  number("length in beats of a clip to create if the slot is empty"),
+				"notes": map[string]any{
+					"type":     "array",
+					"minItems": 1,
+					"items": schema(map[string]any{
+						"pitch":    map[string]any{"type": []string{"integer", "string"}},
+						"start":    number("start in beats"),
+						"duration": number("length in beats"),
+						"velocity": map[string]any{"type": "integer", "minimum": 1, "maximum": 127, "default": 100},
+						"mute":     boolean("mute the note"),
+					}, "pitch", "start", "duration"),
+				},
+			}, "track", "clip", "notes"),
+			run: s.writeNotes,
+		},
+	}
+}
+
+// decode unmarshals tool arguments, treating missing arguments as empty
+func decode(args json.RawMessage, v any) error {
+	if len(args) == 0 || string(args) == "null" {
+		args = json.RawMessage("{}")
+	}
+	if err := json.Unmarshal(args, v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+func noArgs[T any](fn func() (T, error)) func(json.RawMessage) (any, error) {
+	return func(json.RawMessage) (any, error) { return fn() }
+}
+
+func (s *Server) getSong() (map[string]any, error) {
+	r := s.reader("/live/song")
+	song := map[string]any{
+		"tempo":                 r.float("tempo"),
+		"is_playing":            r.bool("is_playing"),
+		"current_song_time":     r.float("current_song_time"),
+		"signature_numerator":   r.int("signature_numerator"),
+		"signature_denominator": r.int("signature_denominator"),
+		"num_tracks":            r.int("num_tracks"),
+		"num_scenes":            r.int("num_scenes"),
+	}
+	return song, r.err
+}
+
+func (s *Server) listTracks() ([]map[string]any, error) {
+	args, err := s.client.Get("/live/song/get/track_names")
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]map[string]any, len(args))
+	for i, name := range args {
+		r := s.reader("/live/track", int32(i))
+		tracks[i] = map[string]any{
+			"track":  i,
+			"name":   als.String(name),
+			"mute":   r.bool("mute"),
+			"solo":   r.bool("solo"),
+			"arm":    r.bool("arm"),
+			"volume": r.float("volume"),
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	return tracks, nil
+}
+
+func (s *Server) listScenes() ([]map[string]any, error) {
+	names, err := s.client.SceneNames()
+	if err != nil {
+		return nil, err
+	}
+
+	scenes := make([]map[string]any, len(names))
+	for i, name := range names {
+		scenes[i] = map[string]any{"scene": i, "name": name}
+	}
+	return scenes, nil
+}
+
+func (s *Server) setTempo(raw json.RawMessage) (any, error) {
+	var args struct {
+		BPM *float32 `json:"bpm"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if args.BPM == nil || *args.BPM < 20 || *args.BPM > 999 {
+		return nil, fmt.Errorf("bpm must be between 20 and 999")
+	}
+	s.client.Song.SetTempo(*args.BPM)
+	return map[string]any{"tempo": *args.BPM}, nil
+}
+
+func (s *Server) transport(raw json.RawMessage) (any, error) {
+	var args struct {
+		Action string `json:"action"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	switch args.Action {
+	case "play":
+		s.client.Song.StartPlaying()
+	case "stop":
+		s.client.Song.StopPlaying()
+	case "continue":
+		s.client.Song.ContinuePlaying()
+	default:
+		return nil, fmt.Errorf("action must be play, stop or continue, got %q", args.Action)
+	}
+	return map[string]any{"action": args.Action}, nil
+}
+
+func (s *Server) fireScene(raw json.RawMessage) (any, error) {
+	var args struct {
+		Scene *int32 `json:"scene"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkScene(args.Scene); err != nil {
+		return nil, err
+	}
+	s.client.Scene.Fire(*args.Scene)
+	return map[string]any{"fired": *args.Scene}, nil
+}
+
+func (s *Server) fireClip(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track *int32 `json:"track"`
+		Clip  *int32 `json:"clip"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkClip(args.Track, args.Clip); err != nil {
+		return nil, err
+	}
+	s.client.Clip.Fire(*args.Track, *args.Clip)
+	return map[string]any{"fired": map[string]any{"track": *args.Track, "clip": *args.Clip}}, nil
+}
+
+func (s *Server) setTrack(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track  *int32   `json:"track"`
+		Mute   *bool    `json:"mute"`
+		Solo   *bool    `json:"solo"`
+		Arm    *bool    `json:"arm"`
+		Volume *float32 `json:"volume"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkTrack(args.Track); err != nil {
+		return nil, err
+	}
+	if args.Volume != nil && (*args.Volume < 0 || *args.Volume > 1) {
+		return nil, fmt.Errorf("volume must be between 0 and 1")
+	}
+
+	track := *args.Track
+	changed := map[string]any{"track": track}
+	if args.Mute != nil {
+		s.client.Track.SetMute(track, *args.Mute)
+		changed["mute"] = *args.Mute
+	}
+	if args.Solo != nil {
+		s.client.Track.SetSolo(track, *args.Solo)
+		changed["solo"] = *args.Solo
+	}
+	if args.Arm != nil {
+		s.client.Track.SetArm(track, *args.Arm)
+		changed["arm"] = *args.Arm
+	}
+	if args.Volume != nil {
+		s.client.Track.SetVolume(track, *args.Volume)
+		changed["volume"] = *args.Volume
+	}
+	return changed, nil
+}
+
+func (s *Server) listDevices(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track *int32 `json:"track"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkTrack(args.Track); err != nil {
+		return nil, err
+	}
+
+	names, err := s.client.Get("/live/track/get/devices/name", *args.Track)
+	if err != nil {
+		return nil, err
+	}
+	classes, err := s.client.Get("/live/track/get/devices/class_name", *args.Track)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]map[string]any, len(names))
+	for i, name := range names {
+		devices[i] = map[string]any{"device": i, "name": als.String(name)}
+		if i < len(classes) {
+			devices[i]["class_name"] = als.String(classes[i])
+		}
+	}
+	return devices, nil
+}
+
+type parameter struct {
+	Parameter int     `json:"parameter"`
+	Name      string  `json:"name"`
+	Value     float32 `json:"value"`
+	Min       float32 `json:"min"`
+	Max       float32 `json:"max"`
+}
+
+func (s *Server) getDeviceParameters(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track  *int32 `json:"track"`
+		Device *int32 `json:"device"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkDevice(args.Track, args.Device); err != nil {
+		return nil, err
+	}
+	return s.parameters(*args.Track, *args.Device)
+}
+
+func (s *Server) parameters(track, device int32) ([]parameter, error) {
+	columns := []string{"name", "value", "min", "max"}
+	values := make([][]any, len(columns))
+	for i, column := range columns {
+		args, err := s.client.Get("/live/device/get/parameters/"+column, track, device)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = args
+	}
+
+	at := func(column, i int) any {
+		if i < len(values[column]) {
+			return values[column][i]
+		}
+		return nil
+	}
+	params := make([]parameter, len(values[0]))
+	for i := range params {
+		params[i] = parameter{
+			Parameter: i,
+			Name:      als.String(at(0, i)),
+			Value:     als.Float(at(1, i)),
+			Min:       als.Float(at(2, i)),
+			Max:       als.Float(at(3, i)),
+		}
+	}
+	return params, nil
+}
+
+func (s *Server) setDeviceParameter(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track     *int32   `json:"track"`
+		Device    *int32   `json:"device"`
+		Parameter *int32   `json:"parameter"`
+		Value     *float32 `json:"value"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkDevice(args.Track, args.Device); err != nil {
+		return nil, err
+	}
+	if args.Parameter == nil || args.Value == nil {
+		return nil, fmt.Errorf("parameter and value are required")
+	}
+
+	params, err := s.parameters(*args.Track, *args.Device)
+	if err != nil {
+		return nil, err
+	}
+	if *args.Parameter < 0 || int(*args.Parameter) >= len(params) {
+		return nil, fmt.Errorf("parameter %d not found, the device has %d", *args.Parameter, len(params))
+	}
+	p := params[*args.Parameter]
+	if *args.Value < p.Min || *args.Value > p.Max {
+		return nil, fmt.Errorf("%s ranges from %v to %v", p.Name, p.Min, p.Max)
+	}
+
+	s.client.Device.SetParameterValue(*args.Track, *args.Device, *args.Parameter, *args.Value)
+	p.Value = *args.Value
+	return p, nil
+}
+
+type noteArg struct {
+	Pitch    json.RawMessage `json:"pitch"`
+	Start    float32         `json:"start"`
+	Duration float32         `json:"duration"`
+	Velocity *int32          `json:"velocity"`
+	Mute     bool            `json:"mute"`
+}
+
+func (s *Server) readNotes(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track *int32 `json:"track"`
+		Clip  *int32 `json:"clip"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+	if err := s.checkClip(args.Track, args.Clip); err != nil {
+		return nil, err
+	}
+
+	values, err := s.client.Get("/live/clip/get/notes", *args.Track, *args.Clip)
+	if err != nil {
+		return nil, err
+	}
+	// notes come in groups of 5: pitch, start_time, duration, velocity, mute
+	notes := make([]map[string]any, 0, len(values)/5)
+	for i := 0; i+4 < len(values); i += 5 {
+		notes = append(notes, map[string]any{
+			"pitch":    als.Int(values[i]),
+			"start":    als.Float(values[i+1]),
+			"duration": als.Float(values[i+2]),
+			"velocity": als.Int(values[i+3]),
+			"mute":     als.Bool(values[i+4]),
+		})
+	}
+	return notes, nil
+}
+
+func (s *Server) writeNotes(raw json.RawMessage) (any, error) {
+	var args struct {
+		Track   *int32    `json:"track"`
+		Clip    *int32    `json:"clip"`
+		Replace bool      `json:"replace"`
+		Length  float32   `json:"length"`
+		Notes   []noteArg `json:"notes"`
+	}
+	if err := decode(raw, &args); err != nil {
+		return nil, err
+	}
+
+	// validate every note before touching the clip. An empty list would
+	// only clear the clip with replace, which is never what's meant.
+	if len(args.Notes) == 0 {
+		return nil, fmt.Errorf("notes must have at least one note")
+	}
+	params := []any{}
+	for i, n := range args.Notes {
+		pitch, err := parsePitch(n.Pitch)
+		if err != nil {
+			return nil, fmt.Errorf("note %d: %w", i, err)
+		}
+		velocity := int32(100)
+		if n.Velocity != nil {
+			velocity = *n.Velocity
+		}
+		if velocity < 1 || velocity > 127 {
+			return nil, fmt.Errorf("note %d: velocity must be between 1 and 127", i)
+		}
+		if n.Start < 0 || n.Duration <= 0 {
+			return nil, fmt.Errorf("note %d: start must be positive and duration above zero", i)
+		}
+		params = append(params, pitch, n.Start, n.Duration, velocity, als.FromBool(n.Mute))
+	}
+
+	created := false
+	err := s.checkClip(args.Track, args.Clip)
+	if err == errNoClip && args.Length > 0 {
+		s.client.ClipSlot.CreateClip(*args.Track, *args.Clip, args.Length)
+		created = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	if args.Replace && !created {
+		// without a range AbletonOSC removes every note
+		s.client.Send("/live/clip/remove/notes", *args.Track, *args.Clip)
+	}
+	s.client.Send("/live/clip/add/notes", append([]any{*args.Track, *args.Clip}, params...)...)
+
+	return map[string]any{"written": len(args.Notes), "created": created}, nil
+}
+
+// parsePitch accepts a MIDI number or a note name, e.g. 60 or "C4"
+func parsePitch(raw json.RawMessage) (int32, error) {
+	var pitch int32
+	if err := json.Unmarshal(raw, &pitch); err != nil {
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return 0, fmt.Errorf("pitch must be a MIDI number or note name")
+		}
+		if pitch, err = note.ToMidi(name); err != nil {
+			return 0, err
+		}
+	}
+	if pitch < 0 || pitch > 127 {
+		return 0, fmt.Errorf("pitch %d is outside 0-127", pitch)
+	}
+	return pitch, nil
+}
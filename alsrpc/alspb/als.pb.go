@@ -0,0 +1,4253 @@
+// Remote control of Ableton Live, mirroring the als package. Each service
+// matches one of the als APIs: getters are grouped into a single Get that
+// returns the object's state, setters into an Update where only the fields
+// that are set change, and actions are RPCs of their own.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v5.29.3
+// source: als.proto
+
+package alspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_als_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{0}
+}
+
+type Index struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	mi := &file_als_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Index.ProtoReflect.Descriptor instead.
+func (*Index) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Index) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type Names struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Names) Reset() {
+	*x = Names{}
+	mi := &file_als_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Names) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Names) ProtoMessage() {}
+
+func (x *Names) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Names.ProtoReflect.Descriptor instead.
+func (*Names) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Names) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type JumpByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Beats         float32                `protobuf:"fixed32,1,opt,name=beats,proto3" json:"beats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JumpByRequest) Reset() {
+	*x = JumpByRequest{}
+	mi := &file_als_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JumpByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpByRequest) ProtoMessage() {}
+
+func (x *JumpByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpByRequest.ProtoReflect.Descriptor instead.
+func (*JumpByRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JumpByRequest) GetBeats() float32 {
+	if x != nil {
+		return x.Beats
+	}
+	return 0
+}
+
+type Song struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Tempo                     float32                `protobuf:"fixed32,1,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	IsPlaying                 bool                   `protobuf:"varint,2,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	CurrentSongTime           float32                `protobuf:"fixed32,3,opt,name=current_song_time,json=currentSongTime,proto3" json:"current_song_time,omitempty"`
+	Metronome                 bool                   `protobuf:"varint,4,opt,name=metronome,proto3" json:"metronome,omitempty"`
+	Loop                      bool                   `protobuf:"varint,5,opt,name=loop,proto3" json:"loop,omitempty"`
+	LoopStart                 float32                `protobuf:"fixed32,6,opt,name=loop_start,json=loopStart,proto3" json:"loop_start,omitempty"`
+	LoopLength                float32                `protobuf:"fixed32,7,opt,name=loop_length,json=loopLength,proto3" json:"loop_length,omitempty"`
+	RecordMode                bool                   `protobuf:"varint,8,opt,name=record_mode,json=recordMode,proto3" json:"record_mode,omitempty"`
+	SessionRecord             bool                   `protobuf:"varint,9,opt,name=session_record,json=sessionRecord,proto3" json:"session_record,omitempty"`
+	SessionRecordStatus       int32                  `protobuf:"varint,10,opt,name=session_record_status,json=sessionRecordStatus,proto3" json:"session_record_status,omitempty"`
+	ArrangementOverdub        bool                   `protobuf:"varint,11,opt,name=arrangement_overdub,json=arrangementOverdub,proto3" json:"arrangement_overdub,omitempty"`
+	BackToArranger            bool                   `protobuf:"varint,12,opt,name=back_to_arranger,json=backToArranger,proto3" json:"back_to_arranger,omitempty"`
+	PunchIn                   bool                   `protobuf:"varint,13,opt,name=punch_in,json=punchIn,proto3" json:"punch_in,omitempty"`
+	PunchOut                  bool                   `protobuf:"varint,14,opt,name=punch_out,json=punchOut,proto3" json:"punch_out,omitempty"`
+	GrooveAmount              float32                `protobuf:"fixed32,15,opt,name=groove_amount,json=grooveAmount,proto3" json:"groove_amount,omitempty"`
+	SignatureNumerator        int32                  `protobuf:"varint,16,opt,name=signature_numerator,json=signatureNumerator,proto3" json:"signature_numerator,omitempty"`
+	SignatureDenominator      int32                  `protobuf:"varint,17,opt,name=signature_denominator,json=signatureDenominator,proto3" json:"signature_denominator,omitempty"`
+	ClipTriggerQuantization   int32                  `protobuf:"varint,18,opt,name=clip_trigger_quantization,json=clipTriggerQuantization,proto3" json:"clip_trigger_quantization,omitempty"`
+	MidiRecordingQuantization int32                  `protobuf:"varint,19,opt,name=midi_recording_quantization,json=midiRecordingQuantization,proto3" json:"midi_recording_quantization,omitempty"`
+	NumTracks                 int32                  `protobuf:"varint,20,opt,name=num_tracks,json=numTracks,proto3" json:"num_tracks,omitempty"`
+	NumScenes                 int32                  `protobuf:"varint,21,opt,name=num_scenes,json=numScenes,proto3" json:"num_scenes,omitempty"`
+	SongLength                float32                `protobuf:"fixed32,22,opt,name=song_length,json=songLength,proto3" json:"song_length,omitempty"`
+	CanUndo                   bool                   `protobuf:"varint,23,opt,name=can_undo,json=canUndo,proto3" json:"can_undo,omitempty"`
+	CanRedo                   bool                   `protobuf:"varint,24,opt,name=can_redo,json=canRedo,proto3" json:"can_redo,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Song) Reset() {
+	*x = Song{}
+	mi := &file_als_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Song) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Song) ProtoMessage() {}
+
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Song) GetTempo() float32 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *Song) GetIsPlaying() bool {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return false
+}
+
+func (x *Song) GetCurrentSongTime() float32 {
+	if x != nil {
+		return x.CurrentSongTime
+	}
+	return 0
+}
+
+func (x *Song) GetMetronome() bool {
+	if x != nil {
+		return x.Metronome
+	}
+	return false
+}
+
+func (x *Song) GetLoop() bool {
+	if x != nil {
+		return x.Loop
+	}
+	return false
+}
+
+func (x *Song) GetLoopStart() float32 {
+	if x != nil {
+		return x.LoopStart
+	}
+	return 0
+}
+
+func (x *Song) GetLoopLength() float32 {
+	if x != nil {
+		return x.LoopLength
+	}
+	return 0
+}
+
+func (x *Song) GetRecordMode() bool {
+	if x != nil {
+		return x.RecordMode
+	}
+	return false
+}
+
+func (x *Song) GetSessionRecord() bool {
+	if x != nil {
+		return x.SessionRecord
+	}
+	return false
+}
+
+func (x *Song) GetSessionRecordStatus() int32 {
+	if x != nil {
+		return x.SessionRecordStatus
+	}
+	return 0
+}
+
+func (x *Song) GetArrangementOverdub() bool {
+	if x != nil {
+		return x.ArrangementOverdub
+	}
+	return false
+}
+
+func (x *Song) GetBackToArranger() bool {
+	if x != nil {
+		return x.BackToArranger
+	}
+	return false
+}
+
+func (x *Song) GetPunchIn() bool {
+	if x != nil {
+		return x.PunchIn
+	}
+	return false
+}
+
+func (x *Song) GetPunchOut() bool {
+	if x != nil {
+		return x.PunchOut
+	}
+	return false
+}
+
+func (x *Song) GetGrooveAmount() float32 {
+	if x != nil {
+		return x.GrooveAmount
+	}
+	return 0
+}
+
+func (x *Song) GetSignatureNumerator() int32 {
+	if x != nil {
+		return x.SignatureNumerator
+	}
+	return 0
+}
+
+func (x *Song) GetSignatureDenominator() int32 {
+	if x != nil {
+		return x.SignatureDenominator
+	}
+	return 0
+}
+
+func (x *Song) GetClipTriggerQuantization() int32 {
+	if x != nil {
+		return x.ClipTriggerQuantization
+	}
+	return 0
+}
+
+func (x *Song) GetMidiRecordingQuantization() int32 {
+	if x != nil {
+		return x.MidiRecordingQuantization
+	}
+	return 0
+}
+
+func (x *Song) GetNumTracks() int32 {
+	if x != nil {
+		return x.NumTracks
+	}
+	return 0
+}
+
+func (x *Song) GetNumScenes() int32 {
+	if x != nil {
+		return x.NumScenes
+	}
+	return 0
+}
+
+func (x *Song) GetSongLength() float32 {
+	if x != nil {
+		return x.SongLength
+	}
+	return 0
+}
+
+func (x *Song) GetCanUndo() bool {
+	if x != nil {
+		return x.CanUndo
+	}
+	return false
+}
+
+func (x *Song) GetCanRedo() bool {
+	if x != nil {
+		return x.CanRedo
+	}
+	return false
+}
+
+type SongUpdate struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Tempo                     *float32               `protobuf:"fixed32,1,opt,name=tempo,proto3,oneof" json:"tempo,omitempty"`
+	CurrentSongTime           *float32               `protobuf:"fixed32,3,opt,name=current_song_time,json=currentSongTime,proto3,oneof" json:"current_song_time,omitempty"`
+	Metronome                 *bool                  `protobuf:"varint,4,opt,name=metronome,proto3,oneof" json:"metronome,omitempty"`
+	Loop                      *bool                  `protobuf:"varint,5,opt,name=loop,proto3,oneof" json:"loop,omitempty"`
+	LoopStart                 *float32               `protobuf:"fixed32,6,opt,name=loop_start,json=loopStart,proto3,oneof" json:"loop_start,omitempty"`
+	LoopLength                *float32               `protobuf:"fixed32,7,opt,name=loop_length,json=loopLength,proto3,oneof" json:"loop_length,omitempty"`
+	RecordMode                *bool                  `protobuf:"varint,8,opt,name=record_mode,json=recordMode,proto3,oneof" json:"record_mode,omitempty"`
+	SessionRecord             *bool                  `protobuf:"varint,9,opt,name=session_record,json=sessionRecord,proto3,oneof" json:"session_record,omitempty"`
+	ArrangementOverdub        *bool                  `protobuf:"varint,11,opt,name=arrangement_overdub,json=arrangementOverdub,proto3,oneof" json:"arrangement_overdub,omitempty"`
+	BackToArranger            *bool                  `protobuf:"varint,12,opt,name=back_to_arranger,json=backToArranger,proto3,oneof" json:"back_to_arranger,omitempty"`
+	PunchIn                   *bool                  `protobuf:"varint,13,opt,name=punch_in,json=punchIn,proto3,oneof" json:"punch_in,omitempty"`
+	PunchOut                  *bool                  `protobuf:"varint,14,opt,name=punch_out,json=punchOut,proto3,oneof" json:"punch_out,omitempty"`
+	GrooveAmount              *float32               `protobuf:"fixed32,15,opt,name=groove_amount,json=grooveAmount,proto3,oneof" json:"groove_amount,omitempty"`
+	SignatureNumerator        *int32                 `protobuf:"varint,16,opt,name=signature_numerator,json=signatureNumerator,proto3,oneof" json:"signature_numerator,omitempty"`
+	SignatureDenominator      *int32                 `protobuf:"varint,17,opt,name=signature_denominator,json=signatureDenominator,proto3,oneof" json:"signature_denominator,omitempty"`
+	ClipTriggerQuantization   *int32                 `protobuf:"varint,18,opt,name=clip_trigger_quantization,json=clipTriggerQuantization,proto3,oneof" json:"clip_trigger_quantization,omitempty"`
+	MidiRecordingQuantization *int32                 `protobuf:"varint,19,opt,name=midi_recording_quantization,json=midiRecordingQuantization,proto3,oneof" json:"midi_recording_quantization,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *SongUpdate) Reset() {
+	*x = SongUpdate{}
+	mi := &file_als_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongUpdate) ProtoMessage() {}
+
+func (x *SongUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongUpdate.ProtoReflect.Descriptor instead.
+func (*SongUpdate) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SongUpdate) GetTempo() float32 {
+	if x != nil && x.Tempo != nil {
+		return *x.Tempo
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetCurrentSongTime() float32 {
+	if x != nil && x.CurrentSongTime != nil {
+		return *x.CurrentSongTime
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetMetronome() bool {
+	if x != nil && x.Metronome != nil {
+		return *x.Metronome
+	}
+	return false
+}
+
+func (x *SongUpdate) GetLoop() bool {
+	if x != nil && x.Loop != nil {
+		return *x.Loop
+	}
+	return false
+}
+
+func (x *SongUpdate) GetLoopStart() float32 {
+	if x != nil && x.LoopStart != nil {
+		return *x.LoopStart
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetLoopLength() float32 {
+	if x != nil && x.LoopLength != nil {
+		return *x.LoopLength
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetRecordMode() bool {
+	if x != nil && x.RecordMode != nil {
+		return *x.RecordMode
+	}
+	return false
+}
+
+func (x *SongUpdate) GetSessionRecord() bool {
+	if x != nil && x.SessionRecord != nil {
+		return *x.SessionRecord
+	}
+	return false
+}
+
+func (x *SongUpdate) GetArrangementOverdub() bool {
+	if x != nil && x.ArrangementOverdub != nil {
+		return *x.ArrangementOverdub
+	}
+	return false
+}
+
+func (x *SongUpdate) GetBackToArranger() bool {
+	if x != nil && x.BackToArranger != nil {
+		return *x.BackToArranger
+	}
+	return false
+}
+
+func (x *SongUpdate) GetPunchIn() bool {
+	if x != nil && x.PunchIn != nil {
+		return *x.PunchIn
+	}
+	return false
+}
+
+func (x *SongUpdate) GetPunchOut() bool {
+	if x != nil && x.PunchOut != nil {
+		return *x.PunchOut
+	}
+	return false
+}
+
+func (x *SongUpdate) GetGrooveAmount() float32 {
+	if x != nil && x.GrooveAmount != nil {
+		return *x.GrooveAmount
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetSignatureNumerator() int32 {
+	if x != nil && x.SignatureNumerator != nil {
+		return *x.SignatureNumerator
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetSignatureDenominator() int32 {
+	if x != nil && x.SignatureDenominator != nil {
+		return *x.SignatureDenominator
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetClipTriggerQuantization() int32 {
+	if x != nil && x.ClipTriggerQuantization != nil {
+		return *x.ClipTriggerQuantization
+	}
+	return 0
+}
+
+func (x *SongUpdate) GetMidiRecordingQuantization() int32 {
+	if x != nil && x.MidiRecordingQuantization != nil {
+		return *x.MidiRecordingQuantization
+	}
+	return 0
+}
+
+type TrackRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackRef) Reset() {
+	*x = TrackRef{}
+	mi := &file_als_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackRef) ProtoMessage() {}
+
+func (x *TrackRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackRef.ProtoReflect.Descriptor instead.
+func (*TrackRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrackRef) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+type SendRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Send          int32                  `protobuf:"varint,2,opt,name=send,proto3" json:"send,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendRef) Reset() {
+	*x = SendRef{}
+	mi := &file_als_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRef) ProtoMessage() {}
+
+func (x *SendRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRef.ProtoReflect.Descriptor instead.
+func (*SendRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendRef) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *SendRef) GetSend() int32 {
+	if x != nil {
+		return x.Send
+	}
+	return 0
+}
+
+type SetSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Send          int32                  `protobuf:"varint,2,opt,name=send,proto3" json:"send,omitempty"`
+	Value         float32                `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSendRequest) Reset() {
+	*x = SetSendRequest{}
+	mi := &file_als_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSendRequest) ProtoMessage() {}
+
+func (x *SetSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSendRequest.ProtoReflect.Descriptor instead.
+func (*SetSendRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetSendRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *SetSendRequest) GetSend() int32 {
+	if x != nil {
+		return x.Send
+	}
+	return 0
+}
+
+func (x *SetSendRequest) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float32                `protobuf:"fixed32,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_als_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Value) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Track struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Track                  int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mute                   bool                   `protobuf:"varint,3,opt,name=mute,proto3" json:"mute,omitempty"`
+	Solo                   bool                   `protobuf:"varint,4,opt,name=solo,proto3" json:"solo,omitempty"`
+	Arm                    bool                   `protobuf:"varint,5,opt,name=arm,proto3" json:"arm,omitempty"`
+	Volume                 float32                `protobuf:"fixed32,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Panning                float32                `protobuf:"fixed32,7,opt,name=panning,proto3" json:"panning,omitempty"`
+	Color                  int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	ColorIndex             int32                  `protobuf:"varint,9,opt,name=color_index,json=colorIndex,proto3" json:"color_index,omitempty"`
+	CurrentMonitoringState int32                  `protobuf:"varint,10,opt,name=current_monitoring_state,json=currentMonitoringState,proto3" json:"current_monitoring_state,omitempty"`
+	FoldState              bool                   `protobuf:"varint,11,opt,name=fold_state,json=foldState,proto3" json:"fold_state,omitempty"`
+	InputRoutingType       string                 `protobuf:"bytes,12,opt,name=input_routing_type,json=inputRoutingType,proto3" json:"input_routing_type,omitempty"`
+	InputRoutingChannel    string                 `protobuf:"bytes,13,opt,name=input_routing_channel,json=inputRoutingChannel,proto3" json:"input_routing_channel,omitempty"`
+	OutputRoutingType      string                 `protobuf:"bytes,14,opt,name=output_routing_type,json=outputRoutingType,proto3" json:"output_routing_type,omitempty"`
+	OutputRoutingChannel   string                 `protobuf:"bytes,15,opt,name=output_routing_channel,json=outputRoutingChannel,proto3" json:"output_routing_channel,omitempty"`
+	CanBeArmed             bool                   `protobuf:"varint,16,opt,name=can_be_armed,json=canBeArmed,proto3" json:"can_be_armed,omitempty"`
+	HasAudioInput          bool                   `protobuf:"varint,17,opt,name=has_audio_input,json=hasAudioInput,proto3" json:"has_audio_input,omitempty"`
+	HasAudioOutput         bool                   `protobuf:"varint,18,opt,name=has_audio_output,json=hasAudioOutput,proto3" json:"has_audio_output,omitempty"`
+	HasMidiInput           bool                   `protobuf:"varint,19,opt,name=has_midi_input,json=hasMidiInput,proto3" json:"has_midi_input,omitempty"`
+	HasMidiOutput          bool                   `protobuf:"varint,20,opt,name=has_midi_output,json=hasMidiOutput,proto3" json:"has_midi_output,omitempty"`
+	IsFoldable             bool                   `protobuf:"varint,21,opt,name=is_foldable,json=isFoldable,proto3" json:"is_foldable,omitempty"`
+	IsGrouped              bool                   `protobuf:"varint,22,opt,name=is_grouped,json=isGrouped,proto3" json:"is_grouped,omitempty"`
+	IsVisible              bool                   `protobuf:"varint,23,opt,name=is_visible,json=isVisible,proto3" json:"is_visible,omitempty"`
+	PlayingSlotIndex       int32                  `protobuf:"varint,24,opt,name=playing_slot_index,json=playingSlotIndex,proto3" json:"playing_slot_index,omitempty"`
+	FiredSlotIndex         int32                  `protobuf:"varint,25,opt,name=fired_slot_index,json=firedSlotIndex,proto3" json:"fired_slot_index,omitempty"`
+	OutputMeterLeft        float32                `protobuf:"fixed32,26,opt,name=output_meter_left,json=outputMeterLeft,proto3" json:"output_meter_left,omitempty"`
+	OutputMeterRight       float32                `protobuf:"fixed32,27,opt,name=output_meter_right,json=outputMeterRight,proto3" json:"output_meter_right,omitempty"`
+	OutputMeterLevel       float32                `protobuf:"fixed32,28,opt,name=output_meter_level,json=outputMeterLevel,proto3" json:"output_meter_level,omitempty"`
+	NumDevices             int32                  `protobuf:"varint,29,opt,name=num_devices,json=numDevices,proto3" json:"num_devices,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	mi := &file_als_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Track) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *Track) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Track) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+func (x *Track) GetSolo() bool {
+	if x != nil {
+		return x.Solo
+	}
+	return false
+}
+
+func (x *Track) GetArm() bool {
+	if x != nil {
+		return x.Arm
+	}
+	return false
+}
+
+func (x *Track) GetVolume() float32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Track) GetPanning() float32 {
+	if x != nil {
+		return x.Panning
+	}
+	return 0
+}
+
+func (x *Track) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Track) GetColorIndex() int32 {
+	if x != nil {
+		return x.ColorIndex
+	}
+	return 0
+}
+
+func (x *Track) GetCurrentMonitoringState() int32 {
+	if x != nil {
+		return x.CurrentMonitoringState
+	}
+	return 0
+}
+
+func (x *Track) GetFoldState() bool {
+	if x != nil {
+		return x.FoldState
+	}
+	return false
+}
+
+func (x *Track) GetInputRoutingType() string {
+	if x != nil {
+		return x.InputRoutingType
+	}
+	return ""
+}
+
+func (x *Track) GetInputRoutingChannel() string {
+	if x != nil {
+		return x.InputRoutingChannel
+	}
+	return ""
+}
+
+func (x *Track) GetOutputRoutingType() string {
+	if x != nil {
+		return x.OutputRoutingType
+	}
+	return ""
+}
+
+func (x *Track) GetOutputRoutingChannel() string {
+	if x != nil {
+		return x.OutputRoutingChannel
+	}
+	return ""
+}
+
+func (x *Track) GetCanBeArmed() bool {
+	if x != nil {
+		return x.CanBeArmed
+	}
+	return false
+}
+
+func (x *Track) GetHasAudioInput() bool {
+	if x != nil {
+		return x.HasAudioInput
+	}
+	return false
+}
+
+func (x *Track) GetHasAudioOutput() bool {
+	if x != nil {
+		return x.HasAudioOutput
+	}
+	return false
+}
+
+func (x *Track) GetHasMidiInput() bool {
+	if x != nil {
+		return x.HasMidiInput
+	}
+	return false
+}
+
+func (x *Track) GetHasMidiOutput() bool {
+	if x != nil {
+		return x.HasMidiOutput
+	}
+	return false
+}
+
+func (x *Track) GetIsFoldable() bool {
+	if x != nil {
+		return x.IsFoldable
+	}
+	return false
+}
+
+func (x *Track) GetIsGrouped() bool {
+	if x != nil {
+		return x.IsGrouped
+	}
+	return false
+}
+
+func (x *Track) GetIsVisible() bool {
+	if x != nil {
+		return x.IsVisible
+	}
+	return false
+}
+
+func (x *Track) GetPlayingSlotIndex() int32 {
+	if x != nil {
+		return x.PlayingSlotIndex
+	}
+	return 0
+}
+
+func (x *Track) GetFiredSlotIndex() int32 {
+	if x != nil {
+		return x.FiredSlotIndex
+	}
+	return 0
+}
+
+func (x *Track) GetOutputMeterLeft() float32 {
+	if x != nil {
+		return x.OutputMeterLeft
+	}
+	return 0
+}
+
+func (x *Track) GetOutputMeterRight() float32 {
+	if x != nil {
+		return x.OutputMeterRight
+	}
+	return 0
+}
+
+func (x *Track) GetOutputMeterLevel() float32 {
+	if x != nil {
+		return x.OutputMeterLevel
+	}
+	return 0
+}
+
+func (x *Track) GetNumDevices() int32 {
+	if x != nil {
+		return x.NumDevices
+	}
+	return 0
+}
+
+type TrackUpdate struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Track                  int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Name                   *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Mute                   *bool                  `protobuf:"varint,3,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
+	Solo                   *bool                  `protobuf:"varint,4,opt,name=solo,proto3,oneof" json:"solo,omitempty"`
+	Arm                    *bool                  `protobuf:"varint,5,opt,name=arm,proto3,oneof" json:"arm,omitempty"`
+	Volume                 *float32               `protobuf:"fixed32,6,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Panning                *float32               `protobuf:"fixed32,7,opt,name=panning,proto3,oneof" json:"panning,omitempty"`
+	Color                  *int32                 `protobuf:"varint,8,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ColorIndex             *int32                 `protobuf:"varint,9,opt,name=color_index,json=colorIndex,proto3,oneof" json:"color_index,omitempty"`
+	CurrentMonitoringState *int32                 `protobuf:"varint,10,opt,name=current_monitoring_state,json=currentMonitoringState,proto3,oneof" json:"current_monitoring_state,omitempty"`
+	FoldState              *bool                  `protobuf:"varint,11,opt,name=fold_state,json=foldState,proto3,oneof" json:"fold_state,omitempty"`
+	InputRoutingType       *string                `protobuf:"bytes,12,opt,name=input_routing_type,json=inputRoutingType,proto3,oneof" json:"input_routing_type,omitempty"`
+	InputRoutingChannel    *string                `protobuf:"bytes,13,opt,name=input_routing_channel,json=inputRoutingChannel,proto3,oneof" json:"input_routing_channel,omitempty"`
+	OutputRoutingType      *string                `protobuf:"bytes,14,opt,name=output_routing_type,json=outputRoutingType,proto3,oneof" json:"output_routing_type,omitempty"`
+	OutputRoutingChannel   *string                `protobuf:"bytes,15,opt,name=output_routing_channel,json=outputRoutingChannel,proto3,oneof" json:"output_routing_channel,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrackUpdate) Reset() {
+	*x = TrackUpdate{}
+	mi := &file_als_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackUpdate) ProtoMessage() {}
+
+func (x *TrackUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackUpdate.ProtoReflect.Descriptor instead.
+func (*TrackUpdate) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TrackUpdate) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *TrackUpdate) GetMute() bool {
+	if x != nil && x.Mute != nil {
+		return *x.Mute
+	}
+	return false
+}
+
+func (x *TrackUpdate) GetSolo() bool {
+	if x != nil && x.Solo != nil {
+		return *x.Solo
+	}
+	return false
+}
+
+func (x *TrackUpdate) GetArm() bool {
+	if x != nil && x.Arm != nil {
+		return *x.Arm
+	}
+	return false
+}
+
+func (x *TrackUpdate) GetVolume() float32 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetPanning() float32 {
+	if x != nil && x.Panning != nil {
+		return *x.Panning
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetColor() int32 {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetColorIndex() int32 {
+	if x != nil && x.ColorIndex != nil {
+		return *x.ColorIndex
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetCurrentMonitoringState() int32 {
+	if x != nil && x.CurrentMonitoringState != nil {
+		return *x.CurrentMonitoringState
+	}
+	return 0
+}
+
+func (x *TrackUpdate) GetFoldState() bool {
+	if x != nil && x.FoldState != nil {
+		return *x.FoldState
+	}
+	return false
+}
+
+func (x *TrackUpdate) GetInputRoutingType() string {
+	if x != nil && x.InputRoutingType != nil {
+		return *x.InputRoutingType
+	}
+	return ""
+}
+
+func (x *TrackUpdate) GetInputRoutingChannel() string {
+	if x != nil && x.InputRoutingChannel != nil {
+		return *x.InputRoutingChannel
+	}
+	return ""
+}
+
+func (x *TrackUpdate) GetOutputRoutingType() string {
+	if x != nil && x.OutputRoutingType != nil {
+		return *x.OutputRoutingType
+	}
+	return ""
+}
+
+func (x *TrackUpdate) GetOutputRoutingChannel() string {
+	if x != nil && x.OutputRoutingChannel != nil {
+		return *x.OutputRoutingChannel
+	}
+	return ""
+}
+
+type ClipSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Length        float32                `protobuf:"fixed32,2,opt,name=length,proto3" json:"length,omitempty"`
+	Color         int32                  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	StartTime     float32                `protobuf:"fixed32,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // arrangement clips only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipSummary) Reset() {
+	*x = ClipSummary{}
+	mi := &file_als_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipSummary) ProtoMessage() {}
+
+func (x *ClipSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipSummary.ProtoReflect.Descriptor instead.
+func (*ClipSummary) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClipSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClipSummary) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ClipSummary) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *ClipSummary) GetStartTime() float32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+type ClipSummaries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clips         []*ClipSummary         `protobuf:"bytes,1,rep,name=clips,proto3" json:"clips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipSummaries) Reset() {
+	*x = ClipSummaries{}
+	mi := &file_als_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipSummaries) ProtoMessage() {}
+
+func (x *ClipSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipSummaries.ProtoReflect.Descriptor instead.
+func (*ClipSummaries) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClipSummaries) GetClips() []*ClipSummary {
+	if x != nil {
+		return x.Clips
+	}
+	return nil
+}
+
+type DeviceSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ClassName     string                 `protobuf:"bytes,3,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceSummary) Reset() {
+	*x = DeviceSummary{}
+	mi := &file_als_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSummary) ProtoMessage() {}
+
+func (x *DeviceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSummary.ProtoReflect.Descriptor instead.
+func (*DeviceSummary) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeviceSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeviceSummary) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+type DeviceSummaries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*DeviceSummary       `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceSummaries) Reset() {
+	*x = DeviceSummaries{}
+	mi := &file_als_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSummaries) ProtoMessage() {}
+
+func (x *DeviceSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSummaries.ProtoReflect.Descriptor instead.
+func (*DeviceSummaries) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeviceSummaries) GetDevices() []*DeviceSummary {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type ClipRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipRef) Reset() {
+	*x = ClipRef{}
+	mi := &file_als_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipRef) ProtoMessage() {}
+
+func (x *ClipRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipRef.ProtoReflect.Descriptor instead.
+func (*ClipRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClipRef) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *ClipRef) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+type Clip struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Track           int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip            int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color           int32                  `protobuf:"varint,4,opt,name=color,proto3" json:"color,omitempty"`
+	Gain            float32                `protobuf:"fixed32,5,opt,name=gain,proto3" json:"gain,omitempty"`
+	Length          float32                `protobuf:"fixed32,6,opt,name=length,proto3" json:"length,omitempty"`
+	PitchCoarse     int32                  `protobuf:"varint,7,opt,name=pitch_coarse,json=pitchCoarse,proto3" json:"pitch_coarse,omitempty"`
+	PitchFine       int32                  `protobuf:"varint,8,opt,name=pitch_fine,json=pitchFine,proto3" json:"pitch_fine,omitempty"`
+	FilePath        string                 `protobuf:"bytes,9,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	IsAudioClip     bool                   `protobuf:"varint,10,opt,name=is_audio_clip,json=isAudioClip,proto3" json:"is_audio_clip,omitempty"`
+	IsMidiClip      bool                   `protobuf:"varint,11,opt,name=is_midi_clip,json=isMidiClip,proto3" json:"is_midi_clip,omitempty"`
+	IsPlaying       bool                   `protobuf:"varint,12,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	IsRecording     bool                   `protobuf:"varint,13,opt,name=is_recording,json=isRecording,proto3" json:"is_recording,omitempty"`
+	PlayingPosition float32                `protobuf:"fixed32,14,opt,name=playing_position,json=playingPosition,proto3" json:"playing_position,omitempty"`
+	LoopStart       float32                `protobuf:"fixed32,15,opt,name=loop_start,json=loopStart,proto3" json:"loop_start,omitempty"`
+	LoopEnd         float32                `protobuf:"fixed32,16,opt,name=loop_end,json=loopEnd,proto3" json:"loop_end,omitempty"`
+	Warping         bool                   `protobuf:"varint,17,opt,name=warping,proto3" json:"warping,omitempty"`
+	StartMarker     float32                `protobuf:"fixed32,18,opt,name=start_marker,json=startMarker,proto3" json:"start_marker,omitempty"`
+	EndMarker       float32                `protobuf:"fixed32,19,opt,name=end_marker,json=endMarker,proto3" json:"end_marker,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Clip) Reset() {
+	*x = Clip{}
+	mi := &file_als_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Clip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clip) ProtoMessage() {}
+
+func (x *Clip) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clip.ProtoReflect.Descriptor instead.
+func (*Clip) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Clip) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *Clip) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *Clip) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Clip) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Clip) GetGain() float32 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *Clip) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Clip) GetPitchCoarse() int32 {
+	if x != nil {
+		return x.PitchCoarse
+	}
+	return 0
+}
+
+func (x *Clip) GetPitchFine() int32 {
+	if x != nil {
+		return x.PitchFine
+	}
+	return 0
+}
+
+func (x *Clip) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *Clip) GetIsAudioClip() bool {
+	if x != nil {
+		return x.IsAudioClip
+	}
+	return false
+}
+
+func (x *Clip) GetIsMidiClip() bool {
+	if x != nil {
+		return x.IsMidiClip
+	}
+	return false
+}
+
+func (x *Clip) GetIsPlaying() bool {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return false
+}
+
+func (x *Clip) GetIsRecording() bool {
+	if x != nil {
+		return x.IsRecording
+	}
+	return false
+}
+
+func (x *Clip) GetPlayingPosition() float32 {
+	if x != nil {
+		return x.PlayingPosition
+	}
+	return 0
+}
+
+func (x *Clip) GetLoopStart() float32 {
+	if x != nil {
+		return x.LoopStart
+	}
+	return 0
+}
+
+func (x *Clip) GetLoopEnd() float32 {
+	if x != nil {
+		return x.LoopEnd
+	}
+	return 0
+}
+
+func (x *Clip) GetWarping() bool {
+	if x != nil {
+		return x.Warping
+	}
+	return false
+}
+
+func (x *Clip) GetStartMarker() float32 {
+	if x != nil {
+		return x.StartMarker
+	}
+	return 0
+}
+
+func (x *Clip) GetEndMarker() float32 {
+	if x != nil {
+		return x.EndMarker
+	}
+	return 0
+}
+
+type ClipUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *int32                 `protobuf:"varint,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Gain          *float32               `protobuf:"fixed32,5,opt,name=gain,proto3,oneof" json:"gain,omitempty"`
+	PitchCoarse   *int32                 `protobuf:"varint,7,opt,name=pitch_coarse,json=pitchCoarse,proto3,oneof" json:"pitch_coarse,omitempty"`
+	PitchFine     *int32                 `protobuf:"varint,8,opt,name=pitch_fine,json=pitchFine,proto3,oneof" json:"pitch_fine,omitempty"`
+	LoopStart     *float32               `protobuf:"fixed32,15,opt,name=loop_start,json=loopStart,proto3,oneof" json:"loop_start,omitempty"`
+	LoopEnd       *float32               `protobuf:"fixed32,16,opt,name=loop_end,json=loopEnd,proto3,oneof" json:"loop_end,omitempty"`
+	Warping       *bool                  `protobuf:"varint,17,opt,name=warping,proto3,oneof" json:"warping,omitempty"`
+	StartMarker   *float32               `protobuf:"fixed32,18,opt,name=start_marker,json=startMarker,proto3,oneof" json:"start_marker,omitempty"`
+	EndMarker     *float32               `protobuf:"fixed32,19,opt,name=end_marker,json=endMarker,proto3,oneof" json:"end_marker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipUpdate) Reset() {
+	*x = ClipUpdate{}
+	mi := &file_als_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipUpdate) ProtoMessage() {}
+
+func (x *ClipUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipUpdate.ProtoReflect.Descriptor instead.
+func (*ClipUpdate) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClipUpdate) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ClipUpdate) GetColor() int32 {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetGain() float32 {
+	if x != nil && x.Gain != nil {
+		return *x.Gain
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetPitchCoarse() int32 {
+	if x != nil && x.PitchCoarse != nil {
+		return *x.PitchCoarse
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetPitchFine() int32 {
+	if x != nil && x.PitchFine != nil {
+		return *x.PitchFine
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetLoopStart() float32 {
+	if x != nil && x.LoopStart != nil {
+		return *x.LoopStart
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetLoopEnd() float32 {
+	if x != nil && x.LoopEnd != nil {
+		return *x.LoopEnd
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetWarping() bool {
+	if x != nil && x.Warping != nil {
+		return *x.Warping
+	}
+	return false
+}
+
+func (x *ClipUpdate) GetStartMarker() float32 {
+	if x != nil && x.StartMarker != nil {
+		return *x.StartMarker
+	}
+	return 0
+}
+
+func (x *ClipUpdate) GetEndMarker() float32 {
+	if x != nil && x.EndMarker != nil {
+		return *x.EndMarker
+	}
+	return 0
+}
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pitch         int32                  `protobuf:"varint,1,opt,name=pitch,proto3" json:"pitch,omitempty"`
+	StartTime     float32                `protobuf:"fixed32,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration      float32                `protobuf:"fixed32,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Velocity      int32                  `protobuf:"varint,4,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Mute          bool                   `protobuf:"varint,5,opt,name=mute,proto3" json:"mute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_als_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Note) GetPitch() int32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *Note) GetStartTime() float32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Note) GetDuration() float32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Note) GetVelocity() int32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *Note) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+type Notes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notes) Reset() {
+	*x = Notes{}
+	mi := &file_als_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notes) ProtoMessage() {}
+
+func (x *Notes) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notes.ProtoReflect.Descriptor instead.
+func (*Notes) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Notes) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// GetNotesRequest reads every note, or those in a range when
+// pitch_span and time_span are set.
+type GetNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	StartPitch    int32                  `protobuf:"varint,3,opt,name=start_pitch,json=startPitch,proto3" json:"start_pitch,omitempty"`
+	PitchSpan     int32                  `protobuf:"varint,4,opt,name=pitch_span,json=pitchSpan,proto3" json:"pitch_span,omitempty"`
+	StartTime     float32                `protobuf:"fixed32,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeSpan      float32                `protobuf:"fixed32,6,opt,name=time_span,json=timeSpan,proto3" json:"time_span,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotesRequest) Reset() {
+	*x = GetNotesRequest{}
+	mi := &file_als_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotesRequest) ProtoMessage() {}
+
+func (x *GetNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotesRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNotesRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *GetNotesRequest) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *GetNotesRequest) GetStartPitch() int32 {
+	if x != nil {
+		return x.StartPitch
+	}
+	return 0
+}
+
+func (x *GetNotesRequest) GetPitchSpan() int32 {
+	if x != nil {
+		return x.PitchSpan
+	}
+	return 0
+}
+
+func (x *GetNotesRequest) GetStartTime() float32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetNotesRequest) GetTimeSpan() float32 {
+	if x != nil {
+		return x.TimeSpan
+	}
+	return 0
+}
+
+type AddNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	Notes         []*Note                `protobuf:"bytes,3,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNotesRequest) Reset() {
+	*x = AddNotesRequest{}
+	mi := &file_als_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNotesRequest) ProtoMessage() {}
+
+func (x *AddNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNotesRequest.ProtoReflect.Descriptor instead.
+func (*AddNotesRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddNotesRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *AddNotesRequest) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *AddNotesRequest) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type RemoveNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	StartPitch    int32                  `protobuf:"varint,3,opt,name=start_pitch,json=startPitch,proto3" json:"start_pitch,omitempty"`
+	PitchSpan     int32                  `protobuf:"varint,4,opt,name=pitch_span,json=pitchSpan,proto3" json:"pitch_span,omitempty"`
+	StartTime     float32                `protobuf:"fixed32,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeSpan      float32                `protobuf:"fixed32,6,opt,name=time_span,json=timeSpan,proto3" json:"time_span,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNotesRequest) Reset() {
+	*x = RemoveNotesRequest{}
+	mi := &file_als_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNotesRequest) ProtoMessage() {}
+
+func (x *RemoveNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNotesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveNotesRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveNotesRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *RemoveNotesRequest) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *RemoveNotesRequest) GetStartPitch() int32 {
+	if x != nil {
+		return x.StartPitch
+	}
+	return 0
+}
+
+func (x *RemoveNotesRequest) GetPitchSpan() int32 {
+	if x != nil {
+		return x.PitchSpan
+	}
+	return 0
+}
+
+func (x *RemoveNotesRequest) GetStartTime() float32 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *RemoveNotesRequest) GetTimeSpan() float32 {
+	if x != nil {
+		return x.TimeSpan
+	}
+	return 0
+}
+
+type ClipSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	HasClip       bool                   `protobuf:"varint,3,opt,name=has_clip,json=hasClip,proto3" json:"has_clip,omitempty"`
+	HasStopButton bool                   `protobuf:"varint,4,opt,name=has_stop_button,json=hasStopButton,proto3" json:"has_stop_button,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipSlot) Reset() {
+	*x = ClipSlot{}
+	mi := &file_als_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipSlot) ProtoMessage() {}
+
+func (x *ClipSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipSlot.ProtoReflect.Descriptor instead.
+func (*ClipSlot) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClipSlot) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *ClipSlot) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *ClipSlot) GetHasClip() bool {
+	if x != nil {
+		return x.HasClip
+	}
+	return false
+}
+
+func (x *ClipSlot) GetHasStopButton() bool {
+	if x != nil {
+		return x.HasStopButton
+	}
+	return false
+}
+
+type ClipSlotUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	HasStopButton *bool                  `protobuf:"varint,4,opt,name=has_stop_button,json=hasStopButton,proto3,oneof" json:"has_stop_button,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClipSlotUpdate) Reset() {
+	*x = ClipSlotUpdate{}
+	mi := &file_als_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClipSlotUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClipSlotUpdate) ProtoMessage() {}
+
+func (x *ClipSlotUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClipSlotUpdate.ProtoReflect.Descriptor instead.
+func (*ClipSlotUpdate) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClipSlotUpdate) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *ClipSlotUpdate) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *ClipSlotUpdate) GetHasStopButton() bool {
+	if x != nil && x.HasStopButton != nil {
+		return *x.HasStopButton
+	}
+	return false
+}
+
+type CreateClipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Clip          int32                  `protobuf:"varint,2,opt,name=clip,proto3" json:"clip,omitempty"`
+	Length        float32                `protobuf:"fixed32,3,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClipRequest) Reset() {
+	*x = CreateClipRequest{}
+	mi := &file_als_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClipRequest) ProtoMessage() {}
+
+func (x *CreateClipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClipRequest.ProtoReflect.Descriptor instead.
+func (*CreateClipRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateClipRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *CreateClipRequest) GetClip() int32 {
+	if x != nil {
+		return x.Clip
+	}
+	return 0
+}
+
+func (x *CreateClipRequest) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type DuplicateClipToRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *ClipRef               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *ClipRef               `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateClipToRequest) Reset() {
+	*x = DuplicateClipToRequest{}
+	mi := &file_als_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateClipToRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateClipToRequest) ProtoMessage() {}
+
+func (x *DuplicateClipToRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateClipToRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateClipToRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DuplicateClipToRequest) GetFrom() *ClipRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DuplicateClipToRequest) GetTo() *ClipRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type SceneRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scene         int32                  `protobuf:"varint,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SceneRef) Reset() {
+	*x = SceneRef{}
+	mi := &file_als_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SceneRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SceneRef) ProtoMessage() {}
+
+func (x *SceneRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SceneRef.ProtoReflect.Descriptor instead.
+func (*SceneRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SceneRef) GetScene() int32 {
+	if x != nil {
+		return x.Scene
+	}
+	return 0
+}
+
+type Scene struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Scene                    int32                  `protobuf:"varint,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color                    int32                  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	ColorIndex               int32                  `protobuf:"varint,4,opt,name=color_index,json=colorIndex,proto3" json:"color_index,omitempty"`
+	IsEmpty                  bool                   `protobuf:"varint,5,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	IsTriggered              bool                   `protobuf:"varint,6,opt,name=is_triggered,json=isTriggered,proto3" json:"is_triggered,omitempty"`
+	Tempo                    float32                `protobuf:"fixed32,7,opt,name=tempo,proto3" json:"tempo,omitempty"`
+	TempoEnabled             bool                   `protobuf:"varint,8,opt,name=tempo_enabled,json=tempoEnabled,proto3" json:"tempo_enabled,omitempty"`
+	TimeSignatureNumerator   int32                  `protobuf:"varint,9,opt,name=time_signature_numerator,json=timeSignatureNumerator,proto3" json:"time_signature_numerator,omitempty"`
+	TimeSignatureDenominator int32                  `protobuf:"varint,10,opt,name=time_signature_denominator,json=timeSignatureDenominator,proto3" json:"time_signature_denominator,omitempty"`
+	TimeSignatureEnabled     bool                   `protobuf:"varint,11,opt,name=time_signature_enabled,json=timeSignatureEnabled,proto3" json:"time_signature_enabled,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Scene) Reset() {
+	*x = Scene{}
+	mi := &file_als_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scene) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scene) ProtoMessage() {}
+
+func (x *Scene) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scene.ProtoReflect.Descriptor instead.
+func (*Scene) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Scene) GetScene() int32 {
+	if x != nil {
+		return x.Scene
+	}
+	return 0
+}
+
+func (x *Scene) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scene) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Scene) GetColorIndex() int32 {
+	if x != nil {
+		return x.ColorIndex
+	}
+	return 0
+}
+
+func (x *Scene) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
+	}
+	return false
+}
+
+func (x *Scene) GetIsTriggered() bool {
+	if x != nil {
+		return x.IsTriggered
+	}
+	return false
+}
+
+func (x *Scene) GetTempo() float32 {
+	if x != nil {
+		return x.Tempo
+	}
+	return 0
+}
+
+func (x *Scene) GetTempoEnabled() bool {
+	if x != nil {
+		return x.TempoEnabled
+	}
+	return false
+}
+
+func (x *Scene) GetTimeSignatureNumerator() int32 {
+	if x != nil {
+		return x.TimeSignatureNumerator
+	}
+	return 0
+}
+
+func (x *Scene) GetTimeSignatureDenominator() int32 {
+	if x != nil {
+		return x.TimeSignatureDenominator
+	}
+	return 0
+}
+
+func (x *Scene) GetTimeSignatureEnabled() bool {
+	if x != nil {
+		return x.TimeSignatureEnabled
+	}
+	return false
+}
+
+type SceneUpdate struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Scene                    int32                  `protobuf:"varint,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	Name                     *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color                    *int32                 `protobuf:"varint,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ColorIndex               *int32                 `protobuf:"varint,4,opt,name=color_index,json=colorIndex,proto3,oneof" json:"color_index,omitempty"`
+	Tempo                    *float32               `protobuf:"fixed32,7,opt,name=tempo,proto3,oneof" json:"tempo,omitempty"`
+	TempoEnabled             *bool                  `protobuf:"varint,8,opt,name=tempo_enabled,json=tempoEnabled,proto3,oneof" json:"tempo_enabled,omitempty"`
+	TimeSignatureNumerator   *int32                 `protobuf:"varint,9,opt,name=time_signature_numerator,json=timeSignatureNumerator,proto3,oneof" json:"time_signature_numerator,omitempty"`
+	TimeSignatureDenominator *int32                 `protobuf:"varint,10,opt,name=time_signature_denominator,json=timeSignatureDenominator,proto3,oneof" json:"time_signature_denominator,omitempty"`
+	TimeSignatureEnabled     *bool                  `protobuf:"varint,11,opt,name=time_signature_enabled,json=timeSignatureEnabled,proto3,oneof" json:"time_signature_enabled,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SceneUpdate) Reset() {
+	*x = SceneUpdate{}
+	mi := &file_als_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SceneUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SceneUpdate) ProtoMessage() {}
+
+func (x *SceneUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SceneUpdate.ProtoReflect.Descriptor instead.
+func (*SceneUpdate) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SceneUpdate) GetScene() int32 {
+	if x != nil {
+		return x.Scene
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SceneUpdate) GetColor() int32 {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetColorIndex() int32 {
+	if x != nil && x.ColorIndex != nil {
+		return *x.ColorIndex
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetTempo() float32 {
+	if x != nil && x.Tempo != nil {
+		return *x.Tempo
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetTempoEnabled() bool {
+	if x != nil && x.TempoEnabled != nil {
+		return *x.TempoEnabled
+	}
+	return false
+}
+
+func (x *SceneUpdate) GetTimeSignatureNumerator() int32 {
+	if x != nil && x.TimeSignatureNumerator != nil {
+		return *x.TimeSignatureNumerator
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetTimeSignatureDenominator() int32 {
+	if x != nil && x.TimeSignatureDenominator != nil {
+		return *x.TimeSignatureDenominator
+	}
+	return 0
+}
+
+func (x *SceneUpdate) GetTimeSignatureEnabled() bool {
+	if x != nil && x.TimeSignatureEnabled != nil {
+		return *x.TimeSignatureEnabled
+	}
+	return false
+}
+
+type DeviceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Device        int32                  `protobuf:"varint,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceRef) Reset() {
+	*x = DeviceRef{}
+	mi := &file_als_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRef) ProtoMessage() {}
+
+func (x *DeviceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRef.ProtoReflect.Descriptor instead.
+func (*DeviceRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeviceRef) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *DeviceRef) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+type ParameterRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Device        int32                  `protobuf:"varint,2,opt,name=device,proto3" json:"device,omitempty"`
+	Parameter     int32                  `protobuf:"varint,3,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParameterRef) Reset() {
+	*x = ParameterRef{}
+	mi := &file_als_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterRef) ProtoMessage() {}
+
+func (x *ParameterRef) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterRef.ProtoReflect.Descriptor instead.
+func (*ParameterRef) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ParameterRef) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *ParameterRef) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *ParameterRef) GetParameter() int32 {
+	if x != nil {
+		return x.Parameter
+	}
+	return 0
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Device        int32                  `protobuf:"varint,2,opt,name=device,proto3" json:"device,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ClassName     string                 `protobuf:"bytes,4,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Parameters    []*Parameter           `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_als_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Device) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *Device) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *Device) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Device) GetParameters() []*Parameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type Parameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parameter     int32                  `protobuf:"varint,1,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float32                `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	Min           float32                `protobuf:"fixed32,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float32                `protobuf:"fixed32,5,opt,name=max,proto3" json:"max,omitempty"`
+	IsQuantized   bool                   `protobuf:"varint,6,opt,name=is_quantized,json=isQuantized,proto3" json:"is_quantized,omitempty"`
+	ValueString   string                 `protobuf:"bytes,7,opt,name=value_string,json=valueString,proto3" json:"value_string,omitempty"` // GetParameter only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Parameter) Reset() {
+	*x = Parameter{}
+	mi := &file_als_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Parameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parameter) ProtoMessage() {}
+
+func (x *Parameter) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parameter.ProtoReflect.Descriptor instead.
+func (*Parameter) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Parameter) GetParameter() int32 {
+	if x != nil {
+		return x.Parameter
+	}
+	return 0
+}
+
+func (x *Parameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Parameter) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Parameter) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Parameter) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *Parameter) GetIsQuantized() bool {
+	if x != nil {
+		return x.IsQuantized
+	}
+	return false
+}
+
+func (x *Parameter) GetValueString() string {
+	if x != nil {
+		return x.ValueString
+	}
+	return ""
+}
+
+type SetParameterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Device        int32                  `protobuf:"varint,2,opt,name=device,proto3" json:"device,omitempty"`
+	Parameter     int32                  `protobuf:"varint,3,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Value         float32                `protobuf:"fixed32,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParameterRequest) Reset() {
+	*x = SetParameterRequest{}
+	mi := &file_als_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParameterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParameterRequest) ProtoMessage() {}
+
+func (x *SetParameterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParameterRequest.ProtoReflect.Descriptor instead.
+func (*SetParameterRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetParameterRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *SetParameterRequest) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *SetParameterRequest) GetParameter() int32 {
+	if x != nil {
+		return x.Parameter
+	}
+	return 0
+}
+
+func (x *SetParameterRequest) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SetParametersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Track         int32                  `protobuf:"varint,1,opt,name=track,proto3" json:"track,omitempty"`
+	Device        int32                  `protobuf:"varint,2,opt,name=device,proto3" json:"device,omitempty"`
+	Values        []float32              `protobuf:"fixed32,3,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParametersRequest) Reset() {
+	*x = SetParametersRequest{}
+	mi := &file_als_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParametersRequest) ProtoMessage() {}
+
+func (x *SetParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParametersRequest.ProtoReflect.Descriptor instead.
+func (*SetParametersRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetParametersRequest) GetTrack() int32 {
+	if x != nil {
+		return x.Track
+	}
+	return 0
+}
+
+func (x *SetParametersRequest) GetDevice() int32 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *SetParametersRequest) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Selection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SelectedTrack  int32                  `protobuf:"varint,1,opt,name=selected_track,json=selectedTrack,proto3" json:"selected_track,omitempty"`
+	SelectedScene  int32                  `protobuf:"varint,2,opt,name=selected_scene,json=selectedScene,proto3" json:"selected_scene,omitempty"`
+	SelectedClip   *ClipRef               `protobuf:"bytes,3,opt,name=selected_clip,json=selectedClip,proto3" json:"selected_clip,omitempty"`
+	SelectedDevice *DeviceRef             `protobuf:"bytes,4,opt,name=selected_device,json=selectedDevice,proto3" json:"selected_device,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Selection) Reset() {
+	*x = Selection{}
+	mi := &file_als_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Selection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Selection) ProtoMessage() {}
+
+func (x *Selection) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Selection.ProtoReflect.Descriptor instead.
+func (*Selection) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Selection) GetSelectedTrack() int32 {
+	if x != nil {
+		return x.SelectedTrack
+	}
+	return 0
+}
+
+func (x *Selection) GetSelectedScene() int32 {
+	if x != nil {
+		return x.SelectedScene
+	}
+	return 0
+}
+
+func (x *Selection) GetSelectedClip() *ClipRef {
+	if x != nil {
+		return x.SelectedClip
+	}
+	return nil
+}
+
+func (x *Selection) GetSelectedDevice() *DeviceRef {
+	if x != nil {
+		return x.SelectedDevice
+	}
+	return nil
+}
+
+type SelectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*SelectRequest_Track
+	//	*SelectRequest_Scene
+	//	*SelectRequest_Clip
+	//	*SelectRequest_Device
+	Target        isSelectRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectRequest) Reset() {
+	*x = SelectRequest{}
+	mi := &file_als_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectRequest) ProtoMessage() {}
+
+func (x *SelectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectRequest.ProtoReflect.Descriptor instead.
+func (*SelectRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SelectRequest) GetTarget() isSelectRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SelectRequest) GetTrack() int32 {
+	if x != nil {
+		if x, ok := x.Target.(*SelectRequest_Track); ok {
+			return x.Track
+		}
+	}
+	return 0
+}
+
+func (x *SelectRequest) GetScene() int32 {
+	if x != nil {
+		if x, ok := x.Target.(*SelectRequest_Scene); ok {
+			return x.Scene
+		}
+	}
+	return 0
+}
+
+func (x *SelectRequest) GetClip() *ClipRef {
+	if x != nil {
+		if x, ok := x.Target.(*SelectRequest_Clip); ok {
+			return x.Clip
+		}
+	}
+	return nil
+}
+
+func (x *SelectRequest) GetDevice() *DeviceRef {
+	if x != nil {
+		if x, ok := x.Target.(*SelectRequest_Device); ok {
+			return x.Device
+		}
+	}
+	return nil
+}
+
+type isSelectRequest_Target interface {
+	isSelectRequest_Target()
+}
+
+type SelectRequest_Track struct {
+	Track int32 `protobuf:"varint,1,opt,name=track,proto3,oneof"`
+}
+
+type SelectRequest_Scene struct {
+	Scene int32 `protobuf:"varint,2,opt,name=scene,proto3,oneof"`
+}
+
+type SelectRequest_Clip struct {
+	Clip *ClipRef `protobuf:"bytes,3,opt,name=clip,proto3,oneof"`
+}
+
+type SelectRequest_Device struct {
+	Device *DeviceRef `protobuf:"bytes,4,opt,name=device,proto3,oneof"`
+}
+
+func (*SelectRequest_Track) isSelectRequest_Target() {}
+
+func (*SelectRequest_Scene) isSelectRequest_Target() {}
+
+func (*SelectRequest_Clip) isSelectRequest_Target() {}
+
+func (*SelectRequest_Device) isSelectRequest_Target() {}
+
+// Topic names a listenable property, e.g. object "track", property
+// "volume", indices [2].
+type Topic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        string                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Property      string                 `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"`
+	Indices       []int32                `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	mi := &file_als_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Topic) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *Topic) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *Topic) GetIndices() []int32 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type ListenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*Topic               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListenRequest) Reset() {
+	*x = ListenRequest{}
+	mi := &file_als_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenRequest) ProtoMessage() {}
+
+func (x *ListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenRequest.ProtoReflect.Descriptor instead.
+func (*ListenRequest) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListenRequest) GetTopics() []*Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type Argument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Argument_Float
+	//	*Argument_Int
+	//	*Argument_String_
+	//	*Argument_Bool
+	Value         isArgument_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Argument) Reset() {
+	*x = Argument{}
+	mi := &file_als_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Argument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Argument) ProtoMessage() {}
+
+func (x *Argument) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Argument.ProtoReflect.Descriptor instead.
+func (*Argument) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Argument) GetValue() isArgument_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Argument) GetFloat() float32 {
+	if x != nil {
+		if x, ok := x.Value.(*Argument_Float); ok {
+			return x.Float
+		}
+	}
+	return 0
+}
+
+func (x *Argument) GetInt() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*Argument_Int); ok {
+			return x.Int
+		}
+	}
+	return 0
+}
+
+func (x *Argument) GetString_() string {
+	if x != nil {
+		if x, ok := x.Value.(*Argument_String_); ok {
+			return x.String_
+		}
+	}
+	return ""
+}
+
+func (x *Argument) GetBool() bool {
+	if x != nil {
+		if x, ok := x.Value.(*Argument_Bool); ok {
+			return x.Bool
+		}
+	}
+	return false
+}
+
+type isArgument_Value interface {
+	isArgument_Value()
+}
+
+type Argument_Float struct {
+	Float float32 `protobuf:"fixed32,1,opt,name=float,proto3,oneof"`
+}
+
+type Argument_Int struct {
+	Int int32 `protobuf:"varint,2,opt,name=int,proto3,oneof"`
+}
+
+type Argument_String_ struct {
+	String_ string `protobuf:"bytes,3,opt,name=string,proto3,oneof"`
+}
+
+type Argument_Bool struct {
+	Bool bool `protobuf:"varint,4,opt,name=bool,proto3,oneof"`
+}
+
+func (*Argument_Float) isArgument_Value() {}
+
+func (*Argument_Int) isArgument_Value() {}
+
+func (*Argument_String_) isArgument_Value() {}
+
+func (*Argument_Bool) isArgument_Value() {}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *Topic                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Values        []*Argument            `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	TimeUnixNano  int64                  `protobuf:"varint,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_als_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_als_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_als_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Event) GetTopic() *Topic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *Event) GetValues() []*Argument {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Event) GetTimeUnixNano() int64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+var File_als_proto protoreflect.FileDescriptor
+
+const file_als_proto_rawDesc = "" +
+	"\n" +
+	"\tals.proto\x12\x06als.v1\"\a\n" +
+	"\x05Empty\"\x1d\n" +
+	"\x05Index\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\"\x1d\n" +
+	"\x05Names\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"%\n" +
+	"\rJumpByRequest\x12\x14\n" +
+	"\x05beats\x18\x01 \x01(\x02R\x05beats\"\x84\a\n" +
+	"\x04Song\x12\x14\n" +
+	"\x05tempo\x18\x01 \x01(\x02R\x05tempo\x12\x1d\n" +
+	"\n" +
+	"is_playing\x18\x02 \x01(\bR\tisPlaying\x12*\n" +
+	"\x11current_song_time\x18\x03 \x01(\x02R\x0fcurrentSongTime\x12\x1c\n" +
+	"\tmetronome\x18\x04 \x01(\bR\tmetronome\x12\x12\n" +
+	"\x04loop\x18\x05 \x01(\bR\x04loop\x12\x1d\n" +
+	"\n" +
+	"loop_start\x18\x06 \x01(\x02R\tloopStart\x12\x1f\n" +
+	"\vloop_length\x18\a \x01(\x02R\n" +
+	"loopLength\x12\x1f\n" +
+	"\vrecord_mode\x18\b \x01(\bR\n" +
+	"recordMode\x12%\n" +
+	"\x0esession_record\x18\t \x01(\bR\rsessionRecord\x122\n" +
+	"\x15session_record_status\x18\n" +
+	" \x01(\x05R\x13sessionRecordStatus\x12/\n" +
+	"\x13arrangement_overdub\x18\v \x01(\bR\x12arrangementOverdub\x12(\n" +
+	"\x10back_to_arranger\x18\f \x01(\bR\x0ebackToArranger\x12\x19\n" +
+	"\bpunch_in\x18\r \x01(\bR\apunchIn\x12\x1b\n" +
+	"\tpunch_out\x18\x0e \x01(\bR\bpunchOut\x12#\n" +
+	"\rgroove_amount\x18\x0f \x01(\x02R\fgrooveAmount\x12/\n" +
+	"\x13signature_numerator\x18\x10 \x01(\x05R\x12signatureNumerator\x123\n" +
+	"\x15signature_denominator\x18\x11 \x01(\x05R\x14signatureDenominator\x12:\n" +
+	"\x19clip_trigger_quantization\x18\x12 \x01(\x05R\x17clipTriggerQuantization\x12>\n" +
+	"\x1bmidi_recording_quantization\x18\x13 \x01(\x05R\x19midiRecordingQuantization\x12\x1d\n" +
+	"\n" +
+	"num_tracks\x18\x14 \x01(\x05R\tnumTracks\x12\x1d\n" +
+	"\n" +
+	"num_scenes\x18\x15 \x01(\x05R\tnumScenes\x12\x1f\n" +
+	"\vsong_length\x18\x16 \x01(\x02R\n" +
+	"songLength\x12\x19\n" +
+	"\bcan_undo\x18\x17 \x01(\bR\acanUndo\x12\x19\n" +
+	"\bcan_redo\x18\x18 \x01(\bR\acanRedo\"\xba\b\n" +
+	"\n" +
+	"SongUpdate\x12\x19\n" +
+	"\x05tempo\x18\x01 \x01(\x02H\x00R\x05tempo\x88\x01\x01\x12/\n" +
+	"\x11current_song_time\x18\x03 \x01(\x02H\x01R\x0fcurrentSongTime\x88\x01\x01\x12!\n" +
+	"\tmetronome\x18\x04 \x01(\bH\x02R\tmetronome\x88\x01\x01\x12\x17\n" +
+	"\x04loop\x18\x05 \x01(\bH\x03R\x04loop\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"loop_start\x18\x06 \x01(\x02H\x04R\tloopStart\x88\x01\x01\x12$\n" +
+	"\vloop_length\x18\a \x01(\x02H\x05R\n" +
+	"loopLength\x88\x01\x01\x12$\n" +
+	"\vrecord_mode\x18\b \x01(\bH\x06R\n" +
+	"recordMode\x88\x01\x01\x12*\n" +
+	"\x0esession_record\x18\t \x01(\bH\aR\rsessionRecord\x88\x01\x01\x124\n" +
+	"\x13arrangement_overdub\x18\v \x01(\bH\bR\x12arrangementOverdub\x88\x01\x01\x12-\n" +
+	"\x10back_to_arranger\x18\f \x01(\bH\tR\x0ebackToArranger\x88\x01\x01\x12\x1e\n" +
+	"\bpunch_in\x18\r \x01(\bH\n" +
+	"R\apunchIn\x88\x01\x01\x12 \n" +
+	"\tpunch_out\x18\x0e \x01(\bH\vR\bpunchOut\x88\x01\x01\x12(\n" +
+	"\rgroove_amount\x18\x0f \x01(\x02H\fR\fgrooveAmount\x88\x01\x01\x124\n" +
+	"\x13signature_numerator\x18\x10 \x01(\x05H\rR\x12signatureNumerator\x88\x01\x01\x128\n" +
+	"\x15signature_denominator\x18\x11 \x01(\x05H\x0eR\x14signatureDenominator\x88\x01\x01\x12?\n" +
+	"\x19clip_trigger_quantization\x18\x12 \x01(\x05H\x0fR\x17clipTriggerQuantization\x88\x01\x01\x12C\n" +
+	"\x1bmidi_recording_quantization\x18\x13 \x01(\x05H\x10R\x19midiRecordingQuantization\x88\x01\x01B\b\n" +
+	"\x06_tempoB\x14\n" +
+	"\x12_current_song_timeB\f\n" +
+	"\n" +
+	"_metronomeB\a\n" +
+	"\x05_loopB\r\n" +
+	"\v_loop_startB\x0e\n" +
+	"\f_loop_lengthB\x0e\n" +
+	"\f_record_modeB\x11\n" +
+	"\x0f_session_recordB\x16\n" +
+	"\x14_arrangement_overdubB\x13\n" +
+	"\x11_back_to_arrangerB\v\n" +
+	"\t_punch_inB\f\n" +
+	"\n" +
+	"_punch_outB\x10\n" +
+	"\x0e_groove_amountB\x16\n" +
+	"\x14_signature_numeratorB\x18\n" +
+	"\x16_signature_denominatorB\x1c\n" +
+	"\x1a_clip_trigger_quantizationB\x1e\n" +
+	"\x1c_midi_recording_quantization\" \n" +
+	"\bTrackRef\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\"3\n" +
+	"\aSendRef\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04send\x18\x02 \x01(\x05R\x04send\"P\n" +
+	"\x0eSetSendRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04send\x18\x02 \x01(\x05R\x04send\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x02R\x05value\"\x1d\n" +
+	"\x05Value\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x02R\x05value\"\x97\b\n" +
+	"\x05Track\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mute\x18\x03 \x01(\bR\x04mute\x12\x12\n" +
+	"\x04solo\x18\x04 \x01(\bR\x04solo\x12\x10\n" +
+	"\x03arm\x18\x05 \x01(\bR\x03arm\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x02R\x06volume\x12\x18\n" +
+	"\apanning\x18\a \x01(\x02R\apanning\x12\x14\n" +
+	"\x05color\x18\b \x01(\x05R\x05color\x12\x1f\n" +
+	"\vcolor_index\x18\t \x01(\x05R\n" +
+	"colorIndex\x128\n" +
+	"\x18current_monitoring_state\x18\n" +
+	" \x01(\x05R\x16currentMonitoringState\x12\x1d\n" +
+	"\n" +
+	"fold_state\x18\v \x01(\bR\tfoldState\x12,\n" +
+	"\x12input_routing_type\x18\f \x01(\tR\x10inputRoutingType\x122\n" +
+	"\x15input_routing_channel\x18\r \x01(\tR\x13inputRoutingChannel\x12.\n" +
+	"\x13output_routing_type\x18\x0e \x01(\tR\x11outputRoutingType\x124\n" +
+	"\x16output_routing_channel\x18\x0f \x01(\tR\x14outputRoutingChannel\x12 \n" +
+	"\fcan_be_armed\x18\x10 \x01(\bR\n" +
+	"canBeArmed\x12&\n" +
+	"\x0fhas_audio_input\x18\x11 \x01(\bR\rhasAudioInput\x12(\n" +
+	"\x10has_audio_output\x18\x12 \x01(\bR\x0ehasAudioOutput\x12$\n" +
+	"\x0ehas_midi_input\x18\x13 \x01(\bR\fhasMidiInput\x12&\n" +
+	"\x0fhas_midi_output\x18\x14 \x01(\bR\rhasMidiOutput\x12\x1f\n" +
+	"\vis_foldable\x18\x15 \x01(\bR\n" +
+	"isFoldable\x12\x1d\n" +
+	"\n" +
+	"is_grouped\x18\x16 \x01(\bR\tisGrouped\x12\x1d\n" +
+	"\n" +
+	"is_visible\x18\x17 \x01(\bR\tisVisible\x12,\n" +
+	"\x12playing_slot_index\x18\x18 \x01(\x05R\x10playingSlotIndex\x12(\n" +
+	"\x10fired_slot_index\x18\x19 \x01(\x05R\x0efiredSlotIndex\x12*\n" +
+	"\x11output_meter_left\x18\x1a \x01(\x02R\x0foutputMeterLeft\x12,\n" +
+	"\x12output_meter_right\x18\x1b \x01(\x02R\x10outputMeterRight\x12,\n" +
+	"\x12output_meter_level\x18\x1c \x01(\x02R\x10outputMeterLevel\x12\x1f\n" +
+	"\vnum_devices\x18\x1d \x01(\x05R\n" +
+	"numDevices\"\xa5\x06\n" +
+	"\vTrackUpdate\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04mute\x18\x03 \x01(\bH\x01R\x04mute\x88\x01\x01\x12\x17\n" +
+	"\x04solo\x18\x04 \x01(\bH\x02R\x04solo\x88\x01\x01\x12\x15\n" +
+	"\x03arm\x18\x05 \x01(\bH\x03R\x03arm\x88\x01\x01\x12\x1b\n" +
+	"\x06volume\x18\x06 \x01(\x02H\x04R\x06volume\x88\x01\x01\x12\x1d\n" +
+	"\apanning\x18\a \x01(\x02H\x05R\apanning\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\b \x01(\x05H\x06R\x05color\x88\x01\x01\x12$\n" +
+	"\vcolor_index\x18\t \x01(\x05H\aR\n" +
+	"colorIndex\x88\x01\x01\x12=\n" +
+	"\x18current_monitoring_state\x18\n" +
+	" \x01(\x05H\bR\x16currentMonitoringState\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"fold_state\x18\v \x01(\bH\tR\tfoldState\x88\x01\x01\x121\n" +
+	"\x12input_routing_type\x18\f \x01(\tH\n" +
+	"R\x10inputRoutingType\x88\x01\x01\x127\n" +
+	"\x15input_routing_channel\x18\r \x01(\tH\vR\x13inputRoutingChannel\x88\x01\x01\x123\n" +
+	"\x13output_routing_type\x18\x0e \x01(\tH\fR\x11outputRoutingType\x88\x01\x01\x129\n" +
+	"\x16output_routing_channel\x18\x0f \x01(\tH\rR\x14outputRoutingChannel\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_muteB\a\n" +
+	"\x05_soloB\x06\n" +
+	"\x04_armB\t\n" +
+	"\a_volumeB\n" +
+	"\n" +
+	"\b_panningB\b\n" +
+	"\x06_colorB\x0e\n" +
+	"\f_color_indexB\x1b\n" +
+	"\x19_current_monitoring_stateB\r\n" +
+	"\v_fold_stateB\x15\n" +
+	"\x13_input_routing_typeB\x18\n" +
+	"\x16_input_routing_channelB\x16\n" +
+	"\x14_output_routing_typeB\x19\n" +
+	"\x17_output_routing_channel\"n\n" +
+	"\vClipSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x02R\x06length\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x05R\x05color\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x02R\tstartTime\":\n" +
+	"\rClipSummaries\x12)\n" +
+	"\x05clips\x18\x01 \x03(\v2\x13.als.v1.ClipSummaryR\x05clips\"V\n" +
+	"\rDeviceSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"class_name\x18\x03 \x01(\tR\tclassName\"B\n" +
+	"\x0fDeviceSummaries\x12/\n" +
+	"\adevices\x18\x01 \x03(\v2\x15.als.v1.DeviceSummaryR\adevices\"3\n" +
+	"\aClipRef\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\"\xae\x04\n" +
+	"\x04Clip\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\x05R\x05color\x12\x12\n" +
+	"\x04gain\x18\x05 \x01(\x02R\x04gain\x12\x16\n" +
+	"\x06length\x18\x06 \x01(\x02R\x06length\x12!\n" +
+	"\fpitch_coarse\x18\a \x01(\x05R\vpitchCoarse\x12\x1d\n" +
+	"\n" +
+	"pitch_fine\x18\b \x01(\x05R\tpitchFine\x12\x1b\n" +
+	"\tfile_path\x18\t \x01(\tR\bfilePath\x12\"\n" +
+	"\ris_audio_clip\x18\n" +
+	" \x01(\bR\visAudioClip\x12 \n" +
+	"\fis_midi_clip\x18\v \x01(\bR\n" +
+	"isMidiClip\x12\x1d\n" +
+	"\n" +
+	"is_playing\x18\f \x01(\bR\tisPlaying\x12!\n" +
+	"\fis_recording\x18\r \x01(\bR\visRecording\x12)\n" +
+	"\x10playing_position\x18\x0e \x01(\x02R\x0fplayingPosition\x12\x1d\n" +
+	"\n" +
+	"loop_start\x18\x0f \x01(\x02R\tloopStart\x12\x19\n" +
+	"\bloop_end\x18\x10 \x01(\x02R\aloopEnd\x12\x18\n" +
+	"\awarping\x18\x11 \x01(\bR\awarping\x12!\n" +
+	"\fstart_marker\x18\x12 \x01(\x02R\vstartMarker\x12\x1d\n" +
+	"\n" +
+	"end_marker\x18\x13 \x01(\x02R\tendMarker\"\x82\x04\n" +
+	"\n" +
+	"ClipUpdate\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\x05H\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04gain\x18\x05 \x01(\x02H\x02R\x04gain\x88\x01\x01\x12&\n" +
+	"\fpitch_coarse\x18\a \x01(\x05H\x03R\vpitchCoarse\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"pitch_fine\x18\b \x01(\x05H\x04R\tpitchFine\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"loop_start\x18\x0f \x01(\x02H\x05R\tloopStart\x88\x01\x01\x12\x1e\n" +
+	"\bloop_end\x18\x10 \x01(\x02H\x06R\aloopEnd\x88\x01\x01\x12\x1d\n" +
+	"\awarping\x18\x11 \x01(\bH\aR\awarping\x88\x01\x01\x12&\n" +
+	"\fstart_marker\x18\x12 \x01(\x02H\bR\vstartMarker\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"end_marker\x18\x13 \x01(\x02H\tR\tendMarker\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_gainB\x0f\n" +
+	"\r_pitch_coarseB\r\n" +
+	"\v_pitch_fineB\r\n" +
+	"\v_loop_startB\v\n" +
+	"\t_loop_endB\n" +
+	"\n" +
+	"\b_warpingB\x0f\n" +
+	"\r_start_markerB\r\n" +
+	"\v_end_marker\"\x87\x01\n" +
+	"\x04Note\x12\x14\n" +
+	"\x05pitch\x18\x01 \x01(\x05R\x05pitch\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x02R\tstartTime\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x02R\bduration\x12\x1a\n" +
+	"\bvelocity\x18\x04 \x01(\x05R\bvelocity\x12\x12\n" +
+	"\x04mute\x18\x05 \x01(\bR\x04mute\"+\n" +
+	"\x05Notes\x12\"\n" +
+	"\x05notes\x18\x01 \x03(\v2\f.als.v1.NoteR\x05notes\"\xb7\x01\n" +
+	"\x0fGetNotesRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x1f\n" +
+	"\vstart_pitch\x18\x03 \x01(\x05R\n" +
+	"startPitch\x12\x1d\n" +
+	"\n" +
+	"pitch_span\x18\x04 \x01(\x05R\tpitchSpan\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x02R\tstartTime\x12\x1b\n" +
+	"\ttime_span\x18\x06 \x01(\x02R\btimeSpan\"_\n" +
+	"\x0fAddNotesRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\"\n" +
+	"\x05notes\x18\x03 \x03(\v2\f.als.v1.NoteR\x05notes\"\xba\x01\n" +
+	"\x12RemoveNotesRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x1f\n" +
+	"\vstart_pitch\x18\x03 \x01(\x05R\n" +
+	"startPitch\x12\x1d\n" +
+	"\n" +
+	"pitch_span\x18\x04 \x01(\x05R\tpitchSpan\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x02R\tstartTime\x12\x1b\n" +
+	"\ttime_span\x18\x06 \x01(\x02R\btimeSpan\"w\n" +
+	"\bClipSlot\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x19\n" +
+	"\bhas_clip\x18\x03 \x01(\bR\ahasClip\x12&\n" +
+	"\x0fhas_stop_button\x18\x04 \x01(\bR\rhasStopButton\"{\n" +
+	"\x0eClipSlotUpdate\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12+\n" +
+	"\x0fhas_stop_button\x18\x04 \x01(\bH\x00R\rhasStopButton\x88\x01\x01B\x12\n" +
+	"\x10_has_stop_button\"U\n" +
+	"\x11CreateClipRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x12\n" +
+	"\x04clip\x18\x02 \x01(\x05R\x04clip\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x02R\x06length\"^\n" +
+	"\x16DuplicateClipToRequest\x12#\n" +
+	"\x04from\x18\x01 \x01(\v2\x0f.als.v1.ClipRefR\x04from\x12\x1f\n" +
+	"\x02to\x18\x02 \x01(\v2\x0f.als.v1.ClipRefR\x02to\" \n" +
+	"\bSceneRef\x12\x14\n" +
+	"\x05scene\x18\x01 \x01(\x05R\x05scene\"\x8f\x03\n" +
+	"\x05Scene\x12\x14\n" +
+	"\x05scene\x18\x01 \x01(\x05R\x05scene\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x05R\x05color\x12\x1f\n" +
+	"\vcolor_index\x18\x04 \x01(\x05R\n" +
+	"colorIndex\x12\x19\n" +
+	"\bis_empty\x18\x05 \x01(\bR\aisEmpty\x12!\n" +
+	"\fis_triggered\x18\x06 \x01(\bR\visTriggered\x12\x14\n" +
+	"\x05tempo\x18\a \x01(\x02R\x05tempo\x12#\n" +
+	"\rtempo_enabled\x18\b \x01(\bR\ftempoEnabled\x128\n" +
+	"\x18time_signature_numerator\x18\t \x01(\x05R\x16timeSignatureNumerator\x12<\n" +
+	"\x1atime_signature_denominator\x18\n" +
+	" \x01(\x05R\x18timeSignatureDenominator\x124\n" +
+	"\x16time_signature_enabled\x18\v \x01(\bR\x14timeSignatureEnabled\"\x95\x04\n" +
+	"\vSceneUpdate\x12\x14\n" +
+	"\x05scene\x18\x01 \x01(\x05R\x05scene\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\x05H\x01R\x05color\x88\x01\x01\x12$\n" +
+	"\vcolor_index\x18\x04 \x01(\x05H\x02R\n" +
+	"colorIndex\x88\x01\x01\x12\x19\n" +
+	"\x05tempo\x18\a \x01(\x02H\x03R\x05tempo\x88\x01\x01\x12(\n" +
+	"\rtempo_enabled\x18\b \x01(\bH\x04R\ftempoEnabled\x88\x01\x01\x12=\n" +
+	"\x18time_signature_numerator\x18\t \x01(\x05H\x05R\x16timeSignatureNumerator\x88\x01\x01\x12A\n" +
+	"\x1atime_signature_denominator\x18\n" +
+	" \x01(\x05H\x06R\x18timeSignatureDenominator\x88\x01\x01\x129\n" +
+	"\x16time_signature_enabled\x18\v \x01(\bH\aR\x14timeSignatureEnabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_colorB\x0e\n" +
+	"\f_color_indexB\b\n" +
+	"\x06_tempoB\x10\n" +
+	"\x0e_tempo_enabledB\x1b\n" +
+	"\x19_time_signature_numeratorB\x1d\n" +
+	"\x1b_time_signature_denominatorB\x19\n" +
+	"\x17_time_signature_enabled\"9\n" +
+	"\tDeviceRef\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\x05R\x06device\"Z\n" +
+	"\fParameterRef\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\x05R\x06device\x12\x1c\n" +
+	"\tparameter\x18\x03 \x01(\x05R\tparameter\"\xb0\x01\n" +
+	"\x06Device\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\x05R\x06device\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"class_name\x18\x04 \x01(\tR\tclassName\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x121\n" +
+	"\n" +
+	"parameters\x18\x06 \x03(\v2\x11.als.v1.ParameterR\n" +
+	"parameters\"\xbd\x01\n" +
+	"\tParameter\x12\x1c\n" +
+	"\tparameter\x18\x01 \x01(\x05R\tparameter\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x02R\x05value\x12\x10\n" +
+	"\x03min\x18\x04 \x01(\x02R\x03min\x12\x10\n" +
+	"\x03max\x18\x05 \x01(\x02R\x03max\x12!\n" +
+	"\fis_quantized\x18\x06 \x01(\bR\visQuantized\x12!\n" +
+	"\fvalue_string\x18\a \x01(\tR\vvalueString\"w\n" +
+	"\x13SetParameterRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\x05R\x06device\x12\x1c\n" +
+	"\tparameter\x18\x03 \x01(\x05R\tparameter\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x02R\x05value\"\\\n" +
+	"\x14SetParametersRequest\x12\x14\n" +
+	"\x05track\x18\x01 \x01(\x05R\x05track\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\x05R\x06device\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\x02R\x06values\"\xcb\x01\n" +
+	"\tSelection\x12%\n" +
+	"\x0eselected_track\x18\x01 \x01(\x05R\rselectedTrack\x12%\n" +
+	"\x0eselected_scene\x18\x02 \x01(\x05R\rselectedScene\x124\n" +
+	"\rselected_clip\x18\x03 \x01(\v2\x0f.als.v1.ClipRefR\fselectedClip\x12:\n" +
+	"\x0fselected_device\x18\x04 \x01(\v2\x11.als.v1.DeviceRefR\x0eselectedDevice\"\x9d\x01\n" +
+	"\rSelectRequest\x12\x16\n" +
+	"\x05track\x18\x01 \x01(\x05H\x00R\x05track\x12\x16\n" +
+	"\x05scene\x18\x02 \x01(\x05H\x00R\x05scene\x12%\n" +
+	"\x04clip\x18\x03 \x01(\v2\x0f.als.v1.ClipRefH\x00R\x04clip\x12+\n" +
+	"\x06device\x18\x04 \x01(\v2\x11.als.v1.DeviceRefH\x00R\x06deviceB\b\n" +
+	"\x06target\"U\n" +
+	"\x05Topic\x12\x16\n" +
+	"\x06object\x18\x01 \x01(\tR\x06object\x12\x1a\n" +
+	"\bproperty\x18\x02 \x01(\tR\bproperty\x12\x18\n" +
+	"\aindices\x18\x03 \x03(\x05R\aindices\"6\n" +
+	"\rListenRequest\x12%\n" +
+	"\x06topics\x18\x01 \x03(\v2\r.als.v1.TopicR\x06topics\"o\n" +
+	"\bArgument\x12\x16\n" +
+	"\x05float\x18\x01 \x01(\x02H\x00R\x05float\x12\x12\n" +
+	"\x03int\x18\x02 \x01(\x05H\x00R\x03int\x12\x18\n" +
+	"\x06string\x18\x03 \x01(\tH\x00R\x06string\x12\x14\n" +
+	"\x04bool\x18\x04 \x01(\bH\x00R\x04boolB\a\n" +
+	"\x05value\"|\n" +
+	"\x05Event\x12#\n" +
+	"\x05topic\x18\x01 \x01(\v2\r.als.v1.TopicR\x05topic\x12(\n" +
+	"\x06values\x18\x02 \x03(\v2\x10.als.v1.ArgumentR\x06values\x12$\n" +
+	"\x0etime_unix_nano\x18\x03 \x01(\x03R\ftimeUnixNano2\x93\t\n" +
+	"\vSongService\x12&\n" +
+	"\aGetSong\x12\r.als.v1.Empty\x1a\f.als.v1.Song\x12/\n" +
+	"\n" +
+	"UpdateSong\x12\x12.als.v1.SongUpdate\x1a\r.als.v1.Empty\x12*\n" +
+	"\n" +
+	"ListTracks\x12\r.als.v1.Empty\x1a\r.als.v1.Names\x12,\n" +
+	"\fStartPlaying\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12+\n" +
+	"\vStopPlaying\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12/\n" +
+	"\x0fContinuePlaying\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12,\n" +
+	"\fStopAllClips\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12(\n" +
+	"\bTapTempo\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12$\n" +
+	"\x04Undo\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12$\n" +
+	"\x04Redo\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12+\n" +
+	"\vCaptureMidi\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x124\n" +
+	"\x14TriggerSessionRecord\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12.\n" +
+	"\x06JumpBy\x12\x15.als.v1.JumpByRequest\x1a\r.als.v1.Empty\x12-\n" +
+	"\rJumpToNextCue\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12-\n" +
+	"\rJumpToPrevCue\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12.\n" +
+	"\x0eJumpToCuePoint\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x12/\n" +
+	"\x0fCreateMidiTrack\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x120\n" +
+	"\x10CreateAudioTrack\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x121\n" +
+	"\x11CreateReturnTrack\x12\r.als.v1.Empty\x1a\r.als.v1.Empty\x12+\n" +
+	"\vCreateScene\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x12+\n" +
+	"\vDeleteTrack\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x121\n" +
+	"\x11DeleteReturnTrack\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x12+\n" +
+	"\vDeleteScene\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x12.\n" +
+	"\x0eDuplicateTrack\x12\r.als.v1.Index\x1a\r.als.v1.Empty\x12.\n" +
+	"\x0eDuplicateScene\x12\r.als.v1.Index\x1a\r.als.v1.Empty2\xad\x03\n" +
+	"\fTrackService\x12+\n" +
+	"\bGetTrack\x12\x10.als.v1.TrackRef\x1a\r.als.v1.Track\x121\n" +
+	"\vUpdateTrack\x12\x13.als.v1.TrackUpdate\x1a\r.als.v1.Empty\x12/\n" +
+	"\fStopAllClips\x12\x10.als.v1.TrackRef\x1a\r.als.v1.Empty\x12)\n" +
+	"\aGetSend\x12\x0f.als.v1.SendRef\x1a\r.als.v1.Value\x120\n" +
+	"\aSetSend\x12\x16.als.v1.SetSendRequest\x1a\r.als.v1.Empty\x124\n" +
+	"\tListClips\x12\x10.als.v1.TrackRef\x1a\x15.als.v1.ClipSummaries\x12?\n" +
+	"\x14ListArrangementClips\x12\x10.als.v1.TrackRef\x1a\x15.als.v1.ClipSummaries\x128\n" +
+	"\vListDevices\x12\x10.als.v1.TrackRef\x1a\x17.als.v1.DeviceSummaries2\x8b\x03\n" +
+	"\vClipService\x12(\n" +
+	"\aGetClip\x12\x0f.als.v1.ClipRef\x1a\f.als.v1.Clip\x12/\n" +
+	"\n" +
+	"UpdateClip\x12\x12.als.v1.ClipUpdate\x1a\r.als.v1.Empty\x12&\n" +
+	"\x04Fire\x12\x0f.als.v1.ClipRef\x1a\r.als.v1.Empty\x12&\n" +
+	"\x04Stop\x12\x0f.als.v1.ClipRef\x1a\r.als.v1.Empty\x12/\n" +
+	"\rDuplicateLoop\x12\x0f.als.v1.ClipRef\x1a\r.als.v1.Empty\x122\n" +
+	"\bGetNotes\x12\x17.als.v1.GetNotesRequest\x1a\r.als.v1.Notes\x122\n" +
+	"\bAddNotes\x12\x17.als.v1.AddNotesRequest\x1a\r.als.v1.Empty\x128\n" +
+	"\vRemoveNotes\x12\x1a.als.v1.RemoveNotesRequest\x1a\r.als.v1.Empty2\xcc\x02\n" +
+	"\x0fClipSlotService\x120\n" +
+	"\vGetClipSlot\x12\x0f.als.v1.ClipRef\x1a\x10.als.v1.ClipSlot\x127\n" +
+	"\x0eUpdateClipSlot\x12\x16.als.v1.ClipSlotUpdate\x1a\r.als.v1.Empty\x12&\n" +
+	"\x04Fire\x12\x0f.als.v1.ClipRef\x1a\r.als.v1.Empty\x126\n" +
+	"\n" +
+	"CreateClip\x12\x19.als.v1.CreateClipRequest\x1a\r.als.v1.Empty\x12,\n" +
+	"\n" +
+	"DeleteClip\x12\x0f.als.v1.ClipRef\x1a\r.als.v1.Empty\x12@\n" +
+	"\x0fDuplicateClipTo\x12\x1e.als.v1.DuplicateClipToRequest\x1a\r.als.v1.Empty2\xf8\x01\n" +
+	"\fSceneService\x12+\n" +
+	"\bGetScene\x12\x10.als.v1.SceneRef\x1a\r.als.v1.Scene\x121\n" +
+	"\vUpdateScene\x12\x13.als.v1.SceneUpdate\x1a\r.als.v1.Empty\x12'\n" +
+	"\x04Fire\x12\x10.als.v1.SceneRef\x1a\r.als.v1.Empty\x121\n" +
+	"\x0eFireAsSelected\x12\x10.als.v1.SceneRef\x1a\r.als.v1.Empty\x12,\n" +
+	"\fFireSelected\x12\r.als.v1.Empty\x1a\r.als.v1.Empty2\xf2\x01\n" +
+	"\rDeviceService\x12.\n" +
+	"\tGetDevice\x12\x11.als.v1.DeviceRef\x1a\x0e.als.v1.Device\x127\n" +
+	"\fGetParameter\x12\x14.als.v1.ParameterRef\x1a\x11.als.v1.Parameter\x12:\n" +
+	"\fSetParameter\x12\x1b.als.v1.SetParameterRequest\x1a\r.als.v1.Empty\x12<\n" +
+	"\rSetParameters\x12\x1c.als.v1.SetParametersRequest\x1a\r.als.v1.Empty2o\n" +
+	"\vViewService\x120\n" +
+	"\fGetSelection\x12\r.als.v1.Empty\x1a\x11.als.v1.Selection\x12.\n" +
+	"\x06Select\x12\x15.als.v1.SelectRequest\x1a\r.als.v1.Empty2C\n" +
+	"\x0fListenerService\x120\n" +
+	"\x06Listen\x12\x15.als.v1.ListenRequest\x1a\r.als.v1.Event0\x01B5Z3github.com/matt0792/ableton-ctrl/alsrpc/alspb;alspbb\x06proto3"
+
+var (
+	file_als_proto_rawDescOnce sync.Once
+	file_als_proto_rawDescData []byte
+)
+
+func file_als_proto_rawDescGZIP() []byte {
+	file_als_proto_rawDescOnce.Do(func() {
+		file_als_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_als_proto_rawDesc), len(file_als_proto_rawDesc)))
+	})
+	return file_als_proto_rawDescData
+}
+
+var file_als_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_als_proto_goTypes = []any{
+	(*Empty)(nil),                  // 0: als.v1.Empty
+	(*Index)(nil),                  // 1: als.v1.Index
+	(*Names)(nil),                  // 2: als.v1.Names
+	(*JumpByRequest)(nil),          // 3: als.v1.JumpByRequest
+	(*Song)(nil),                   // 4: als.v1.Song
+	(*SongUpdate)(nil),             // 5: als.v1.SongUpdate
+	(*TrackRef)(nil),               // 6: als.v1.TrackRef
+	(*SendRef)(nil),                // 7: als.v1.SendRef
+	(*SetSendRequest)(nil),         // 8: als.v1.SetSendRequest
+	(*Value)(nil),                  // 9: als.v1.Value
+	(*Track)(nil),                  // 10: als.v1.Track
+	(*TrackUpdate)(nil),            // 11: als.v1.TrackUpdate
+	(*ClipSummary)(nil),            // 12: als.v1.ClipSummary
+	(*ClipSummaries)(nil),          // 13: als.v1.ClipSummaries
+	(*DeviceSummary)(nil),          // 14: als.v1.DeviceSummary
+	(*DeviceSummaries)(nil),        // 15: als.v1.DeviceSummaries
+	(*ClipRef)(nil),                // 16: als.v1.ClipRef
+	(*Clip)(nil),                   // 17: als.v1.Clip
+	(*ClipUpdate)(nil),             // 18: als.v1.ClipUpdate
+	(*Note)(nil),                   // 19: als.v1.Note
+	(*Notes)(nil),                  // 20: als.v1.Notes
+	(*GetNotesRequest)(nil),        // 21: als.v1.GetNotesRequest
+	(*AddNotesRequest)(nil),        // 22: als.v1.AddNotesRequest
+	(*RemoveNotesRequest)(nil),     // 23: als.v1.RemoveNotesRequest
+	(*ClipSlot)(nil),               // 24: als.v1.ClipSlot
+	(*ClipSlotUpdate)(nil),         // 25: als.v1.ClipSlotUpdate
+	(*CreateClipRequest)(nil),      // 26: als.v1.CreateClipRequest
+	(*DuplicateClipToRequest)(nil), // 27: als.v1.DuplicateClipToRequest
+	(*SceneRef)(nil),               // 28: als.v1.SceneRef
+	(*Scene)(nil),                  // 29: als.v1.Scene
+	(*SceneUpdate)(nil),            // 30: als.v1.SceneUpdate
+	(*DeviceRef)(nil),              // 31: als.v1.DeviceRef
+	(*ParameterRef)(nil),           // 32: als.v1.ParameterRef
+	(*Device)(nil),                 // 33: als.v1.Device
+	(*Parameter)(nil),              // 34: als.v1.Parameter
+	(*SetParameterRequest)(nil),    // 35: als.v1.SetParameterRequest
+	(*SetParametersRequest)(nil),   // 36: als.v1.SetParametersRequest
+	(*Selection)(nil),              // 37: als.v1.Selection
+	(*SelectRequest)(nil),          // 38: als.v1.SelectRequest
+	(*Topic)(nil),                  // 39: als.v1.Topic
+	(*ListenRequest)(nil),          // 40: als.v1.ListenRequest
+	(*Argument)(nil),               // 41: als.v1.Argument
+	(*Event)(nil),                  // 42: als.v1.Event
+}
+var file_als_proto_depIdxs = []int32{
+	12, // 0: als.v1.ClipSummaries.clips:type_name -> als.v1.ClipSummary
+	14, // 1: als.v1.DeviceSummaries.devices:type_name -> als.v1.DeviceSummary
+	19, // 2: als.v1.Notes.notes:type_name -> als.v1.Note
+	19, // 3: als.v1.AddNotesRequest.notes:type_name -> als.v1.Note
+	16, // 4: als.v1.DuplicateClipToRequest.from:type_name -> als.v1.ClipRef
+	16, // 5: als.v1.DuplicateClipToRequest.to:type_name -> als.v1.ClipRef
+	34, // 6: als.v1.Device.parameters:type_name -> als.v1.Parameter
+	16, // 7: als.v1.Selection.selected_clip:type_name -> als.v1.ClipRef
+	31, // 8: als.v1.Selection.selected_device:type_name -> als.v1.DeviceRef
+	16, // 9: als.v1.SelectRequest.clip:type_name -> als.v1.ClipRef
+	31, // 10: als.v1.SelectRequest.device:type_name -> als.v1.DeviceRef
+	39, // 11: als.v1.ListenRequest.topics:type_name -> als.v1.Topic
+	39, // 12: als.v1.Event.topic:type_name -> als.v1.Topic
+	41, // 13: als.v1.Event.values:type_name -> als.v1.Argument
+	0,  // 14: als.v1.SongService.GetSong:input_type -> als.v1.Empty
+	5,  // 15: als.v1.SongService.UpdateSong:input_type -> als.v1.SongUpdate
+	0,  // 16: als.v1.SongService.ListTracks:input_type -> als.v1.Empty
+	0,  // 17: als.v1.SongService.StartPlaying:input_type -> als.v1.Empty
+	0,  // 18: als.v1.SongService.StopPlaying:input_type -> als.v1.Empty
+	0,  // 19: als.v1.SongService.ContinuePlaying:input_type -> als.v1.Empty
+	0,  // 20: als.v1.SongService.StopAllClips:input_type -> als.v1.Empty
+	0,  // 21: als.v1.SongService.TapTempo:input_type -> als.v1.Empty
+	0,  // 22: als.v1.SongService.Undo:input_type -> als.v1.Empty
+	0,  // 23: als.v1.SongService.Redo:input_type -> als.v1.Empty
+	0,  // 24: als.v1.SongService.CaptureMidi:input_type -> als.v1.Empty
+	0,  // 25: als.v1.SongService.TriggerSessionRecord:input_type -> als.v1.Empty
+	3,  // 26: als.v1.SongService.JumpBy:input_type -> als.v1.JumpByRequest
+	0,  // 27: als.v1.SongService.JumpToNextCue:input_type -> als.v1.Empty
+	0,  // 28: als.v1.SongService.JumpToPrevCue:input_type -> als.v1.Empty
+	1,  // 29: als.v1.SongService.JumpToCuePoint:input_type -> als.v1.Index
+	1,  // 30: als.v1.SongService.CreateMidiTrack:input_type -> als.v1.Index
+	1,  // 31: als.v1.SongService.CreateAudioTrack:input_type -> als.v1.Index
+	0,  // 32: als.v1.SongService.CreateReturnTrack:input_type -> als.v1.Empty
+	1,  // 33: als.v1.SongService.CreateScene:input_type -> als.v1.Index
+	1,  // 34: als.v1.SongService.DeleteTrack:input_type -> als.v1.Index
+	1,  // 35: als.v1.SongService.DeleteReturnTrack:input_type -> als.v1.Index
+	1,  // 36: als.v1.SongService.DeleteScene:input_type -> als.v1.Index
+	1,  // 37: als.v1.SongService.DuplicateTrack:input_type -> als.v1.Index
+	1,  // 38: als.v1.SongService.DuplicateScene:input_type -> als.v1.Index
+	6,  // 39: als.v1.TrackService.GetTrack:input_type -> als.v1.TrackRef
+	11, // 40: als.v1.TrackService.UpdateTrack:input_type -> als.v1.TrackUpdate
+	6,  // 41: als.v1.TrackService.StopAllClips:input_type -> als.v1.TrackRef
+	7,  // 42: als.v1.TrackService.GetSend:input_type -> als.v1.SendRef
+	8,  // 43: als.v1.TrackService.SetSend:input_type -> als.v1.SetSendRequest
+	6,  // 44: als.v1.TrackService.ListClips:input_type -> als.v1.TrackRef
+	6,  // 45: als.v1.TrackService.ListArrangementClips:input_type -> als.v1.TrackRef
+	6,  // 46: als.v1.TrackService.ListDevices:input_type -> als.v1.TrackRef
+	16, // 47: als.v1.ClipService.GetClip:input_type -> als.v1.ClipRef
+	18, // 48: als.v1.ClipService.UpdateClip:input_type -> als.v1.ClipUpdate
+	16, // 49: als.v1.ClipService.Fire:input_type -> als.v1.ClipRef
+	16, // 50: als.v1.ClipService.Stop:input_type -> als.v1.ClipRef
+	16, // 51: als.v1.ClipService.DuplicateLoop:input_type -> als.v1.ClipRef
+	21, // 52: als.v1.ClipService.GetNotes:input_type -> als.v1.GetNotesRequest
+	22, // 53: als.v1.ClipService.AddNotes:input_type -> als.v1.AddNotesRequest
+	23, // 54: als.v1.ClipService.RemoveNotes:input_type -> als.v1.RemoveNotesRequest
+	16, // 55: als.v1.ClipSlotService.GetClipSlot:input_type -> als.v1.ClipRef
+	25, // 56: als.v1.ClipSlotService.UpdateClipSlot:input_type -> als.v1.ClipSlotUpdate
+	16, // 57: als.v1.ClipSlotService.Fire:input_type -> als.v1.ClipRef
+	26, // 58: als.v1.ClipSlotService.CreateClip:input_type -> als.v1.CreateClipRequest
+	16, // 59: als.v1.ClipSlotService.DeleteClip:input_type -> als.v1.ClipRef
+	27, // 60: als.v1.ClipSlotService.DuplicateClipTo:input_type -> als.v1.DuplicateClipToRequest
+	28, // 61: als.v1.SceneService.GetScene:input_type -> als.v1.SceneRef
+	30, // 62: als.v1.SceneService.UpdateScene:input_type -> als.v1.SceneUpdate
+	28, // 63: als.v1.SceneService.Fire:input_type -> als.v1.SceneRef
+	28, // 64: als.v1.SceneService.FireAsSelected:input_type -> als.v1.SceneRef
+	0,  // 65: als.v1.SceneService.FireSelected:input_type -> als.v1.Empty
+	31, // 66: als.v1.DeviceService.GetDevice:input_type -> als.v1.DeviceRef
+	32, // 67: als.v1.DeviceService.GetParameter:input_type -> als.v1.ParameterRef
+	35, // 68: als.v1.DeviceService.SetParameter:input_type -> als.v1.SetParameterRequest
+	36, // 69: als.v1.DeviceService.SetParameters:input_type -> als.v1.SetParametersRequest
+	0,  // 70: als.v1.ViewService.GetSelection:input_type -> als.v1.Empty
+	38, // 71: als.v1.ViewService.Select:input_type -> als.v1.SelectRequest
+	40, // 72: als.v1.ListenerService.Listen:input_type -> als.v1.ListenRequest
+	4,  // 73: als.v1.SongService.GetSong:output_type -> als.v1.Song
+	0,  // 74: als.v1.SongService.UpdateSong:output_type -> als.v1.Empty
+	2,  // 75: als.v1.SongService.ListTracks:output_type -> als.v1.Names
+	0,  // 76: als.v1.SongService.StartPlaying:output_type -> als.v1.Empty
+	0,  // 77: als.v1.SongService.StopPlaying:output_type -> als.v1.Empty
+	0,  // 78: als.v1.SongService.ContinuePlaying:output_type -> als.v1.Empty
+	0,  // 79: als.v1.SongService.StopAllClips:output_type -> als.v1.Empty
+	0,  // 80: als.v1.SongService.TapTempo:output_type -> als.v1.Empty
+	0,  // 81: als.v1.SongService.Undo:output_type -> als.v1.Empty
+	0,  // 82: als.v1.SongService.Redo:output_type -> als.v1.Empty
+	0,  // 83: als.v1.SongService.CaptureMidi:output_type -> als.v1.Empty
+	0,  // 84: als.v1.SongService.TriggerSessionRecord:output_type -> als.v1.Empty
+	0,  // 85: als.v1.SongService.JumpBy:output_type -> als.v1.Empty
+	0,  // 86: als.v1.SongService.JumpToNextCue:output_type -> als.v1.Empty
+	0,  // 87: als.v1.SongService.JumpToPrevCue:output_type -> als.v1.Empty
+	0,  // 88: als.v1.SongService.JumpToCuePoint:output_type -> als.v1.Empty
+	0,  // 89: als.v1.SongService.CreateMidiTrack:output_type -> als.v1.Empty
+	0,  // 90: als.v1.SongService.CreateAudioTrack:output_type -> als.v1.Empty
+	0,  // 91: als.v1.SongService.CreateReturnTrack:output_type -> als.v1.Empty
+	0,  // 92: als.v1.SongService.CreateScene:output_type -> als.v1.Empty
+	0,  // 93: als.v1.SongService.DeleteTrack:output_type -> als.v1.Empty
+	0,  // 94: als.v1.SongService.DeleteReturnTrack:output_type -> als.v1.Empty
+	0,  // 95: als.v1.SongService.DeleteScene:output_type -> als.v1.Empty
+	0,  // 96: als.v1.SongService.DuplicateTrack:output_type -> als.v1.Empty
+	0,  // 97: als.v1.SongService.DuplicateScene:output_type -> als.v1.Empty
+	10, // 98: als.v1.TrackService.GetTrack:output_type -> als.v1.Track
+	0,  // 99: als.v1.TrackService.UpdateTrack:output_type -> als.v1.Empty
+	0,  // 100: als.v1.TrackService.StopAllClips:output_type -> als.v1.Empty
+	9,  // 101: als.v1.TrackService.GetSend:output_type -> als.v1.Value
+	0,  // 102: als.v1.TrackService.SetSend:output_type -> als.v1.Empty
+	13, // 103: als.v1.TrackService.ListClips:output_type -> als.v1.ClipSummaries
+	13, // 104: als.v1.TrackService.ListArrangementClips:output_type -> als.v1.ClipSummaries
+	15, // 105: als.v1.TrackService.ListDevices:output_type -> als.v1.DeviceSummaries
+	17, // 106: als.v1.ClipService.GetClip:output_type -> als.v1.Clip
+	0,  // 107: als.v1.ClipService.UpdateClip:output_type -> als.v1.Empty
+	0,  // 108: als.v1.ClipService.Fire:output_type -> als.v1.Empty
+	0,  // 109: als.v1.ClipService.Stop:output_type -> als.v1.Empty
+	0,  // 110: als.v1.ClipService.DuplicateLoop:output_type -> als.v1.Empty
+	20, // 111: als.v1.ClipService.GetNotes:output_type -> als.v1.Notes
+	0,  // 112: als.v1.ClipService.AddNotes:output_type -> als.v1.Empty
+	0,  // 113: als.v1.ClipService.RemoveNotes:output_type -> als.v1.Empty
+	24, // 114: als.v1.ClipSlotService.GetClipSlot:output_type -> als.v1.ClipSlot
+	0,  // 115: als.v1.ClipSlotService.UpdateClipSlot:output_type -> als.v1.Empty
+	0,  // 116: als.v1.ClipSlotService.Fire:output_type -> als.v1.Empty
+	0,  // 117: als.v1.ClipSlotService.CreateClip:output_type -> als.v1.Empty
+	0,  // 118: als.v1.ClipSlotService.DeleteClip:output_type -> als.v1.Empty
+	0,  // 119: als.v1.ClipSlotService.DuplicateClipTo:output_type -> als.v1.Empty
+	29, // 120: als.v1.SceneService.GetScene:output_type -> als.v1.Scene
+	0,  // 121: als.v1.SceneService.UpdateScene:output_type -> als.v1.Empty
+	0,  // 122: als.v1.SceneService.Fire:output_type -> als.v1.Empty
+	0,  // 123: als.v1.SceneService.FireAsSelected:output_type -> als.v1.Empty
+	0,  // 124: als.v1.SceneService.FireSelected:output_type -> als.v1.Empty
+	33, // 125: als.v1.DeviceService.GetDevice:output_type -> als.v1.Device
+	34, // 126: als.v1.DeviceService.GetParameter:output_type -> als.v1.Parameter
+	0,  // 127: als.v1.DeviceService.SetParameter:output_type -> als.v1.Empty
+	0,  // 128: als.v1.DeviceService.SetParameters:output_type -> als.v1.Empty
+	37, // 129: als.v1.ViewService.GetSelection:output_type -> als.v1.Selection
+	0,  // 130: als.v1.ViewService.Select:output_type -> als.v1.Empty
+	42, // 131: als.v1.ListenerService.Listen:output_type -> als.v1.Event
+	73, // [73:132] is the sub-list for method output_type
+	14, // [14:73] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_als_proto_init() }
+func file_als_proto_init() {
+	if File_als_proto != nil {
+		return
+	}
+	file_als_proto_msgTypes[5].OneofWrappers = []any{}
+	file_als_proto_msgTypes[11].OneofWrappers = []any{}
+	file_als_proto_msgTypes[18].OneofWrappers = []any{}
+	file_als_proto_msgTypes[25].OneofWrappers = []any{}
+	file_als_proto_msgTypes[30].OneofWrappers = []any{}
+	file_als_proto_msgTypes[38].OneofWrappers = []any{
+		(*SelectRequest_Track)(nil),
+		(*SelectRequest_Scene)(nil),
+		(*SelectRequest_Clip)(nil),
+		(*SelectRequest_Device)(nil),
+	}
+	file_als_proto_msgTypes[41].OneofWrappers = []any{
+		(*Argument_Float)(nil),
+		(*Argument_Int)(nil),
+		(*Argument_String_)(nil),
+		(*Argument_Bool)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_als_proto_rawDesc), len(file_als_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   8,
+		},
+		GoTypes:           file_als_proto_goTypes,
+		DependencyIndexes: file_als_proto_depIdxs,
+		MessageInfos:      file_als_proto_msgTypes,
+	}.Build()
+	File_als_proto = out.File
+	file_als_proto_goTypes = nil
+	file_als_proto_depIdxs = nil
+}
@@ -0,0 +1,2787 @@
+// Remote control of Ableton Live, mirroring the als package. Each service
+// matches one of the als APIs: getters are grouped into a single Get that
+// returns the object's state, setters into an Update where only the fields
+// that are set change, and actions are RPCs of their own.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v5.29.3
+// source: als.proto
+
+package alspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SongService_GetSong_FullMethodName              = "/als.v1.SongService/GetSong"
+	SongService_UpdateSong_FullMethodName           = "/als.v1.SongService/UpdateSong"
+	SongService_ListTracks_FullMethodName           = "/als.v1.SongService/ListTracks"
+	SongService_StartPlaying_FullMethodName         = "/als.v1.SongService/StartPlaying"
+	SongService_StopPlaying_FullMethodName          = "/als.v1.SongService/StopPlaying"
+	SongService_ContinuePlaying_FullMethodName      = "/als.v1.SongService/ContinuePlaying"
+	SongService_StopAllClips_FullMethodName         = "/als.v1.SongService/StopAllClips"
+	SongService_TapTempo_FullMethodName             = "/als.v1.SongService/TapTempo"
+	SongService_Undo_FullMethodName                 = "/als.v1.SongService/Undo"
+	SongService_Redo_FullMethodName                 = "/als.v1.SongService/Redo"
+	SongService_CaptureMidi_FullMethodName          = "/als.v1.SongService/CaptureMidi"
+	SongService_TriggerSessionRecord_FullMethodName = "/als.v1.SongService/TriggerSessionRecord"
+	SongService_JumpBy_FullMethodName               = "/als.v1.SongService/JumpBy"
+	SongService_JumpToNextCue_FullMethodName        = "/als.v1.SongService/JumpToNextCue"
+	SongService_JumpToPrevCue_FullMethodName        = "/als.v1.SongService/JumpToPrevCue"
+	SongService_JumpToCuePoint_FullMethodName       = "/als.v1.SongService/JumpToCuePoint"
+	SongService_CreateMidiTrack_FullMethodName      = "/als.v1.SongService/CreateMidiTrack"
+	SongService_CreateAudioTrack_FullMethodName     = "/als.v1.SongService/CreateAudioTrack"
+	SongService_CreateReturnTrack_FullMethodName    = "/als.v1.SongService/CreateReturnTrack"
+	SongService_CreateScene_FullMethodName          = "/als.v1.SongService/CreateScene"
+	SongService_DeleteTrack_FullMethodName          = "/als.v1.SongService/DeleteTrack"
+	SongService_DeleteReturnTrack_FullMethodName    = "/als.v1.SongService/DeleteReturnTrack"
+	SongService_DeleteScene_FullMethodName          = "/als.v1.SongService/DeleteScene"
+	SongService_DuplicateTrack_FullMethodName       = "/als.v1.SongService/DuplicateTrack"
+	SongService_DuplicateScene_FullMethodName       = "/als.v1.SongService/DuplicateScene"
+)
+
+// SongServiceClient is the client API for SongService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SongServiceClient interface {
+	GetSong(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Song, error)
+	UpdateSong(ctx context.Context, in *SongUpdate, opts ...grpc.CallOption) (*Empty, error)
+	ListTracks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Names, error)
+	StartPlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	StopPlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ContinuePlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	StopAllClips(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	TapTempo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	Undo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	Redo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	CaptureMidi(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	TriggerSessionRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	JumpBy(ctx context.Context, in *JumpByRequest, opts ...grpc.CallOption) (*Empty, error)
+	JumpToNextCue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	JumpToPrevCue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	JumpToCuePoint(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	CreateMidiTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	CreateAudioTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	CreateReturnTrack(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	CreateScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	DeleteTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	DeleteReturnTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	DeleteScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	DuplicateTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+	DuplicateScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type songServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSongServiceClient(cc grpc.ClientConnInterface) SongServiceClient {
+	return &songServiceClient{cc}
+}
+
+func (c *songServiceClient) GetSong(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_GetSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UpdateSong(ctx context.Context, in *SongUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_UpdateSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ListTracks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Names, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Names)
+	err := c.cc.Invoke(ctx, SongService_ListTracks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) StartPlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_StartPlaying_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) StopPlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_StopPlaying_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ContinuePlaying(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_ContinuePlaying_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) StopAllClips(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_StopAllClips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) TapTempo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_TapTempo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) Undo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_Undo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) Redo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_Redo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CaptureMidi(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_CaptureMidi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) TriggerSessionRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_TriggerSessionRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) JumpBy(ctx context.Context, in *JumpByRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_JumpBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) JumpToNextCue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_JumpToNextCue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) JumpToPrevCue(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_JumpToPrevCue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) JumpToCuePoint(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_JumpToCuePoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CreateMidiTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_CreateMidiTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CreateAudioTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_CreateAudioTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CreateReturnTrack(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_CreateReturnTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CreateScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_CreateScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DeleteTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_DeleteTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DeleteReturnTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_DeleteReturnTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DeleteScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_DeleteScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DuplicateTrack(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_DuplicateTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DuplicateScene(ctx context.Context, in *Index, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SongService_DuplicateScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SongServiceServer is the server API for SongService service.
+// All implementations must embed UnimplementedSongServiceServer
+// for forward compatibility.
+type SongServiceServer interface {
+	GetSong(context.Context, *Empty) (*Song, error)
+	UpdateSong(context.Context, *SongUpdate) (*Empty, error)
+	ListTracks(context.Context, *Empty) (*Names, error)
+	StartPlaying(context.Context, *Empty) (*Empty, error)
+	StopPlaying(context.Context, *Empty) (*Empty, error)
+	ContinuePlaying(context.Context, *Empty) (*Empty, error)
+	StopAllClips(context.Context, *Empty) (*Empty, error)
+	TapTempo(context.Context, *Empty) (*Empty, error)
+	Undo(context.Context, *Empty) (*Empty, error)
+	Redo(context.Context, *Empty) (*Empty, error)
+	CaptureMidi(context.Context, *Empty) (*Empty, error)
+	TriggerSessionRecord(context.Context, *Empty) (*Empty, error)
+	JumpBy(context.Context, *JumpByRequest) (*Empty, error)
+	JumpToNextCue(context.Context, *Empty) (*Empty, error)
+	JumpToPrevCue(context.Context, *Empty) (*Empty, error)
+	JumpToCuePoint(context.Context, *Index) (*Empty, error)
+	CreateMidiTrack(context.Context, *Index) (*Empty, error)
+	CreateAudioTrack(context.Context, *Index) (*Empty, error)
+	CreateReturnTrack(context.Context, *Empty) (*Empty, error)
+	CreateScene(context.Context, *Index) (*Empty, error)
+	DeleteTrack(context.Context, *Index) (*Empty, error)
+	DeleteReturnTrack(context.Context, *Index) (*Empty, error)
+	DeleteScene(context.Context, *Index) (*Empty, error)
+	DuplicateTrack(context.Context, *Index) (*Empty, error)
+	DuplicateScene(context.Context, *Index) (*Empty, error)
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+// UnimplementedSongServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSongServiceServer struct{}
+
+func (UnimplementedSongServiceServer) GetSong(context.Context, *Empty) (*Song, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSong not implemented")
+}
+func (UnimplementedSongServiceServer) UpdateSong(context.Context, *SongUpdate) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSong not implemented")
+}
+func (UnimplementedSongServiceServer) ListTracks(context.Context, *Empty) (*Names, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTracks not implemented")
+}
+func (UnimplementedSongServiceServer) StartPlaying(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPlaying not implemented")
+}
+func (UnimplementedSongServiceServer) StopPlaying(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopPlaying not implemented")
+}
+func (UnimplementedSongServiceServer) ContinuePlaying(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ContinuePlaying not implemented")
+}
+func (UnimplementedSongServiceServer) StopAllClips(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopAllClips not implemented")
+}
+func (UnimplementedSongServiceServer) TapTempo(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method TapTempo not implemented")
+}
+func (UnimplementedSongServiceServer) Undo(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedSongServiceServer) Redo(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Redo not implemented")
+}
+func (UnimplementedSongServiceServer) CaptureMidi(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CaptureMidi not implemented")
+}
+func (UnimplementedSongServiceServer) TriggerSessionRecord(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerSessionRecord not implemented")
+}
+func (UnimplementedSongServiceServer) JumpBy(context.Context, *JumpByRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method JumpBy not implemented")
+}
+func (UnimplementedSongServiceServer) JumpToNextCue(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method JumpToNextCue not implemented")
+}
+func (UnimplementedSongServiceServer) JumpToPrevCue(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method JumpToPrevCue not implemented")
+}
+func (UnimplementedSongServiceServer) JumpToCuePoint(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method JumpToCuePoint not implemented")
+}
+func (UnimplementedSongServiceServer) CreateMidiTrack(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMidiTrack not implemented")
+}
+func (UnimplementedSongServiceServer) CreateAudioTrack(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAudioTrack not implemented")
+}
+func (UnimplementedSongServiceServer) CreateReturnTrack(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReturnTrack not implemented")
+}
+func (UnimplementedSongServiceServer) CreateScene(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateScene not implemented")
+}
+func (UnimplementedSongServiceServer) DeleteTrack(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTrack not implemented")
+}
+func (UnimplementedSongServiceServer) DeleteReturnTrack(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReturnTrack not implemented")
+}
+func (UnimplementedSongServiceServer) DeleteScene(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteScene not implemented")
+}
+func (UnimplementedSongServiceServer) DuplicateTrack(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateTrack not implemented")
+}
+func (UnimplementedSongServiceServer) DuplicateScene(context.Context, *Index) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateScene not implemented")
+}
+func (UnimplementedSongServiceServer) mustEmbedUnimplementedSongServiceServer() {}
+func (UnimplementedSongServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSongServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SongServiceServer will
+// result in compilation errors.
+type UnsafeSongServiceServer interface {
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+func RegisterSongServiceServer(s grpc.ServiceRegistrar, srv SongServiceServer) {
+	// If the following call panics, it indicates UnimplementedSongServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SongService_ServiceDesc, srv)
+}
+
+func _SongService_GetSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetSong(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).UpdateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_UpdateSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).UpdateSong(ctx, req.(*SongUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ListTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ListTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ListTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ListTracks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_StartPlaying_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).StartPlaying(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_StartPlaying_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).StartPlaying(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_StopPlaying_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).StopPlaying(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_StopPlaying_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).StopPlaying(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ContinuePlaying_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ContinuePlaying(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ContinuePlaying_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ContinuePlaying(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_StopAllClips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).StopAllClips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_StopAllClips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).StopAllClips(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_TapTempo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).TapTempo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_TapTempo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).TapTempo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_Undo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).Undo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_Redo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).Redo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CaptureMidi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CaptureMidi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CaptureMidi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CaptureMidi(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_TriggerSessionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).TriggerSessionRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_TriggerSessionRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).TriggerSessionRecord(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_JumpBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JumpByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).JumpBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_JumpBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).JumpBy(ctx, req.(*JumpByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_JumpToNextCue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).JumpToNextCue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_JumpToNextCue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).JumpToNextCue(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_JumpToPrevCue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).JumpToPrevCue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_JumpToPrevCue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).JumpToPrevCue(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_JumpToCuePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).JumpToCuePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_JumpToCuePoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).JumpToCuePoint(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CreateMidiTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateMidiTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateMidiTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateMidiTrack(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CreateAudioTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateAudioTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateAudioTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateAudioTrack(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CreateReturnTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateReturnTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateReturnTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateReturnTrack(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CreateScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateScene(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DeleteTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DeleteTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DeleteTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DeleteTrack(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DeleteReturnTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DeleteReturnTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DeleteReturnTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DeleteReturnTrack(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DeleteScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DeleteScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DeleteScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DeleteScene(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DuplicateTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DuplicateTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DuplicateTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DuplicateTrack(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DuplicateScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Index)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DuplicateScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DuplicateScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DuplicateScene(ctx, req.(*Index))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SongService_ServiceDesc is the grpc.ServiceDesc for SongService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SongService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.SongService",
+	HandlerType: (*SongServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSong",
+			Handler:    _SongService_GetSong_Handler,
+		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _SongService_UpdateSong_Handler,
+		},
+		{
+			MethodName: "ListTracks",
+			Handler:    _SongService_ListTracks_Handler,
+		},
+		{
+			MethodName: "StartPlaying",
+			Handler:    _SongService_StartPlaying_Handler,
+		},
+		{
+			MethodName: "StopPlaying",
+			Handler:    _SongService_StopPlaying_Handler,
+		},
+		{
+			MethodName: "ContinuePlaying",
+			Handler:    _SongService_ContinuePlaying_Handler,
+		},
+		{
+			MethodName: "StopAllClips",
+			Handler:    _SongService_StopAllClips_Handler,
+		},
+		{
+			MethodName: "TapTempo",
+			Handler:    _SongService_TapTempo_Handler,
+		},
+		{
+			MethodName: "Undo",
+			Handler:    _SongService_Undo_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _SongService_Redo_Handler,
+		},
+		{
+			MethodName: "CaptureMidi",
+			Handler:    _SongService_CaptureMidi_Handler,
+		},
+		{
+			MethodName: "TriggerSessionRecord",
+			Handler:    _SongService_TriggerSessionRecord_Handler,
+		},
+		{
+			MethodName: "JumpBy",
+			Handler:    _SongService_JumpBy_Handler,
+		},
+		{
+			MethodName: "JumpToNextCue",
+			Handler:    _SongService_JumpToNextCue_Handler,
+		},
+		{
+			MethodName: "JumpToPrevCue",
+			Handler:    _SongService_JumpToPrevCue_Handler,
+		},
+		{
+			MethodName: "JumpToCuePoint",
+			Handler:    _SongService_JumpToCuePoint_Handler,
+		},
+		{
+			MethodName: "CreateMidiTrack",
+			Handler:    _SongService_CreateMidiTrack_Handler,
+		},
+		{
+			MethodName: "CreateAudioTrack",
+			Handler:    _SongService_CreateAudioTrack_Handler,
+		},
+		{
+			MethodName: "CreateReturnTrack",
+			Handler:    _SongService_CreateReturnTrack_Handler,
+		},
+		{
+			MethodName: "CreateScene",
+			Handler:    _SongService_CreateScene_Handler,
+		},
+		{
+			MethodName: "DeleteTrack",
+			Handler:    _SongService_DeleteTrack_Handler,
+		},
+		{
+			MethodName: "DeleteReturnTrack",
+			Handler:    _SongService_DeleteReturnTrack_Handler,
+		},
+		{
+			MethodName: "DeleteScene",
+			Handler:    _SongService_DeleteScene_Handler,
+		},
+		{
+			MethodName: "DuplicateTrack",
+			Handler:    _SongService_DuplicateTrack_Handler,
+		},
+		{
+			MethodName: "DuplicateScene",
+			Handler:    _SongService_DuplicateScene_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	TrackService_GetTrack_FullMethodName             = "/als.v1.TrackService/GetTrack"
+	TrackService_UpdateTrack_FullMethodName          = "/als.v1.TrackService/UpdateTrack"
+	TrackService_StopAllClips_FullMethodName         = "/als.v1.TrackService/StopAllClips"
+	TrackService_GetSend_FullMethodName              = "/als.v1.TrackService/GetSend"
+	TrackService_SetSend_FullMethodName              = "/als.v1.TrackService/SetSend"
+	TrackService_ListClips_FullMethodName            = "/als.v1.TrackService/ListClips"
+	TrackService_ListArrangementClips_FullMethodName = "/als.v1.TrackService/ListArrangementClips"
+	TrackService_ListDevices_FullMethodName          = "/als.v1.TrackService/ListDevices"
+)
+
+// TrackServiceClient is the client API for TrackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrackServiceClient interface {
+	GetTrack(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*Track, error)
+	UpdateTrack(ctx context.Context, in *TrackUpdate, opts ...grpc.CallOption) (*Empty, error)
+	StopAllClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*Empty, error)
+	GetSend(ctx context.Context, in *SendRef, opts ...grpc.CallOption) (*Value, error)
+	SetSend(ctx context.Context, in *SetSendRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*ClipSummaries, error)
+	ListArrangementClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*ClipSummaries, error)
+	ListDevices(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*DeviceSummaries, error)
+}
+
+type trackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrackServiceClient(cc grpc.ClientConnInterface) TrackServiceClient {
+	return &trackServiceClient{cc}
+}
+
+func (c *trackServiceClient) GetTrack(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*Track, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Track)
+	err := c.cc.Invoke(ctx, TrackService_GetTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) UpdateTrack(ctx context.Context, in *TrackUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TrackService_UpdateTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) StopAllClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TrackService_StopAllClips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) GetSend(ctx context.Context, in *SendRef, opts ...grpc.CallOption) (*Value, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Value)
+	err := c.cc.Invoke(ctx, TrackService_GetSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) SetSend(ctx context.Context, in *SetSendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, TrackService_SetSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*ClipSummaries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClipSummaries)
+	err := c.cc.Invoke(ctx, TrackService_ListClips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListArrangementClips(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*ClipSummaries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClipSummaries)
+	err := c.cc.Invoke(ctx, TrackService_ListArrangementClips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListDevices(ctx context.Context, in *TrackRef, opts ...grpc.CallOption) (*DeviceSummaries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceSummaries)
+	err := c.cc.Invoke(ctx, TrackService_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackServiceServer is the server API for TrackService service.
+// All implementations must embed UnimplementedTrackServiceServer
+// for forward compatibility.
+type TrackServiceServer interface {
+	GetTrack(context.Context, *TrackRef) (*Track, error)
+	UpdateTrack(context.Context, *TrackUpdate) (*Empty, error)
+	StopAllClips(context.Context, *TrackRef) (*Empty, error)
+	GetSend(context.Context, *SendRef) (*Value, error)
+	SetSend(context.Context, *SetSendRequest) (*Empty, error)
+	ListClips(context.Context, *TrackRef) (*ClipSummaries, error)
+	ListArrangementClips(context.Context, *TrackRef) (*ClipSummaries, error)
+	ListDevices(context.Context, *TrackRef) (*DeviceSummaries, error)
+	mustEmbedUnimplementedTrackServiceServer()
+}
+
+// UnimplementedTrackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrackServiceServer struct{}
+
+func (UnimplementedTrackServiceServer) GetTrack(context.Context, *TrackRef) (*Track, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrack not implemented")
+}
+func (UnimplementedTrackServiceServer) UpdateTrack(context.Context, *TrackUpdate) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrack not implemented")
+}
+func (UnimplementedTrackServiceServer) StopAllClips(context.Context, *TrackRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopAllClips not implemented")
+}
+func (UnimplementedTrackServiceServer) GetSend(context.Context, *SendRef) (*Value, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSend not implemented")
+}
+func (UnimplementedTrackServiceServer) SetSend(context.Context, *SetSendRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSend not implemented")
+}
+func (UnimplementedTrackServiceServer) ListClips(context.Context, *TrackRef) (*ClipSummaries, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClips not implemented")
+}
+func (UnimplementedTrackServiceServer) ListArrangementClips(context.Context, *TrackRef) (*ClipSummaries, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListArrangementClips not implemented")
+}
+func (UnimplementedTrackServiceServer) ListDevices(context.Context, *TrackRef) (*DeviceSummaries, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedTrackServiceServer) mustEmbedUnimplementedTrackServiceServer() {}
+func (UnimplementedTrackServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTrackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackServiceServer will
+// result in compilation errors.
+type UnsafeTrackServiceServer interface {
+	mustEmbedUnimplementedTrackServiceServer()
+}
+
+func RegisterTrackServiceServer(s grpc.ServiceRegistrar, srv TrackServiceServer) {
+	// If the following call panics, it indicates UnimplementedTrackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrackService_ServiceDesc, srv)
+}
+
+func _TrackService_GetTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_GetTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetTrack(ctx, req.(*TrackRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_UpdateTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).UpdateTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_UpdateTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).UpdateTrack(ctx, req.(*TrackUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_StopAllClips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).StopAllClips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_StopAllClips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).StopAllClips(ctx, req.(*TrackRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_GetSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_GetSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetSend(ctx, req.(*SendRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_SetSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).SetSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_SetSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).SetSend(ctx, req.(*SetSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListClips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListClips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListClips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListClips(ctx, req.(*TrackRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListArrangementClips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListArrangementClips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListArrangementClips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListArrangementClips(ctx, req.(*TrackRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListDevices(ctx, req.(*TrackRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrackService_ServiceDesc is the grpc.ServiceDesc for TrackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.TrackService",
+	HandlerType: (*TrackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTrack",
+			Handler:    _TrackService_GetTrack_Handler,
+		},
+		{
+			MethodName: "UpdateTrack",
+			Handler:    _TrackService_UpdateTrack_Handler,
+		},
+		{
+			MethodName: "StopAllClips",
+			Handler:    _TrackService_StopAllClips_Handler,
+		},
+		{
+			MethodName: "GetSend",
+			Handler:    _TrackService_GetSend_Handler,
+		},
+		{
+			MethodName: "SetSend",
+			Handler:    _TrackService_SetSend_Handler,
+		},
+		{
+			MethodName: "ListClips",
+			Handler:    _TrackService_ListClips_Handler,
+		},
+		{
+			MethodName: "ListArrangementClips",
+			Handler:    _TrackService_ListArrangementClips_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _TrackService_ListDevices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	ClipService_GetClip_FullMethodName       = "/als.v1.ClipService/GetClip"
+	ClipService_UpdateClip_FullMethodName    = "/als.v1.ClipService/UpdateClip"
+	ClipService_Fire_FullMethodName          = "/als.v1.ClipService/Fire"
+	ClipService_Stop_FullMethodName          = "/als.v1.ClipService/Stop"
+	ClipService_DuplicateLoop_FullMethodName = "/als.v1.ClipService/DuplicateLoop"
+	ClipService_GetNotes_FullMethodName      = "/als.v1.ClipService/GetNotes"
+	ClipService_AddNotes_FullMethodName      = "/als.v1.ClipService/AddNotes"
+	ClipService_RemoveNotes_FullMethodName   = "/als.v1.ClipService/RemoveNotes"
+)
+
+// ClipServiceClient is the client API for ClipService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClipServiceClient interface {
+	GetClip(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Clip, error)
+	UpdateClip(ctx context.Context, in *ClipUpdate, opts ...grpc.CallOption) (*Empty, error)
+	Fire(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error)
+	Stop(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error)
+	DuplicateLoop(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error)
+	GetNotes(ctx context.Context, in *GetNotesRequest, opts ...grpc.CallOption) (*Notes, error)
+	AddNotes(ctx context.Context, in *AddNotesRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveNotes(ctx context.Context, in *RemoveNotesRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type clipServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClipServiceClient(cc grpc.ClientConnInterface) ClipServiceClient {
+	return &clipServiceClient{cc}
+}
+
+func (c *clipServiceClient) GetClip(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Clip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Clip)
+	err := c.cc.Invoke(ctx, ClipService_GetClip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) UpdateClip(ctx context.Context, in *ClipUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_UpdateClip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) Fire(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_Fire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) Stop(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) DuplicateLoop(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_DuplicateLoop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) GetNotes(ctx context.Context, in *GetNotesRequest, opts ...grpc.CallOption) (*Notes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notes)
+	err := c.cc.Invoke(ctx, ClipService_GetNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) AddNotes(ctx context.Context, in *AddNotesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_AddNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipServiceClient) RemoveNotes(ctx context.Context, in *RemoveNotesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipService_RemoveNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClipServiceServer is the server API for ClipService service.
+// All implementations must embed UnimplementedClipServiceServer
+// for forward compatibility.
+type ClipServiceServer interface {
+	GetClip(context.Context, *ClipRef) (*Clip, error)
+	UpdateClip(context.Context, *ClipUpdate) (*Empty, error)
+	Fire(context.Context, *ClipRef) (*Empty, error)
+	Stop(context.Context, *ClipRef) (*Empty, error)
+	DuplicateLoop(context.Context, *ClipRef) (*Empty, error)
+	GetNotes(context.Context, *GetNotesRequest) (*Notes, error)
+	AddNotes(context.Context, *AddNotesRequest) (*Empty, error)
+	RemoveNotes(context.Context, *RemoveNotesRequest) (*Empty, error)
+	mustEmbedUnimplementedClipServiceServer()
+}
+
+// UnimplementedClipServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClipServiceServer struct{}
+
+func (UnimplementedClipServiceServer) GetClip(context.Context, *ClipRef) (*Clip, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClip not implemented")
+}
+func (UnimplementedClipServiceServer) UpdateClip(context.Context, *ClipUpdate) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateClip not implemented")
+}
+func (UnimplementedClipServiceServer) Fire(context.Context, *ClipRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fire not implemented")
+}
+func (UnimplementedClipServiceServer) Stop(context.Context, *ClipRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedClipServiceServer) DuplicateLoop(context.Context, *ClipRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateLoop not implemented")
+}
+func (UnimplementedClipServiceServer) GetNotes(context.Context, *GetNotesRequest) (*Notes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotes not implemented")
+}
+func (UnimplementedClipServiceServer) AddNotes(context.Context, *AddNotesRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddNotes not implemented")
+}
+func (UnimplementedClipServiceServer) RemoveNotes(context.Context, *RemoveNotesRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveNotes not implemented")
+}
+func (UnimplementedClipServiceServer) mustEmbedUnimplementedClipServiceServer() {}
+func (UnimplementedClipServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeClipServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClipServiceServer will
+// result in compilation errors.
+type UnsafeClipServiceServer interface {
+	mustEmbedUnimplementedClipServiceServer()
+}
+
+func RegisterClipServiceServer(s grpc.ServiceRegistrar, srv ClipServiceServer) {
+	// If the following call panics, it indicates UnimplementedClipServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClipService_ServiceDesc, srv)
+}
+
+func _ClipService_GetClip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).GetClip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_GetClip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).GetClip(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_UpdateClip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).UpdateClip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_UpdateClip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).UpdateClip(ctx, req.(*ClipUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_Fire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).Fire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_Fire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).Fire(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).Stop(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_DuplicateLoop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).DuplicateLoop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_DuplicateLoop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).DuplicateLoop(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_GetNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).GetNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_GetNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).GetNotes(ctx, req.(*GetNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_AddNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).AddNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_AddNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).AddNotes(ctx, req.(*AddNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipService_RemoveNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipServiceServer).RemoveNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipService_RemoveNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipServiceServer).RemoveNotes(ctx, req.(*RemoveNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClipService_ServiceDesc is the grpc.ServiceDesc for ClipService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClipService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.ClipService",
+	HandlerType: (*ClipServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClip",
+			Handler:    _ClipService_GetClip_Handler,
+		},
+		{
+			MethodName: "UpdateClip",
+			Handler:    _ClipService_UpdateClip_Handler,
+		},
+		{
+			MethodName: "Fire",
+			Handler:    _ClipService_Fire_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _ClipService_Stop_Handler,
+		},
+		{
+			MethodName: "DuplicateLoop",
+			Handler:    _ClipService_DuplicateLoop_Handler,
+		},
+		{
+			MethodName: "GetNotes",
+			Handler:    _ClipService_GetNotes_Handler,
+		},
+		{
+			MethodName: "AddNotes",
+			Handler:    _ClipService_AddNotes_Handler,
+		},
+		{
+			MethodName: "RemoveNotes",
+			Handler:    _ClipService_RemoveNotes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	ClipSlotService_GetClipSlot_FullMethodName     = "/als.v1.ClipSlotService/GetClipSlot"
+	ClipSlotService_UpdateClipSlot_FullMethodName  = "/als.v1.ClipSlotService/UpdateClipSlot"
+	ClipSlotService_Fire_FullMethodName            = "/als.v1.ClipSlotService/Fire"
+	ClipSlotService_CreateClip_FullMethodName      = "/als.v1.ClipSlotService/CreateClip"
+	ClipSlotService_DeleteClip_FullMethodName      = "/als.v1.ClipSlotService/DeleteClip"
+	ClipSlotService_DuplicateClipTo_FullMethodName = "/als.v1.ClipSlotService/DuplicateClipTo"
+)
+
+// ClipSlotServiceClient is the client API for ClipSlotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClipSlotServiceClient interface {
+	GetClipSlot(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*ClipSlot, error)
+	UpdateClipSlot(ctx context.Context, in *ClipSlotUpdate, opts ...grpc.CallOption) (*Empty, error)
+	Fire(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error)
+	CreateClip(ctx context.Context, in *CreateClipRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteClip(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error)
+	DuplicateClipTo(ctx context.Context, in *DuplicateClipToRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type clipSlotServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClipSlotServiceClient(cc grpc.ClientConnInterface) ClipSlotServiceClient {
+	return &clipSlotServiceClient{cc}
+}
+
+func (c *clipSlotServiceClient) GetClipSlot(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*ClipSlot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClipSlot)
+	err := c.cc.Invoke(ctx, ClipSlotService_GetClipSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipSlotServiceClient) UpdateClipSlot(ctx context.Context, in *ClipSlotUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipSlotService_UpdateClipSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipSlotServiceClient) Fire(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipSlotService_Fire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipSlotServiceClient) CreateClip(ctx context.Context, in *CreateClipRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipSlotService_CreateClip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipSlotServiceClient) DeleteClip(ctx context.Context, in *ClipRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipSlotService_DeleteClip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clipSlotServiceClient) DuplicateClipTo(ctx context.Context, in *DuplicateClipToRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClipSlotService_DuplicateClipTo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClipSlotServiceServer is the server API for ClipSlotService service.
+// All implementations must embed UnimplementedClipSlotServiceServer
+// for forward compatibility.
+type ClipSlotServiceServer interface {
+	GetClipSlot(context.Context, *ClipRef) (*ClipSlot, error)
+	UpdateClipSlot(context.Context, *ClipSlotUpdate) (*Empty, error)
+	Fire(context.Context, *ClipRef) (*Empty, error)
+	CreateClip(context.Context, *CreateClipRequest) (*Empty, error)
+	DeleteClip(context.Context, *ClipRef) (*Empty, error)
+	DuplicateClipTo(context.Context, *DuplicateClipToRequest) (*Empty, error)
+	mustEmbedUnimplementedClipSlotServiceServer()
+}
+
+// UnimplementedClipSlotServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClipSlotServiceServer struct{}
+
+func (UnimplementedClipSlotServiceServer) GetClipSlot(context.Context, *ClipRef) (*ClipSlot, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClipSlot not implemented")
+}
+func (UnimplementedClipSlotServiceServer) UpdateClipSlot(context.Context, *ClipSlotUpdate) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateClipSlot not implemented")
+}
+func (UnimplementedClipSlotServiceServer) Fire(context.Context, *ClipRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fire not implemented")
+}
+func (UnimplementedClipSlotServiceServer) CreateClip(context.Context, *CreateClipRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateClip not implemented")
+}
+func (UnimplementedClipSlotServiceServer) DeleteClip(context.Context, *ClipRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteClip not implemented")
+}
+func (UnimplementedClipSlotServiceServer) DuplicateClipTo(context.Context, *DuplicateClipToRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateClipTo not implemented")
+}
+func (UnimplementedClipSlotServiceServer) mustEmbedUnimplementedClipSlotServiceServer() {}
+func (UnimplementedClipSlotServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeClipSlotServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClipSlotServiceServer will
+// result in compilation errors.
+type UnsafeClipSlotServiceServer interface {
+	mustEmbedUnimplementedClipSlotServiceServer()
+}
+
+func RegisterClipSlotServiceServer(s grpc.ServiceRegistrar, srv ClipSlotServiceServer) {
+	// If the following call panics, it indicates UnimplementedClipSlotServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClipSlotService_ServiceDesc, srv)
+}
+
+func _ClipSlotService_GetClipSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).GetClipSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_GetClipSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).GetClipSlot(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipSlotService_UpdateClipSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipSlotUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).UpdateClipSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_UpdateClipSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).UpdateClipSlot(ctx, req.(*ClipSlotUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipSlotService_Fire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).Fire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_Fire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).Fire(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipSlotService_CreateClip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).CreateClip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_CreateClip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).CreateClip(ctx, req.(*CreateClipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipSlotService_DeleteClip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClipRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).DeleteClip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_DeleteClip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).DeleteClip(ctx, req.(*ClipRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClipSlotService_DuplicateClipTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateClipToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClipSlotServiceServer).DuplicateClipTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClipSlotService_DuplicateClipTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClipSlotServiceServer).DuplicateClipTo(ctx, req.(*DuplicateClipToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClipSlotService_ServiceDesc is the grpc.ServiceDesc for ClipSlotService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClipSlotService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.ClipSlotService",
+	HandlerType: (*ClipSlotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClipSlot",
+			Handler:    _ClipSlotService_GetClipSlot_Handler,
+		},
+		{
+			MethodName: "UpdateClipSlot",
+			Handler:    _ClipSlotService_UpdateClipSlot_Handler,
+		},
+		{
+			MethodName: "Fire",
+			Handler:    _ClipSlotService_Fire_Handler,
+		},
+		{
+			MethodName: "CreateClip",
+			Handler:    _ClipSlotService_CreateClip_Handler,
+		},
+		{
+			MethodName: "DeleteClip",
+			Handler:    _ClipSlotService_DeleteClip_Handler,
+		},
+		{
+			MethodName: "DuplicateClipTo",
+			Handler:    _ClipSlotService_DuplicateClipTo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	SceneService_GetScene_FullMethodName       = "/als.v1.SceneService/GetScene"
+	SceneService_UpdateScene_FullMethodName    = "/als.v1.SceneService/UpdateScene"
+	SceneService_Fire_FullMethodName           = "/als.v1.SceneService/Fire"
+	SceneService_FireAsSelected_FullMethodName = "/als.v1.SceneService/FireAsSelected"
+	SceneService_FireSelected_FullMethodName   = "/als.v1.SceneService/FireSelected"
+)
+
+// SceneServiceClient is the client API for SceneService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SceneServiceClient interface {
+	GetScene(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Scene, error)
+	UpdateScene(ctx context.Context, in *SceneUpdate, opts ...grpc.CallOption) (*Empty, error)
+	Fire(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Empty, error)
+	FireAsSelected(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Empty, error)
+	FireSelected(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type sceneServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSceneServiceClient(cc grpc.ClientConnInterface) SceneServiceClient {
+	return &sceneServiceClient{cc}
+}
+
+func (c *sceneServiceClient) GetScene(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Scene, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scene)
+	err := c.cc.Invoke(ctx, SceneService_GetScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) UpdateScene(ctx context.Context, in *SceneUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SceneService_UpdateScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) Fire(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SceneService_Fire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) FireAsSelected(ctx context.Context, in *SceneRef, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SceneService_FireAsSelected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) FireSelected(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SceneService_FireSelected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SceneServiceServer is the server API for SceneService service.
+// All implementations must embed UnimplementedSceneServiceServer
+// for forward compatibility.
+type SceneServiceServer interface {
+	GetScene(context.Context, *SceneRef) (*Scene, error)
+	UpdateScene(context.Context, *SceneUpdate) (*Empty, error)
+	Fire(context.Context, *SceneRef) (*Empty, error)
+	FireAsSelected(context.Context, *SceneRef) (*Empty, error)
+	FireSelected(context.Context, *Empty) (*Empty, error)
+	mustEmbedUnimplementedSceneServiceServer()
+}
+
+// UnimplementedSceneServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSceneServiceServer struct{}
+
+func (UnimplementedSceneServiceServer) GetScene(context.Context, *SceneRef) (*Scene, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScene not implemented")
+}
+func (UnimplementedSceneServiceServer) UpdateScene(context.Context, *SceneUpdate) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateScene not implemented")
+}
+func (UnimplementedSceneServiceServer) Fire(context.Context, *SceneRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Fire not implemented")
+}
+func (UnimplementedSceneServiceServer) FireAsSelected(context.Context, *SceneRef) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method FireAsSelected not implemented")
+}
+func (UnimplementedSceneServiceServer) FireSelected(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method FireSelected not implemented")
+}
+func (UnimplementedSceneServiceServer) mustEmbedUnimplementedSceneServiceServer() {}
+func (UnimplementedSceneServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeSceneServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SceneServiceServer will
+// result in compilation errors.
+type UnsafeSceneServiceServer interface {
+	mustEmbedUnimplementedSceneServiceServer()
+}
+
+func RegisterSceneServiceServer(s grpc.ServiceRegistrar, srv SceneServiceServer) {
+	// If the following call panics, it indicates UnimplementedSceneServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SceneService_ServiceDesc, srv)
+}
+
+func _SceneService_GetScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SceneRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).GetScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_GetScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).GetScene(ctx, req.(*SceneRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_UpdateScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SceneUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).UpdateScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_UpdateScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).UpdateScene(ctx, req.(*SceneUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_Fire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SceneRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).Fire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_Fire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).Fire(ctx, req.(*SceneRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_FireAsSelected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SceneRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).FireAsSelected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_FireAsSelected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).FireAsSelected(ctx, req.(*SceneRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_FireSelected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).FireSelected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_FireSelected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).FireSelected(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SceneService_ServiceDesc is the grpc.ServiceDesc for SceneService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SceneService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.SceneService",
+	HandlerType: (*SceneServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetScene",
+			Handler:    _SceneService_GetScene_Handler,
+		},
+		{
+			MethodName: "UpdateScene",
+			Handler:    _SceneService_UpdateScene_Handler,
+		},
+		{
+			MethodName: "Fire",
+			Handler:    _SceneService_Fire_Handler,
+		},
+		{
+			MethodName: "FireAsSelected",
+			Handler:    _SceneService_FireAsSelected_Handler,
+		},
+		{
+			MethodName: "FireSelected",
+			Handler:    _SceneService_FireSelected_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	DeviceService_GetDevice_FullMethodName     = "/als.v1.DeviceService/GetDevice"
+	DeviceService_GetParameter_FullMethodName  = "/als.v1.DeviceService/GetParameter"
+	DeviceService_SetParameter_FullMethodName  = "/als.v1.DeviceService/SetParameter"
+	DeviceService_SetParameters_FullMethodName = "/als.v1.DeviceService/SetParameters"
+)
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	GetDevice(ctx context.Context, in *DeviceRef, opts ...grpc.CallOption) (*Device, error)
+	GetParameter(ctx context.Context, in *ParameterRef, opts ...grpc.CallOption) (*Parameter, error)
+	SetParameter(ctx context.Context, in *SetParameterRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetParameters(ctx context.Context, in *SetParametersRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type deviceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceServiceClient(cc grpc.ClientConnInterface) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) GetDevice(ctx context.Context, in *DeviceRef, opts ...grpc.CallOption) (*Device, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Device)
+	err := c.cc.Invoke(ctx, DeviceService_GetDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetParameter(ctx context.Context, in *ParameterRef, opts ...grpc.CallOption) (*Parameter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Parameter)
+	err := c.cc.Invoke(ctx, DeviceService_GetParameter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) SetParameter(ctx context.Context, in *SetParameterRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, DeviceService_SetParameter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) SetParameters(ctx context.Context, in *SetParametersRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, DeviceService_SetParameters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceServiceServer is the server API for DeviceService service.
+// All implementations must embed UnimplementedDeviceServiceServer
+// for forward compatibility.
+type DeviceServiceServer interface {
+	GetDevice(context.Context, *DeviceRef) (*Device, error)
+	GetParameter(context.Context, *ParameterRef) (*Parameter, error)
+	SetParameter(context.Context, *SetParameterRequest) (*Empty, error)
+	SetParameters(context.Context, *SetParametersRequest) (*Empty, error)
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+// UnimplementedDeviceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeviceServiceServer struct{}
+
+func (UnimplementedDeviceServiceServer) GetDevice(context.Context, *DeviceRef) (*Device, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedDeviceServiceServer) GetParameter(context.Context, *ParameterRef) (*Parameter, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetParameter not implemented")
+}
+func (UnimplementedDeviceServiceServer) SetParameter(context.Context, *SetParameterRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetParameter not implemented")
+}
+func (UnimplementedDeviceServiceServer) SetParameters(context.Context, *SetParametersRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetParameters not implemented")
+}
+func (UnimplementedDeviceServiceServer) mustEmbedUnimplementedDeviceServiceServer() {}
+func (UnimplementedDeviceServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDeviceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceServiceServer will
+// result in compilation errors.
+type UnsafeDeviceServiceServer interface {
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+func RegisterDeviceServiceServer(s grpc.ServiceRegistrar, srv DeviceServiceServer) {
+	// If the following call panics, it indicates UnimplementedDeviceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeviceService_ServiceDesc, srv)
+}
+
+func _DeviceService_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_GetDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetDevice(ctx, req.(*DeviceRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetParameter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParameterRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetParameter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_GetParameter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetParameter(ctx, req.(*ParameterRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetParameter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetParameterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetParameter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_SetParameter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetParameter(ctx, req.(*SetParameterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetParametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_SetParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetParameters(ctx, req.(*SetParametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceService_ServiceDesc is the grpc.ServiceDesc for DeviceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.DeviceService",
+	HandlerType: (*DeviceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDevice",
+			Handler:    _DeviceService_GetDevice_Handler,
+		},
+		{
+			MethodName: "GetParameter",
+			Handler:    _DeviceService_GetParameter_Handler,
+		},
+		{
+			MethodName: "SetParameter",
+			Handler:    _DeviceService_SetParameter_Handler,
+		},
+		{
+			MethodName: "SetParameters",
+			Handler:    _DeviceService_SetParameters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	ViewService_GetSelection_FullMethodName = "/als.v1.ViewService/GetSelection"
+	ViewService_Select_FullMethodName       = "/als.v1.ViewService/Select"
+)
+
+// ViewServiceClient is the client API for ViewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ViewServiceClient interface {
+	GetSelection(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Selection, error)
+	Select(ctx context.Context, in *SelectRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type viewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewViewServiceClient(cc grpc.ClientConnInterface) ViewServiceClient {
+	return &viewServiceClient{cc}
+}
+
+func (c *viewServiceClient) GetSelection(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Selection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Selection)
+	err := c.cc.Invoke(ctx, ViewService_GetSelection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) Select(ctx context.Context, in *SelectRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ViewService_Select_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ViewServiceServer is the server API for ViewService service.
+// All implementations must embed UnimplementedViewServiceServer
+// for forward compatibility.
+type ViewServiceServer interface {
+	GetSelection(context.Context, *Empty) (*Selection, error)
+	Select(context.Context, *SelectRequest) (*Empty, error)
+	mustEmbedUnimplementedViewServiceServer()
+}
+
+// UnimplementedViewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedViewServiceServer struct{}
+
+func (UnimplementedViewServiceServer) GetSelection(context.Context, *Empty) (*Selection, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSelection not implemented")
+}
+func (UnimplementedViewServiceServer) Select(context.Context, *SelectRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Select not implemented")
+}
+func (UnimplementedViewServiceServer) mustEmbedUnimplementedViewServiceServer() {}
+func (UnimplementedViewServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeViewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ViewServiceServer will
+// result in compilation errors.
+type UnsafeViewServiceServer interface {
+	mustEmbedUnimplementedViewServiceServer()
+}
+
+func RegisterViewServiceServer(s grpc.ServiceRegistrar, srv ViewServiceServer) {
+	// If the following call panics, it indicates UnimplementedViewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ViewService_ServiceDesc, srv)
+}
+
+func _ViewService_GetSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).GetSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_GetSelection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).GetSelection(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_Select_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).Select(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_Select_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).Select(ctx, req.(*SelectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ViewService_ServiceDesc is the grpc.ServiceDesc for ViewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ViewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.ViewService",
+	HandlerType: (*ViewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSelection",
+			Handler:    _ViewService_GetSelection_Handler,
+		},
+		{
+			MethodName: "Select",
+			Handler:    _ViewService_Select_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "als.proto",
+}
+
+const (
+	ListenerService_Listen_FullMethodName = "/als.v1.ListenerService/Listen"
+)
+
+// ListenerServiceClient is the client API for ListenerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ListenerServiceClient interface {
+	// Listen streams updates of the topics until the call is cancelled.
+	// Listeners are shared, so many streams cost one AbletonOSC listener per
+	// topic.
+	Listen(ctx context.Context, in *ListenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+}
+
+type listenerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewListenerServiceClient(cc grpc.ClientConnInterface) ListenerServiceClient {
+	return &listenerServiceClient{cc}
+}
+
+func (c *listenerServiceClient) Listen(ctx context.Context, in *ListenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ListenerService_ServiceDesc.Streams[0], ListenerService_Listen_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListenRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ListenerService_ListenClient = grpc.ServerStreamingClient[Event]
+
+// ListenerServiceServer is the server API for ListenerService service.
+// All implementations must embed UnimplementedListenerServiceServer
+// for forward compatibility.
+type ListenerServiceServer interface {
+	// Listen streams updates of the topics until the call is cancelled.
+	// Listeners are shared, so many streams cost one AbletonOSC listener per
+	// topic.
+	Listen(*ListenRequest, grpc.ServerStreamingServer[Event]) error
+	mustEmbedUnimplementedListenerServiceServer()
+}
+
+// UnimplementedListenerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedListenerServiceServer struct{}
+
+func (UnimplementedListenerServiceServer) Listen(*ListenRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Error(codes.Unimplemented, "method Listen not implemented")
+}
+func (UnimplementedListenerServiceServer) mustEmbedUnimplementedListenerServiceServer() {}
+func (UnimplementedListenerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeListenerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListenerServiceServer will
+// result in compilation errors.
+type UnsafeListenerServiceServer interface {
+	mustEmbedUnimplementedListenerServiceServer()
+}
+
+func RegisterListenerServiceServer(s grpc.ServiceRegistrar, srv ListenerServiceServer) {
+	// If the following call panics, it indicates UnimplementedListenerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ListenerService_ServiceDesc, srv)
+}
+
+func _ListenerService_Listen_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ListenerServiceServer).Listen(m, &grpc.GenericServerStream[ListenRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ListenerService_ListenServer = grpc.ServerStreamingServer[Event]
+
+// ListenerService_ServiceDesc is the grpc.ServiceDesc for ListenerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ListenerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "als.v1.ListenerService",
+	HandlerType: (*ListenerServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Listen",
+			Handler:       _ListenerService_Listen_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "als.proto",
+}
@@ -0,0 +1,207 @@
+package alsrpc
+
+import (
+	"context"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type clipService struct {
+	alspb.UnimplementedClipServiceServer
+	*base
+}
+
+func (s *clipService) GetClip(ctx context.Context, req *alspb.ClipRef) (*alspb.Clip, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+
+	r := s.reader("/live/clip", req.Track, req.Clip)
+	clip := &alspb.Clip{
+		Track:           req.Track,
+		Clip:            req.Clip,
+		Name:            r.string("name"),
+		Color:           r.int("color"),
+		Gain:            r.float("gain"),
+		Length:          r.float("length"),
+		PitchCoarse:     r.int("pitch_coarse"),
+		PitchFine:       r.int("pitch_fine"),
+		FilePath:        r.string("file_path"),
+		IsAudioClip:     r.bool("is_audio_clip"),
+		IsMidiClip:      r.bool("is_midi_clip"),
+		IsPlaying:       r.bool("is_playing"),
+		IsRecording:     r.bool("is_recording"),
+		PlayingPosition: r.float("playing_position"),
+		LoopStart:       r.float("loop_start"),
+		LoopEnd:         r.float("loop_end"),
+		Warping:         r.bool("warping"),
+		StartMarker:     r.float("start_marker"),
+		EndMarker:       r.float("end_marker"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return clip, nil
+}
+
+func (s *clipService) UpdateClip(ctx context.Context, req *alspb.ClipUpdate) (*alspb.Empty, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+
+	w := s.writer("/live/clip", req.Track, req.Clip)
+	w.string("name", req.Name)
+	w.int("color", req.Color)
+	w.float("gain", req.Gain)
+	w.int("pitch_coarse", req.PitchCoarse)
+	w.int("pitch_fine", req.PitchFine)
+	w.float("loop_start", req.LoopStart)
+	w.float("loop_end", req.LoopEnd)
+	w.bool("warping", req.Warping)
+	w.float("start_marker", req.StartMarker)
+	w.float("end_marker", req.EndMarker)
+	return empty, nil
+}
+
+func (s *clipService) Fire(ctx context.Context, req *alspb.ClipRef) (*alspb.Empty, error) {
+	return s.action("fire", req)
+}
+
+func (s *clipService) Stop(ctx context.Context, req *alspb.ClipRef) (*alspb.Empty, error) {
+	return s.action("stop", req)
+}
+
+func (s *clipService) DuplicateLoop(ctx context.Context, req *alspb.ClipRef) (*alspb.Empty, error) {
+	return s.action("duplicate_loop", req)
+}
+
+func (s *clipService) action(name string, req *alspb.ClipRef) (*alspb.Empty, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	return s.send("/live/clip/"+name, req.Track, req.Clip)
+}
+
+func (s *clipService) GetNotes(ctx context.Context, req *alspb.GetNotesRequest) (*alspb.Notes, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+
+	indices := []any{req.Track, req.Clip}
+	params := indices
+	if req.PitchSpan > 0 && req.TimeSpan > 0 {
+		params = append(params, req.StartPitch, req.PitchSpan, req.StartTime, req.TimeSpan)
+	}
+	msg, err := s.client.Query("/live/clip/get/notes", params...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// notes follow the indices in groups of 5: pitch, start_time, duration,
+	// velocity, mute
+	args := msg.Arguments[min(len(indices), len(msg.Arguments)):]
+	notes := make([]*alspb.Note, 0, len(args)/5)
+	for i := 0; i+4 < len(args); i += 5 {
+		notes = append(notes, &alspb.Note{
+			Pitch:     als.Int(args[i]),
+			StartTime: als.Float(args[i+1]),
+			Duration:  als.Float(args[i+2]),
+			Velocity:  als.Int(args[i+3]),
+			Mute:      als.Bool(args[i+4]),
+		})
+	}
+	return &alspb.Notes{Notes: notes}, nil
+}
+
+func (s *clipService) AddNotes(ctx context.Context, req *alspb.AddNotesRequest) (*alspb.Empty, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+
+	params := []any{req.Track, req.Clip}
+	for _, n := range req.Notes {
+		if n.Pitch < 0 || n.Pitch > 127 || n.Velocity < 0 || n.Velocity > 127 || n.Duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid note %v", n)
+		}
+		params = append(params, n.Pitch, n.StartTime, n.Duration, n.Velocity, als.FromBool(n.Mute))
+	}
+	return s.send("/live/clip/add/notes", params...)
+}
+
+func (s *clipService) RemoveNotes(ctx context.Context, req *alspb.RemoveNotesRequest) (*alspb.Empty, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	return s.send("/live/clip/remove/notes", req.Track, req.Clip, req.StartPitch, req.PitchSpan, req.StartTime, req.TimeSpan)
+}
+
+type clipSlotService struct {
+	alspb.UnimplementedClipSlotServiceServer
+	*base
+}
+
+func (s *clipSlotService) GetClipSlot(ctx context.Context, req *alspb.ClipRef) (*alspb.ClipSlot, error) {
+	if err := s.checkSlot(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+
+	r := s.reader("/live/clip_slot", req.Track, req.Clip)
+	slot := &alspb.ClipSlot{
+		Track:         req.Track,
+		Clip:          req.Clip,
+		HasClip:       r.bool("has_clip"),
+		HasStopButton: r.bool("has_stop_button"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return slot, nil
+}
+
+func (s *clipSlotService) UpdateClipSlot(ctx context.Context, req *alspb.ClipSlotUpdate) (*alspb.Empty, error) {
+	if err := s.checkSlot(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	s.writer("/live/clip_slot", req.Track, req.Clip).bool("has_stop_button", req.HasStopButton)
+	return empty, nil
+}
+
+func (s *clipSlotService) Fire(ctx context.Context, req *alspb.ClipRef) (*alspb.Empty, error) {
+	if err := s.checkSlot(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	return s.send("/live/clip_slot/fire", req.Track, req.Clip)
+}
+
+func (s *clipSlotService) CreateClip(ctx context.Context, req *alspb.CreateClipRequest) (*alspb.Empty, error) {
+	if err := s.checkSlot(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	if req.Length <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid length %v", req.Length)
+	}
+	return s.send("/live/clip_slot/create_clip", req.Track, req.Clip, req.Length)
+}
+
+func (s *clipSlotService) DeleteClip(ctx context.Context, req *alspb.ClipRef) (*alspb.Empty, error) {
+	if err := s.checkClip(req.Track, req.Clip); err != nil {
+		return nil, err
+	}
+	return s.send("/live/clip_slot/delete_clip", req.Track, req.Clip)
+}
+
+func (s *clipSlotService) DuplicateClipTo(ctx context.Context, req *alspb.DuplicateClipToRequest) (*alspb.Empty, error) {
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	if err := s.checkClip(req.From.Track, req.From.Clip); err != nil {
+		return nil, err
+	}
+	if err := s.checkSlot(req.To.Track, req.To.Clip); err != nil {
+		return nil, err
+	}
+	return s.send("/live/clip_slot/duplicate_clip_to", req.From.Track, req.From.Clip, req.To.Track, req.To.Clip)
+}
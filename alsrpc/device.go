@@ -0,0 +1,132 @@
+package alsrpc
+
+import (
+	"context"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type deviceService struct {
+	alspb.UnimplementedDeviceServiceServer
+	*base
+}
+
+func (s *deviceService) GetDevice(ctx context.Context, req *alspb.DeviceRef) (*alspb.Device, error) {
+	if err := s.checkDevice(req.Track, req.Device); err != nil {
+		return nil, err
+	}
+
+	r := s.reader("/live/device", req.Track, req.Device)
+	device := &alspb.Device{
+		Track:     req.Track,
+		Device:    req.Device,
+		Name:      r.string("name"),
+		ClassName: r.string("class_name"),
+		Type:      r.string("type"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	params, err := s.parameters(req.Track, req.Device)
+	if err != nil {
+		return nil, err
+	}
+	device.Parameters = params
+	return device, nil
+}
+
+func (s *deviceService) GetParameter(ctx context.Context, req *alspb.ParameterRef) (*alspb.Parameter, error) {
+	params, err := s.checkParameter(req.Track, req.Device, req.Parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := params[req.Parameter]
+	args, err := s.query("/live/device/get/parameter/value_string", req.Track, req.Device, req.Parameter)
+	if err != nil {
+		return nil, err
+	}
+	// the lists may be stale, so read the value on its own
+	value, err := s.query("/live/device/get/parameter/value", req.Track, req.Device, req.Parameter)
+	if err != nil {
+		return nil, err
+	}
+	p.Value = als.Float(als.First(value))
+	p.ValueString = als.String(als.First(args))
+	return p, nil
+}
+
+func (s *deviceService) SetParameter(ctx context.Context, req *alspb.SetParameterRequest) (*alspb.Empty, error) {
+	params, err := s.checkParameter(req.Track, req.Device, req.Parameter)
+	if err != nil {
+		return nil, err
+	}
+	if p := params[req.Parameter]; req.Value < p.Min || req.Value > p.Max {
+		return nil, status.Errorf(codes.OutOfRange, "%s ranges from %v to %v", p.Name, p.Min, p.Max)
+	}
+	return s.send("/live/device/set/parameter/value", req.Track, req.Device, req.Parameter, req.Value)
+}
+
+func (s *deviceService) SetParameters(ctx context.Context, req *alspb.SetParametersRequest) (*alspb.Empty, error) {
+	if err := s.checkDevice(req.Track, req.Device); err != nil {
+		return nil, err
+	}
+	params := []any{req.Track, req.Device}
+	for _, v := range req.Values {
+		params = append(params, v)
+	}
+	return s.send("/live/device/set/parameters/value", params...)
+}
+
+func (s *deviceService) checkParameter(track, device, parameter int32) ([]*alspb.Parameter, error) {
+	if err := s.checkDevice(track, device); err != nil {
+		return nil, err
+	}
+	params, err := s.parameters(track, device)
+	if err != nil {
+		return nil, err
+	}
+	if parameter < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameter %d", parameter)
+	}
+	if int(parameter) >= len(params) {
+		return nil, status.Errorf(codes.NotFound, "parameter %d", parameter)
+	}
+	return params, nil
+}
+
+// parameters reads a device's parameter lists
+func (s *deviceService) parameters(track, device int32) ([]*alspb.Parameter, error) {
+	columns := []string{"name", "value", "min", "max", "is_quantized"}
+	values := make([][]any, len(columns))
+	for i, column := range columns {
+		args, err := s.query("/live/device/get/parameters/"+column, track, device)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = args
+	}
+
+	at := func(column, i int) any {
+		if i < len(values[column]) {
+			return values[column][i]
+		}
+		return nil
+	}
+	params := make([]*alspb.Parameter, len(values[0]))
+	for i := range params {
+		params[i] = &alspb.Parameter{
+			Parameter:   int32(i),
+			Name:        als.String(at(0, i)),
+			Value:       als.Float(at(1, i)),
+			Min:         als.Float(at(2, i)),
+			Max:         als.Float(at(3, i)),
+			IsQuantized: als.Bool(at(4, i)),
+		}
+	}
+	return params, nil
+}
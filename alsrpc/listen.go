@@ -0,0 +1,86 @@
+package alsrpc
+
+import (
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamBuffer is how many events queue for a slow stream before events are
+// dropped
+const streamBuffer = 256
+
+type listenerService struct {
+	alspb.UnimplementedListenerServiceServer
+	*base
+	hub *listen.Hub
+}
+
+func (s *listenerService) Listen(req *alspb.ListenRequest, stream alspb.ListenerService_ListenServer) error {
+	if len(req.Topics) == 0 {
+		return status.Error(codes.InvalidArgument, "no topics")
+	}
+
+	topics := make([]listen.Topic, len(req.Topics))
+	for i, t := range req.Topics {
+		topics[i] = listen.Topic{Object: t.Object, Property: t.Property, Indices: t.Indices}
+		if err := topics[i].Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := stream.Context()
+	events := make(chan listen.Event, streamBuffer)
+	for _, t := range topics {
+		unsubscribe, err := s.hub.Subscribe(t, func(e listen.Event) {
+			// handlers run on the OSC server goroutine, so never block it
+			select {
+			case <-ctx.Done():
+			case events <- e:
+			default:
+			}
+		})
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		defer unsubscribe()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e := <-events:
+			if err := stream.Send(toEvent(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toEvent(e listen.Event) *alspb.Event {
+	values := make([]*alspb.Argument, 0, len(e.Values))
+	for _, v := range e.Values {
+		a := &alspb.Argument{}
+		switch v := v.(type) {
+		case float32:
+			a.Value = &alspb.Argument_Float{Float: v}
+		case int32:
+			a.Value = &alspb.Argument_Int{Int: v}
+		case string:
+			a.Value = &alspb.Argument_String_{String_: v}
+		case bool:
+			a.Value = &alspb.Argument_Bool{Bool: v}
+		default:
+			continue
+		}
+		values = append(values, a)
+	}
+
+	return &alspb.Event{
+		Topic:        &alspb.Topic{Object: e.Object, Property: e.Property, Indices: e.Indices},
+		Values:       values,
+		TimeUnixNano: e.Time.UnixNano(),
+	}
+}
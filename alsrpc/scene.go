@@ -0,0 +1,72 @@
+package alsrpc
+
+import (
+	"context"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+)
+
+type sceneService struct {
+	alspb.UnimplementedSceneServiceServer
+	*base
+}
+
+func (s *sceneService) GetScene(ctx context.Context, req *alspb.SceneRef) (*alspb.Scene, error) {
+	if err := s.checkScene(req.Scene); err != nil {
+		return nil, err
+	}
+
+	r := s.reader("/live/scene", req.Scene)
+	scene := &alspb.Scene{
+		Scene:                    req.Scene,
+		Name:                     r.string("name"),
+		Color:                    r.int("color"),
+		ColorIndex:               r.int("color_index"),
+		IsEmpty:                  r.bool("is_empty"),
+		IsTriggered:              r.bool("is_triggered"),
+		Tempo:                    r.float("tempo"),
+		TempoEnabled:             r.bool("tempo_enabled"),
+		TimeSignatureNumerator:   r.int("time_signature_numerator"),
+		TimeSignatureDenominator: r.int("time_signature_denominator"),
+		TimeSignatureEnabled:     r.bool("time_signature_enabled"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return scene, nil
+}
+
+func (s *sceneService) UpdateScene(ctx context.Context, req *alspb.SceneUpdate) (*alspb.Empty, error) {
+	if err := s.checkScene(req.Scene); err != nil {
+		return nil, err
+	}
+
+	w := s.writer("/live/scene", req.Scene)
+	w.string("name", req.Name)
+	w.int("color", req.Color)
+	w.int("color_index", req.ColorIndex)
+	w.float("tempo", req.Tempo)
+	w.bool("tempo_enabled", req.TempoEnabled)
+	w.int("time_signature_numerator", req.TimeSignatureNumerator)
+	w.int("time_signature_denominator", req.TimeSignatureDenominator)
+	w.bool("time_signature_enabled", req.TimeSignatureEnabled)
+	return empty, nil
+}
+
+func (s *sceneService) Fire(ctx context.Context, req *alspb.SceneRef) (*alspb.Empty, error) {
+	if err := s.checkScene(req.Scene); err != nil {
+		return nil, err
+	}
+	return s.send("/live/scene/fire", req.Scene)
+}
+
+func (s *sceneService) FireAsSelected(ctx context.Context, req *alspb.SceneRef) (*alspb.Empty, error) {
+	if err := s.checkScene(req.Scene); err != nil {
+		return nil, err
+	}
+	return s.send("/live/scene/fire_as_selected", req.Scene)
+}
+
+func (s *sceneService) FireSelected(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/scene/fire_selected")
+}
@@ -0,0 +1,204 @@
+// Package alsrpc serves the als API over gRPC. The schema is in
+// alspb/als.proto, with one service per als API and a ListenerService
+// streaming listener updates.
+package alsrpc
+
+import (
+	"errors"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate protoc -I alspb --go_out=alspb --go_opt=paths=source_relative --go-grpc_out=alspb --go-grpc_opt=paths=source_relative alspb/als.proto
+
+// Register registers every service on s, backed by client. Listeners are
+// shared between streams through a listen.Hub.
+func Register(s grpc.ServiceRegistrar, client *als.Client) {
+	b := &base{client: client}
+	alspb.RegisterSongServiceServer(s, &songService{base: b})
+	alspb.RegisterTrackServiceServer(s, &trackService{base: b})
+	alspb.RegisterClipServiceServer(s, &clipService{base: b})
+	alspb.RegisterClipSlotServiceServer(s, &clipSlotService{base: b})
+	alspb.RegisterSceneServiceServer(s, &sceneService{base: b})
+	alspb.RegisterDeviceServiceServer(s, &deviceService{base: b})
+	alspb.RegisterViewServiceServer(s, &viewService{base: b})
+	alspb.RegisterListenerServiceServer(s, &listenerService{base: b, hub: listen.Shared(client)})
+}
+
+// NewServer creates a gRPC server with every service registered.
+func NewServer(client *als.Client, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	Register(s, client)
+	return s
+}
+
+// base holds what the services share: the client and index checks
+type base struct {
+	client *als.Client
+}
+
+var empty = &alspb.Empty{}
+
+// send sends a message and returns the empty response of write RPCs
+func (b *base) send(addr string, args ...any) (*alspb.Empty, error) {
+	b.client.Send(addr, args...)
+	return empty, nil
+}
+
+// query returns the reply arguments following the indices, see
+// als.Client.Get, with errors as statuses
+func (b *base) query(addr string, indices ...any) ([]any, error) {
+	args, err := b.client.Get(addr, indices...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return args, nil
+}
+
+func (b *base) count(addr string, indices ...any) (int32, error) {
+	args, err := b.query(addr, indices...)
+	if err != nil {
+		return 0, err
+	}
+	return als.Int(als.First(args)), nil
+}
+
+// checkTrack returns InvalidArgument for negative indices and NotFound for
+// tracks beyond the last
+func (b *base) checkTrack(track int32) error {
+	return b.check("track", track, "/live/song/get/num_tracks")
+}
+
+func (b *base) checkScene(scene int32) error {
+	return b.check("scene", scene, "/live/song/get/num_scenes")
+}
+
+// checkClip checks the slot exists and holds a clip
+func (b *base) checkClip(track, clip int32) error {
+	if err := b.checkSlot(track, clip); err != nil {
+		return err
+	}
+	args, err := b.query("/live/clip_slot/get/has_clip", track, clip)
+	if err != nil {
+		return err
+	}
+	if !als.Bool(als.First(args)) {
+		return status.Errorf(codes.NotFound, "no clip in slot %d of track %d", clip, track)
+	}
+	return nil
+}
+
+func (b *base) checkSlot(track, clip int32) error {
+	if err := b.checkTrack(track); err != nil {
+		return err
+	}
+	return b.checkScene(clip)
+}
+
+func (b *base) checkDevice(track, device int32) error {
+	if err := b.checkTrack(track); err != nil {
+		return err
+	}
+	return b.check("device", device, "/live/track/get/num_devices", track)
+}
+
+func (b *base) check(name string, index int32, countAddr string, indices ...any) error {
+	if index < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid %s %d", name, index)
+	}
+	n, err := b.count(countAddr, indices...)
+	if err != nil {
+		return err
+	}
+	if index >= n {
+		return status.Errorf(codes.NotFound, "%s %d", name, index)
+	}
+	return nil
+}
+
+// toStatus maps errors to gRPC statuses. Live not answering in time is
+// Unavailable, as retrying may succeed.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, oscclient.ErrTimeout) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// reader reads properties of one Live object, keeping the first error so a
+// whole message can be filled before checking
+type reader struct {
+	b       *base
+	prefix  string
+	indices []any
+	err     error
+}
+
+func (b *base) reader(prefix string, indices ...any) *reader {
+	return &reader{b: b, prefix: prefix, indices: indices}
+}
+
+func (r *reader) arg(prop string) any {
+	if r.err != nil {
+		return nil
+	}
+	args, err := r.b.query(r.prefix+"/get/"+prop, r.indices...)
+	if err != nil {
+		r.err = err
+		return nil
+	}
+	return als.First(args)
+}
+
+func (r *reader) float(prop string) float32 { return als.Float(r.arg(prop)) }
+func (r *reader) int(prop string) int32     { return als.Int(r.arg(prop)) }
+func (r *reader) bool(prop string) bool     { return als.Bool(r.arg(prop)) }
+func (r *reader) string(prop string) string { return als.String(r.arg(prop)) }
+
+// writer sends the set fields of an update message
+type writer struct {
+	b       *base
+	prefix  string
+	indices []any
+}
+
+func (b *base) writer(prefix string, indices ...any) *writer {
+	return &writer{b: b, prefix: prefix, indices: indices}
+}
+
+func (w *writer) send(prop string, v any) {
+	w.b.client.Send(w.prefix+"/set/"+prop, append(append([]any{}, w.indices...), v)...)
+}
+
+func (w *writer) float(prop string, v *float32) {
+	if v != nil {
+		w.send(prop, *v)
+	}
+}
+
+func (w *writer) int(prop string, v *int32) {
+	if v != nil {
+		w.send(prop, *v)
+	}
+}
+
+func (w *writer) bool(prop string, v *bool) {
+	if v != nil {
+		w.send(prop, als.FromBool(*v))
+	}
+}
+
+func (w *writer) string(prop string, v *string) {
+	if v != nil {
+		w.send(prop, *v)
+	}
+}
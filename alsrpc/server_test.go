@@ -0,0 +1,217 @@
+package alsrpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func newTestServer(t *testing.T) (*alstest.Server, *grpc.ClientConn) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/num_tracks", int32(2))
+	live.Set("/live/song/get/num_scenes", int32(4))
+
+	lis := bufconn.Listen(1 << 20)
+	s := NewServer(live.Client(t))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return live, conn
+}
+
+// seed stores a zero value for every property of a message, skipping the
+// index fields, so getters reading the whole object are answered
+func seed(live *alstest.Server, prefix string, m proto.Message, indices ...any) {
+	fields := m.ProtoReflect().Descriptor().Fields()
+	for i := len(indices); i < fields.Len(); i++ {
+		f := fields.Get(i)
+		var zero any
+		switch f.Kind() {
+		case protoreflect.FloatKind:
+			zero = float32(0)
+		case protoreflect.StringKind:
+			zero = ""
+		default:
+			zero = int32(0)
+		}
+		live.Set(prefix+"/get/"+string(f.Name()), append(append([]any{}, indices...), zero)...)
+	}
+}
+
+// TestGetTrack verifies every property is read and typed
+func TestGetTrack(t *testing.T) {
+	live, conn := newTestServer(t)
+	seed(live, "/live/track", &alspb.Track{}, int32(1))
+	live.Set("/live/track/get/name", int32(1), "Bass")
+	live.Set("/live/track/get/mute", int32(1), int32(1))
+	live.Set("/live/track/get/volume", int32(1), float32(0.85))
+
+	track, err := alspb.NewTrackServiceClient(conn).GetTrack(context.Background(), &alspb.TrackRef{Track: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), track.Track)
+	assert.Equal(t, "Bass", track.Name)
+	assert.True(t, track.Mute)
+	assert.False(t, track.Solo)
+	assert.Equal(t, float32(0.85), track.Volume)
+}
+
+// TestGetTrackConcurrent verifies concurrent calls for different tracks
+// each get their own track's replies
+func TestGetTrackConcurrent(t *testing.T) {
+	live, conn := newTestServer(t)
+	live.Set("/live/song/get/num_tracks", int32(6))
+	for i := range int32(6) {
+		seed(live, "/live/track", &alspb.Track{}, i)
+		live.Set("/live/track/get/name", i, fmt.Sprint("Track ", i))
+	}
+
+	client := alspb.NewTrackServiceClient(conn)
+	var wg sync.WaitGroup
+	for i := range int32(6) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			track, err := client.GetTrack(context.Background(), &alspb.TrackRef{Track: i})
+			if assert.NoError(t, err) {
+				assert.Equal(t, fmt.Sprint("Track ", i), track.Name)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// TestUpdateTrack verifies only set fields are written
+func TestUpdateTrack(t *testing.T) {
+	live, conn := newTestServer(t)
+
+	_, err := alspb.NewTrackServiceClient(conn).UpdateTrack(context.Background(), &alspb.TrackUpdate{
+		Track: 1,
+		Mute:  proto.Bool(true),
+		Name:  proto.String("Sub"),
+	})
+	require.NoError(t, err)
+
+	live.WaitFor(t, "/live/track/set/mute")
+	live.WaitFor(t, "/live/track/set/name")
+	args, _ := live.Get("/live/track/get/mute", int32(1))
+	assert.Equal(t, []any{int32(1), int32(1)}, args)
+	assert.Empty(t, live.Received("/live/track/set/solo"))
+}
+
+// TestStatusCodes verifies bad indices, missing objects and timeouts
+func TestStatusCodes(t *testing.T) {
+	_, conn := newTestServer(t)
+	ctx := context.Background()
+	tracks := alspb.NewTrackServiceClient(conn)
+
+	_, err := tracks.GetTrack(ctx, &alspb.TrackRef{Track: 5})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = tracks.GetTrack(ctx, &alspb.TrackRef{Track: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the fake never answers getters without a value
+	_, err = alspb.NewSceneServiceClient(conn).GetScene(ctx, &alspb.SceneRef{Scene: 0})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = alspb.NewClipServiceClient(conn).Fire(ctx, &alspb.ClipRef{Track: 0, Clip: 0})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = alspb.NewViewServiceClient(conn).Select(ctx, &alspb.SelectRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestNotes verifies notes are decoded, validated and written
+func TestNotes(t *testing.T) {
+	live, conn := newTestServer(t)
+	live.Set("/live/clip_slot/get/has_clip", int32(0), int32(2), int32(1))
+	live.Set("/live/clip/get/notes", int32(0), int32(2),
+		int32(60), float32(0), float32(1), int32(100), int32(0),
+		int32(64), float32(1), float32(0.5), int32(90), int32(1))
+
+	clips := alspb.NewClipServiceClient(conn)
+	ctx := context.Background()
+	notes, err := clips.GetNotes(ctx, &alspb.GetNotesRequest{Track: 0, Clip: 2})
+	require.NoError(t, err)
+	require.Len(t, notes.Notes, 2)
+	assert.Equal(t, int32(64), notes.Notes[1].Pitch)
+	assert.Equal(t, float32(0.5), notes.Notes[1].Duration)
+	assert.True(t, notes.Notes[1].Mute)
+
+	_, err = clips.AddNotes(ctx, &alspb.AddNotesRequest{Track: 0, Clip: 2, Notes: []*alspb.Note{
+		{Pitch: 67, StartTime: 2, Duration: 1, Velocity: 80},
+	}})
+	require.NoError(t, err)
+	msgs := live.WaitFor(t, "/live/clip/add/notes")
+	assert.Equal(t, []any{int32(0), int32(2), int32(67), float32(2), float32(1), int32(80), int32(0)}, msgs[0].Arguments)
+
+	_, err = clips.AddNotes(ctx, &alspb.AddNotesRequest{Track: 0, Clip: 2, Notes: []*alspb.Note{{Pitch: 200, Duration: 1}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	live.Set("/live/clip_slot/get/has_clip", int32(0), int32(3), int32(0))
+	_, err = clips.GetNotes(ctx, &alspb.GetNotesRequest{Track: 0, Clip: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestListen verifies listener updates stream until the call is cancelled
+func TestListen(t *testing.T) {
+	live, conn := newTestServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := alspb.NewListenerServiceClient(conn).Listen(ctx, &alspb.ListenRequest{Topics: []*alspb.Topic{
+		{Object: "track", Property: "volume", Indices: []int32{1}},
+	}})
+	require.NoError(t, err)
+
+	live.WaitFor(t, "/live/track/start_listen/volume")
+	live.Emit("/live/track/get/volume", int32(0), float32(0.1))
+	live.Emit("/live/track/get/volume", int32(1), float32(0.7))
+
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "volume", event.Topic.Property)
+	assert.Equal(t, []int32{1}, event.Topic.Indices)
+	require.Len(t, event.Values, 1)
+	assert.Equal(t, float32(0.7), event.Values[0].GetFloat())
+	assert.NotZero(t, event.TimeUnixNano)
+
+	cancel()
+	live.WaitFor(t, "/live/track/stop_listen/volume")
+}
+
+// TestListenInvalid verifies topics are validated before listening
+func TestListenInvalid(t *testing.T) {
+	_, conn := newTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream, err := alspb.NewListenerServiceClient(conn).Listen(ctx, &alspb.ListenRequest{Topics: []*alspb.Topic{
+		{Object: "track", Property: "volume"},
+	}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
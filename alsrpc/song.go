@@ -0,0 +1,185 @@
+package alsrpc
+
+import (
+	"context"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+)
+
+type songService struct {
+	alspb.UnimplementedSongServiceServer
+	*base
+}
+
+func (s *songService) GetSong(ctx context.Context, _ *alspb.Empty) (*alspb.Song, error) {
+	r := s.reader("/live/song")
+	song := &alspb.Song{
+		Tempo:                     r.float("tempo"),
+		IsPlaying:                 r.bool("is_playing"),
+		CurrentSongTime:           r.float("current_song_time"),
+		Metronome:                 r.bool("metronome"),
+		Loop:                      r.bool("loop"),
+		LoopStart:                 r.float("loop_start"),
+		LoopLength:                r.float("loop_length"),
+		RecordMode:                r.bool("record_mode"),
+		SessionRecord:             r.bool("session_record"),
+		SessionRecordStatus:       r.int("session_record_status"),
+		ArrangementOverdub:        r.bool("arrangement_overdub"),
+		BackToArranger:            r.bool("back_to_arranger"),
+		PunchIn:                   r.bool("punch_in"),
+		PunchOut:                  r.bool("punch_out"),
+		GrooveAmount:              r.float("groove_amount"),
+		SignatureNumerator:        r.int("signature_numerator"),
+		SignatureDenominator:      r.int("signature_denominator"),
+		ClipTriggerQuantization:   r.int("clip_trigger_quantization"),
+		MidiRecordingQuantization: r.int("midi_recording_quantization"),
+		NumTracks:                 r.int("num_tracks"),
+		NumScenes:                 r.int("num_scenes"),
+		SongLength:                r.float("song_length"),
+		CanUndo:                   r.bool("can_undo"),
+		CanRedo:                   r.bool("can_redo"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return song, nil
+}
+
+func (s *songService) UpdateSong(ctx context.Context, req *alspb.SongUpdate) (*alspb.Empty, error) {
+	w := s.writer("/live/song")
+	w.float("tempo", req.Tempo)
+	w.float("current_song_time", req.CurrentSongTime)
+	w.bool("metronome", req.Metronome)
+	w.bool("loop", req.Loop)
+	w.float("loop_start", req.LoopStart)
+	w.float("loop_length", req.LoopLength)
+	w.bool("record_mode", req.RecordMode)
+	w.bool("session_record", req.SessionRecord)
+	w.bool("arrangement_overdub", req.ArrangementOverdub)
+	w.bool("back_to_arranger", req.BackToArranger)
+	w.bool("punch_in", req.PunchIn)
+	w.bool("punch_out", req.PunchOut)
+	w.float("groove_amount", req.GrooveAmount)
+	w.int("signature_numerator", req.SignatureNumerator)
+	w.int("signature_denominator", req.SignatureDenominator)
+	w.int("clip_trigger_quantization", req.ClipTriggerQuantization)
+	w.int("midi_recording_quantization", req.MidiRecordingQuantization)
+	return empty, nil
+}
+
+func (s *songService) ListTracks(ctx context.Context, _ *alspb.Empty) (*alspb.Names, error) {
+	args, err := s.query("/live/song/get/track_names")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = als.String(arg)
+	}
+	return &alspb.Names{Names: names}, nil
+}
+
+// Actions
+
+func (s *songService) StartPlaying(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/start_playing")
+}
+
+func (s *songService) StopPlaying(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/stop_playing")
+}
+
+func (s *songService) ContinuePlaying(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/continue_playing")
+}
+
+func (s *songService) StopAllClips(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/stop_all_clips")
+}
+
+func (s *songService) TapTempo(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/tap_tempo")
+}
+
+func (s *songService) Undo(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/undo")
+}
+
+func (s *songService) Redo(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/redo")
+}
+
+func (s *songService) CaptureMidi(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/capture_midi")
+}
+
+func (s *songService) TriggerSessionRecord(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/trigger_session_record")
+}
+
+func (s *songService) JumpBy(ctx context.Context, req *alspb.JumpByRequest) (*alspb.Empty, error) {
+	return s.send("/live/song/jump_by", req.Beats)
+}
+
+func (s *songService) JumpToNextCue(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/jump_to_next_cue")
+}
+
+func (s *songService) JumpToPrevCue(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/jump_to_prev_cue")
+}
+
+func (s *songService) JumpToCuePoint(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	return s.send("/live/song/cue_point/jump", req.Index)
+}
+
+// Creating at -1 appends, as in Live.
+
+func (s *songService) CreateMidiTrack(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	return s.send("/live/song/create_midi_track", req.Index)
+}
+
+func (s *songService) CreateAudioTrack(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	return s.send("/live/song/create_audio_track", req.Index)
+}
+
+func (s *songService) CreateReturnTrack(context.Context, *alspb.Empty) (*alspb.Empty, error) {
+	return s.send("/live/song/create_return_track")
+}
+
+func (s *songService) CreateScene(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	return s.send("/live/song/create_scene", req.Index)
+}
+
+func (s *songService) DeleteTrack(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	if err := s.checkTrack(req.Index); err != nil {
+		return nil, err
+	}
+	return s.send("/live/song/delete_track", req.Index)
+}
+
+func (s *songService) DeleteReturnTrack(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	return s.send("/live/song/delete_return_track", req.Index)
+}
+
+func (s *songService) DeleteScene(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	if err := s.checkScene(req.Index); err != nil {
+		return nil, err
+	}
+	return s.send("/live/song/delete_scene", req.Index)
+}
+
+func (s *songService) DuplicateTrack(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	if err := s.checkTrack(req.Index); err != nil {
+		return nil, err
+	}
+	return s.send("/live/song/duplicate_track", req.Index)
+}
+
+func (s *songService) DuplicateScene(ctx context.Context, req *alspb.Index) (*alspb.Empty, error) {
+	if err := s.checkScene(req.Index); err != nil {
+		return nil, err
+	}
+	return s.send("/live/song/duplicate_scene", req.Index)
+}
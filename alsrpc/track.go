@@ -0,0 +1,175 @@
+package alsrpc
+
+import (
+	"context"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+)
+
+type trackService struct {
+	alspb.UnimplementedTrackServiceServer
+	*base
+}
+
+func (s *trackService) GetTrack(ctx context.Context, req *alspb.TrackRef) (*alspb.Track, error) {
+	if err := s.checkTrack(req.Track); err != nil {
+		return nil, err
+	}
+
+	r := s.reader("/live/track", req.Track)
+	track := &alspb.Track{
+		Track:                  req.Track,
+		Name:                   r.string("name"),
+		Mute:                   r.bool("mute"),
+		Solo:                   r.bool("solo"),
+		Arm:                    r.bool("arm"),
+		Volume:                 r.float("volume"),
+		Panning:                r.float("panning"),
+		Color:                  r.int("color"),
+		ColorIndex:             r.int("color_index"),
+		CurrentMonitoringState: r.int("current_monitoring_state"),
+		FoldState:              r.bool("fold_state"),
+		InputRoutingType:       r.string("input_routing_type"),
+		InputRoutingChannel:    r.string("input_routing_channel"),
+		OutputRoutingType:      r.string("output_routing_type"),
+		OutputRoutingChannel:   r.string("output_routing_channel"),
+		CanBeArmed:             r.bool("can_be_armed"),
+		HasAudioInput:          r.bool("has_audio_input"),
+		HasAudioOutput:         r.bool("has_audio_output"),
+		HasMidiInput:           r.bool("has_midi_input"),
+		HasMidiOutput:          r.bool("has_midi_output"),
+		IsFoldable:             r.bool("is_foldable"),
+		IsGrouped:              r.bool("is_grouped"),
+		IsVisible:              r.bool("is_visible"),
+		PlayingSlotIndex:       r.int("playing_slot_index"),
+		FiredSlotIndex:         r.int("fired_slot_index"),
+		OutputMeterLeft:        r.float("output_meter_left"),
+		OutputMeterRight:       r.float("output_meter_right"),
+		OutputMeterLevel:       r.float("output_meter_level"),
+		NumDevices:             r.int("num_devices"),
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return track, nil
+}
+
+func (s *trackService) UpdateTrack(ctx context.Context, req *alspb.TrackUpdate) (*alspb.Empty, error) {
+	if err := s.checkTrack(req.Track); err != nil {
+		return nil, err
+	}
+
+	w := s.writer("/live/track", req.Track)
+	w.string("name", req.Name)
+	w.bool("mute", req.Mute)
+	w.bool("solo", req.Solo)
+	w.bool("arm", req.Arm)
+	w.float("volume", req.Volume)
+	w.float("panning", req.Panning)
+	w.int("color", req.Color)
+	w.int("color_index", req.ColorIndex)
+	w.int("current_monitoring_state", req.CurrentMonitoringState)
+	w.bool("fold_state", req.FoldState)
+	w.string("input_routing_type", req.InputRoutingType)
+	w.string("input_routing_channel", req.InputRoutingChannel)
+	w.string("output_routing_type", req.OutputRoutingType)
+	w.string("output_routing_channel", req.OutputRoutingChannel)
+	return empty, nil
+}
+
+func (s *trackService) StopAllClips(ctx context.Context, req *alspb.TrackRef) (*alspb.Empty, error) {
+	if err := s.checkTrack(req.Track); err != nil {
+		return nil, err
+	}
+	return s.send("/live/track/stop_all_clips", req.Track)
+}
+
+func (s *trackService) GetSend(ctx context.Context, req *alspb.SendRef) (*alspb.Value, error) {
+	if err := s.checkTrack(req.Track); err != nil {
+		return nil, err
+	}
+	args, err := s.query("/live/track/get/send", req.Track, req.Send)
+	if err != nil {
+		return nil, err
+	}
+	return &alspb.Value{Value: als.Float(als.First(args))}, nil
+}
+
+func (s *trackService) SetSend(ctx context.Context, req *alspb.SetSendRequest) (*alspb.Empty, error) {
+	if err := s.checkTrack(req.Track); err != nil {
+		return nil, err
+	}
+	return s.send("/live/track/set/send", req.Track, req.Send, req.Value)
+}
+
+func (s *trackService) ListClips(ctx context.Context, req *alspb.TrackRef) (*alspb.ClipSummaries, error) {
+	return s.listClips("clips", req.Track, "name", "length", "color")
+}
+
+func (s *trackService) ListArrangementClips(ctx context.Context, req *alspb.TrackRef) (*alspb.ClipSummaries, error) {
+	return s.listClips("arrangement_clips", req.Track, "name", "length", "start_time")
+}
+
+func (s *trackService) listClips(kind string, track int32, columns ...string) (*alspb.ClipSummaries, error) {
+	rows, err := s.columns(kind, track, columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	clips := make([]*alspb.ClipSummary, len(rows))
+	for i, row := range rows {
+		c := &alspb.ClipSummary{Name: als.String(row["name"]), Length: als.Float(row["length"])}
+		if v, ok := row["color"]; ok {
+			c.Color = als.Int(v)
+		}
+		if v, ok := row["start_time"]; ok {
+			c.StartTime = als.Float(v)
+		}
+		clips[i] = c
+	}
+	return &alspb.ClipSummaries{Clips: clips}, nil
+}
+
+func (s *trackService) ListDevices(ctx context.Context, req *alspb.TrackRef) (*alspb.DeviceSummaries, error) {
+	rows, err := s.columns("devices", req.Track, "name", "type", "class_name")
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*alspb.DeviceSummary, len(rows))
+	for i, row := range rows {
+		devices[i] = &alspb.DeviceSummary{
+			Name:      als.String(row["name"]),
+			Type:      als.String(row["type"]),
+			ClassName: als.String(row["class_name"]),
+		}
+	}
+	return &alspb.DeviceSummaries{Devices: devices}, nil
+}
+
+// columns reads list properties of a track, e.g. clips/name, into rows. The
+// first column decides the number of rows.
+func (s *trackService) columns(kind string, track int32, columns ...string) ([]map[string]any, error) {
+	if err := s.checkTrack(track); err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]any
+	for c, column := range columns {
+		args, err := s.query("/live/track/get/"+kind+"/"+column, track)
+		if err != nil {
+			return nil, err
+		}
+		if c == 0 {
+			rows = make([]map[string]any, len(args))
+			for i := range rows {
+				rows[i] = make(map[string]any, len(columns))
+			}
+		}
+		for i := 0; i < len(args) && i < len(rows); i++ {
+			rows[i][column] = args[i]
+		}
+	}
+	return rows, nil
+}
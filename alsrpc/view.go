@@ -0,0 +1,67 @@
+package alsrpc
+
+import (
+	"context"
+	"github.com/matt0792/ableton-ctrl/als"
+
+	"github.com/matt0792/ableton-ctrl/alsrpc/alspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type viewService struct {
+	alspb.UnimplementedViewServiceServer
+	*base
+}
+
+func (s *viewService) GetSelection(ctx context.Context, _ *alspb.Empty) (*alspb.Selection, error) {
+	var args [4][]any
+	for i, prop := range []string{"selected_track", "selected_scene", "selected_clip", "selected_device"} {
+		var err error
+		if args[i], err = s.query("/live/view/get/" + prop); err != nil {
+			return nil, err
+		}
+	}
+
+	pair := func(args []any) (int32, int32) {
+		if len(args) < 2 {
+			return 0, 0
+		}
+		return als.Int(args[0]), als.Int(args[1])
+	}
+	clipTrack, clip := pair(args[2])
+	deviceTrack, device := pair(args[3])
+	return &alspb.Selection{
+		SelectedTrack:  als.Int(als.First(args[0])),
+		SelectedScene:  als.Int(als.First(args[1])),
+		SelectedClip:   &alspb.ClipRef{Track: clipTrack, Clip: clip},
+		SelectedDevice: &alspb.DeviceRef{Track: deviceTrack, Device: device},
+	}, nil
+}
+
+func (s *viewService) Select(ctx context.Context, req *alspb.SelectRequest) (*alspb.Empty, error) {
+	switch t := req.Target.(type) {
+	case *alspb.SelectRequest_Track:
+		if err := s.checkTrack(t.Track); err != nil {
+			return nil, err
+		}
+		return s.send("/live/view/set/selected_track", t.Track)
+	case *alspb.SelectRequest_Scene:
+		if err := s.checkScene(t.Scene); err != nil {
+			return nil, err
+		}
+		return s.send("/live/view/set/selected_scene", t.Scene)
+	case *alspb.SelectRequest_Clip:
+		if err := s.checkSlot(t.Clip.GetTrack(), t.Clip.GetClip()); err != nil {
+			return nil, err
+		}
+		return s.send("/live/view/set/selected_clip", t.Clip.GetTrack(), t.Clip.GetClip())
+	case *alspb.SelectRequest_Device:
+		if err := s.checkDevice(t.Device.GetTrack(), t.Device.GetDevice()); err != nil {
+			return nil, err
+		}
+		return s.send("/live/view/set/selected_device", t.Device.GetTrack(), t.Device.GetDevice())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "nothing to select")
+	}
+}
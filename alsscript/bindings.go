@@ -0,0 +1,290 @@
+package alsscript
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+	"github.com/matt0792/ableton-ctrl/alsex/tempo"
+)
+
+// curves are the names ramp_tempo accepts
+var curves = map[string]tempo.Curve{
+	"step":        tempo.Step,
+	"linear":      tempo.Linear,
+	"exponential": tempo.Exponential,
+}
+
+// globals are the names every script starts with
+func (e *Engine) globals() starlark.StringDict {
+	return starlark.StringDict{
+		"song":       e.song(),
+		"track":      starlark.NewBuiltin("track", e.trackBuiltin),
+		"scene":      starlark.NewBuiltin("scene", e.sceneBuiltin),
+		"on_beat":    starlark.NewBuiltin("on_beat", e.onBeat),
+		"on_bar":     starlark.NewBuiltin("on_bar", e.onBar),
+		"every":      starlark.NewBuiltin("every", e.every),
+		"at":         starlark.NewBuiltin("at", e.at),
+		"on":         starlark.NewBuiltin("on", e.on),
+		"ramp_tempo": starlark.NewBuiltin("ramp_tempo", e.rampTempo),
+	}
+}
+
+// song returns the song object
+func (e *Engine) song() *object {
+	song := e.client.Song
+	o := newObject("song", "song")
+	o.prop("tempo", e.get("/live/song/get/tempo"), setFloat(song.SetTempo))
+	o.prop("is_playing", e.getBool("/live/song/get/is_playing"), nil)
+	o.prop("current_song_time", e.get("/live/song/get/current_song_time"), setFloat(song.SetCurrentSongTime))
+	o.prop("signature_numerator", e.get("/live/song/get/signature_numerator"), setInt(song.SetSignatureNumerator))
+	o.prop("signature_denominator", e.get("/live/song/get/signature_denominator"), setInt(song.SetSignatureDenominator))
+	o.prop("metronome", e.getBool("/live/song/get/metronome"), setBool(song.SetMetronome))
+	o.prop("num_tracks", e.get("/live/song/get/num_tracks"), nil)
+	o.prop("num_scenes", e.get("/live/song/get/num_scenes"), nil)
+
+	for name, fn := range map[string]func(){
+		"play":             song.StartPlaying,
+		"stop":             song.StopPlaying,
+		"continue":         song.ContinuePlaying,
+		"stop_all_clips":   song.StopAllClips,
+		"tap_tempo":        song.TapTempo,
+		"undo":             song.Undo,
+		"redo":             song.Redo,
+		"jump_to_next_cue": song.JumpToNextCue,
+		"jump_to_prev_cue": song.JumpToPrevCue,
+	} {
+		o.method(name, action(fn))
+	}
+	o.method("jump_by", func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var beats number
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &beats); err != nil {
+			return nil, err
+		}
+		song.JumpBy(float32(beats))
+		return starlark.None, nil
+	})
+	return o
+}
+
+// trackBuiltin is track(name_or_index)
+func (e *Engine) trackBuiltin(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var ref starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &ref); err != nil {
+		return nil, err
+	}
+	index, name, err := find("track", ref, e.resolver.TrackNames, e.resolver.Track)
+	if err != nil {
+		return nil, err
+	}
+
+	track := e.client.Track
+	set := func(fn func(int32, bool), on bool) builtinFunc {
+		return action(func() { fn(index, on) })
+	}
+	o := newObject("track", fmt.Sprintf("track(%q)", name))
+	o.prop("index", constant(starlark.MakeInt(int(index))), nil)
+	o.prop("name", e.get("/live/track/get/name", index), setString(func(v string) { track.SetName(index, v) }))
+	o.prop("volume", e.get("/live/track/get/volume", index), setFloat(func(v float32) { track.SetVolume(index, v) }))
+	o.prop("panning", e.get("/live/track/get/panning", index), setFloat(func(v float32) { track.SetPanning(index, v) }))
+	o.prop("muted", e.getBool("/live/track/get/mute", index), setBool(func(v bool) { track.SetMute(index, v) }))
+	o.prop("soloed", e.getBool("/live/track/get/solo", index), setBool(func(v bool) { track.SetSolo(index, v) }))
+	o.prop("armed", e.getBool("/live/track/get/arm", index), setBool(func(v bool) { track.SetArm(index, v) }))
+	o.prop("playing_slot", e.get("/live/track/get/playing_slot_index", index), nil)
+
+	o.method("mute", set(track.SetMute, true))
+	o.method("unmute", set(track.SetMute, false))
+	o.method("solo", set(track.SetSolo, true))
+	o.method("unsolo", set(track.SetSolo, false))
+	o.method("arm", set(track.SetArm, true))
+	o.method("disarm", set(track.SetArm, false))
+	o.method("stop", action(func() { track.StopAllClips(index) }))
+	o.method("fire", func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var ref starlark.Value
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &ref); err != nil {
+			return nil, err
+		}
+		scene, _, err := find("scene", ref, e.resolver.SceneNames, e.resolver.Scene)
+		if err != nil {
+			return nil, err
+		}
+		e.client.ClipSlot.Fire(index, scene)
+		return starlark.None, nil
+	})
+	return o, nil
+}
+
+// sceneBuiltin is scene(name_or_index)
+func (e *Engine) sceneBuiltin(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var ref starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &ref); err != nil {
+		return nil, err
+	}
+	index, name, err := find("scene", ref, e.resolver.SceneNames, e.resolver.Scene)
+	if err != nil {
+		return nil, err
+	}
+
+	scene := e.client.Scene
+	o := newObject("scene", fmt.Sprintf("scene(%q)", name))
+	o.prop("index", constant(starlark.MakeInt(int(index))), nil)
+	o.prop("name", e.get("/live/scene/get/name", index), setString(func(v string) { scene.SetName(index, v) }))
+	o.method("fire", action(func() { scene.Fire(index) }))
+	return o, nil
+}
+
+// onBeat is on_beat(fn), calling fn(beat) on every beat
+func (e *Engine) onBeat(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var fn starlark.Callable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &fn); err != nil {
+		return nil, err
+	}
+	e.schedule(fn, func(tick func(scheduler.Tick)) *scheduler.Job {
+		return e.scheduler.Every(1, tick)
+	})
+	return starlark.None, nil
+}
+
+// onBar is on_bar(fn), calling fn(beat) at the start of every bar
+func (e *Engine) onBar(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var fn starlark.Callable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &fn); err != nil {
+		return nil, err
+	}
+	e.schedule(fn, e.scheduler.EveryBar)
+	return starlark.None, nil
+}
+
+// every is every(beats, fn, offset=0), calling fn(beat) every beats
+func (e *Engine) every(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var beats, offset number
+	var fn starlark.Callable
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "beats", &beats, "fn", &fn, "offset?", &offset); err != nil {
+		return nil, err
+	}
+	if beats <= 0 {
+		return nil, fmt.Errorf("%s: beats must be positive, got %g", b.Name(), float64(beats))
+	}
+	e.schedule(fn, func(tick func(scheduler.Tick)) *scheduler.Job {
+		return e.scheduler.Every(float64(beats), tick).Offset(float64(offset))
+	})
+	return starlark.None, nil
+}
+
+// at is at(beat, fn), calling fn(beat) once when the playhead reaches beat
+func (e *Engine) at(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var beat number
+	var fn starlark.Callable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &beat, &fn); err != nil {
+		return nil, err
+	}
+	e.schedule(fn, func(tick func(scheduler.Tick)) *scheduler.Job {
+		return e.scheduler.At(float64(beat), tick)
+	})
+	return starlark.None, nil
+}
+
+// schedule adds a job calling fn with the beat. The scheduler runs jobs
+// ahead of the playhead, so the call waits for the beat itself. Calls that
+// can't keep up are merged, see latest.
+func (e *Engine) schedule(fn starlark.Callable, add func(func(scheduler.Tick)) *scheduler.Job) {
+	s := e.script
+	pending := &latest{}
+	job := add(func(tick scheduler.Tick) {
+		time.AfterFunc(tick.Delay(), func() {
+			pending.post(e, s, fn, tick.Beat)
+		})
+	})
+	s.cleanup = append(s.cleanup, job.Cancel)
+	e.startScheduler()
+}
+
+// on is on(topic, fn), calling fn with the new values on every update of a
+// listener topic such as "song/tempo" or "track/volume/0"
+func (e *Engine) on(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var topic string
+	var fn starlark.Callable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &topic, &fn); err != nil {
+		return nil, err
+	}
+	t, err := listen.ParseTopic(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	s := e.script
+	unsubscribe, err := e.hub.Subscribe(t, func(ev listen.Event) {
+		values := make([]starlark.Value, len(ev.Values))
+		for i, v := range ev.Values {
+			values[i] = toValue(v)
+		}
+		e.callback(s, fn, values...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.cleanup = append(s.cleanup, unsubscribe)
+	return starlark.None, nil
+}
+
+// rampTempo is ramp_tempo(to, beats, curve="linear"), starting at the next
+// beat
+func (e *Engine) rampTempo(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var to, beats number
+	curve := "linear"
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "to", &to, "beats", &beats, "curve?", &curve); err != nil {
+		return nil, err
+	}
+	c, ok := curves[curve]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown curve %q, expected step, linear or exponential", b.Name(), curve)
+	}
+
+	e.startScheduler()
+	job := e.tempo.Ramp(float64(to), float64(beats), c)
+	e.script.cleanup = append(e.script.cleanup, job.Cancel)
+	return starlark.None, nil
+}
+
+// find resolves an object by index, or by a name pattern as alsctl takes
+// them, returning its index and name
+func find(kind string, ref starlark.Value, names func() ([]string, error), byName func(string) (int32, error)) (int32, string, error) {
+	var index int32
+	switch ref := ref.(type) {
+	case starlark.Int:
+		i, ok := ref.Int64()
+		if !ok || i < 0 || i > math.MaxInt32 {
+			return 0, "", fmt.Errorf("%s %s not found", kind, ref)
+		}
+		index = int32(i)
+	case starlark.String:
+		i, err := byName(string(ref))
+		if err != nil {
+			return 0, "", err
+		}
+		index = i
+	default:
+		return 0, "", fmt.Errorf("%s: expected a name or index, got %s", kind, ref.Type())
+	}
+
+	list, err := names()
+	if err != nil {
+		return 0, "", err
+	}
+	if int(index) >= len(list) {
+		return 0, "", fmt.Errorf("%s %d not found, there are %d", kind, index, len(list))
+	}
+	return index, list[index], nil
+}
+
+// beatValue passes whole beats as ints, which scripts compare with ==
+func beatValue(beat float64) starlark.Value {
+	if beat == float64(int64(beat)) {
+		return starlark.MakeInt64(int64(beat))
+	}
+	return starlark.Float(beat)
+}
@@ -0,0 +1,342 @@
+// Package alsscript runs Starlark scripts against Live, so automations can be
+// written without Go:
+//
+//	song.tempo = 128
+//	track("Bass").mute()
+//
+//	def drop(beat):
+//	    if beat == 64:
+//	        scene("Drop").fire()
+//
+//	on_beat(drop)
+//
+// Scripts are sandboxed: there is no load, file or network access, and every
+// run of a script or callback is limited to MaxSteps. Callbacks a script
+// registers stay active until the script is reloaded or the engine closed.
+//
+// Tracks and scenes are found by index or by name pattern, as in alsctl:
+// plain names ignore case, and globs such as "lead*" must match exactly one.
+//
+// Scripts and their callbacks run one at a time on the engine's goroutine,
+// so they never race each other.
+package alsscript
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+	"github.com/matt0792/ableton-ctrl/alsex/scheduler"
+	"github.com/matt0792/ableton-ctrl/alsex/tempo"
+)
+
+// DefaultMaxSteps is the default computation limit of each run.
+const DefaultMaxSteps = 1_000_000
+
+// queueSize is how many callbacks may wait to run. Events arriving while it's
+// full are dropped and reported.
+const queueSize = 256
+
+// Opts configures an Engine.
+type Opts struct {
+	// Output receives print output and errors raised by callbacks. Defaults
+	// to stderr.
+	Output io.Writer
+	// MaxSteps limits the computation of each run of a script or callback,
+	// so a runaway loop can't hang the show.
+	MaxSteps uint64
+	// Scheduler times beat callbacks and tempo ramps, e.g. one shared with
+	// a show runner. The engine starts it when a script first schedules
+	// something but leaves stopping it to the caller. Defaults to a
+	// scheduler of the engine's own, stopped on Close.
+	Scheduler *scheduler.Scheduler
+}
+
+// Engine runs one script at a time. Loading a script replaces the previous
+// one and its callbacks.
+type Engine struct {
+	client    *als.Client
+	hub       *listen.Hub
+	resolver  *resolve.Resolver
+	scheduler *scheduler.Scheduler
+	tempo     *tempo.Controller
+	opts      Opts
+
+	predeclared starlark.StringDict
+
+	calls chan func()
+	done  chan struct{}
+	once  sync.Once
+
+	// owned by the engine goroutine
+	script  *script
+	started bool
+	own     bool // the scheduler is the engine's own, stopped on Close
+}
+
+// script is a loaded script and what it registered
+type script struct {
+	name    string
+	cleanup []func()
+}
+
+// New creates an engine for the client and starts its goroutine. Call Close
+// to stop it.
+func New(client *als.Client, opts Opts) *Engine {
+	if opts.Output == nil {
+		opts.Output = os.Stderr
+	}
+	if opts.MaxSteps == 0 {
+		opts.MaxSteps = DefaultMaxSteps
+	}
+
+	s := opts.Scheduler
+	own := s == nil
+	if own {
+		s = scheduler.New(client, scheduler.Opts{})
+	}
+	e := &Engine{
+		client:    client,
+		hub:       listen.Shared(client),
+		resolver:  resolve.New(client),
+		scheduler: s,
+		tempo:     tempo.New(client, s),
+		opts:      opts,
+		calls:     make(chan func(), queueSize),
+		done:      make(chan struct{}),
+		own:       own,
+	}
+	// an unstarted resolver still works, it just reads names again when
+	// nothing matches
+	_ = e.resolver.Start()
+	e.predeclared = e.globals()
+	go e.run()
+	return e
+}
+
+func (e *Engine) run() {
+	for {
+		select {
+		case fn := <-e.calls:
+			fn()
+		case <-e.done:
+			return
+		}
+	}
+}
+
+// do runs fn on the engine goroutine and waits for it
+func (e *Engine) do(fn func()) {
+	finished := make(chan struct{})
+	select {
+	case e.calls <- func() { fn(); close(finished) }:
+	case <-e.done:
+		return
+	}
+	select {
+	case <-finished:
+	case <-e.done:
+	}
+}
+
+// post queues fn without waiting, for listener and scheduler callbacks that
+// must never block. It reports false when the queue is full and fn was
+// dropped.
+func (e *Engine) post(fn func()) bool {
+	select {
+	case e.calls <- fn:
+		return true
+	default:
+		fmt.Fprintln(e.opts.Output, "alsscript: script busy, event dropped")
+		return false
+	}
+}
+
+// Load compiles and runs a script, replacing the current one. A script that
+// doesn't compile leaves the current one running. One that fails while
+// running is unloaded, along with anything it registered.
+func (e *Engine) Load(name string, src []byte) error {
+	_, prog, err := starlark.SourceProgramOptions(&syntax.FileOptions{}, name, src, e.predeclared.Has)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-e.done:
+		return errors.New("alsscript: engine closed")
+	default:
+	}
+
+	e.do(func() {
+		e.unload()
+		e.script = &script{name: name}
+		if _, err = prog.Init(e.thread(name), e.predeclared); err != nil {
+			e.unload()
+		}
+	})
+	return unwrap(err)
+}
+
+// LoadFile loads the script at path.
+func (e *Engine) LoadFile(path string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return e.Load(path, src)
+}
+
+// Unload stops the current script's callbacks.
+func (e *Engine) Unload() {
+	e.do(e.unload)
+}
+
+func (e *Engine) unload() {
+	if e.script == nil {
+		return
+	}
+	for _, fn := range e.script.cleanup {
+		fn()
+	}
+	e.script = nil
+}
+
+// Close unloads the script and stops the engine.
+func (e *Engine) Close() {
+	e.once.Do(func() {
+		e.do(func() {
+			e.unload()
+			if e.started && e.own {
+				e.scheduler.Stop()
+			}
+		})
+		close(e.done)
+		e.resolver.Stop()
+	})
+}
+
+// Watch loads the script at path and reloads it whenever the file changes,
+// checking every interval, until stop is closed. Errors loading it are
+// reported to Output and the previous version keeps running.
+func (e *Engine) Watch(path string, interval time.Duration, stop <-chan struct{}) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := e.LoadFile(path); err != nil {
+		fmt.Fprintln(e.opts.Output, err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+
+		latest, err := os.Stat(path)
+		if err != nil || (latest.ModTime().Equal(info.ModTime()) && latest.Size() == info.Size()) {
+			continue
+		}
+		info = latest
+		if err := e.LoadFile(path); err != nil {
+			fmt.Fprintln(e.opts.Output, err)
+			continue
+		}
+		fmt.Fprintf(e.opts.Output, "alsscript: reloaded %s\n", path)
+	}
+}
+
+// thread returns a sandboxed thread. Load is left unset, so scripts can't
+// load modules.
+func (e *Engine) thread(name string) *starlark.Thread {
+	t := &starlark.Thread{
+		Name: name,
+		Print: func(_ *starlark.Thread, msg string) {
+			fmt.Fprintln(e.opts.Output, msg)
+		},
+	}
+	t.SetMaxExecutionSteps(e.opts.MaxSteps)
+	return t
+}
+
+// callback queues a call to fn from the script s
+func (e *Engine) callback(s *script, fn starlark.Callable, args ...starlark.Value) {
+	e.post(func() { e.call(s, fn, args...) })
+}
+
+// call runs fn from the script s on the engine goroutine. It's skipped if s
+// has been replaced.
+func (e *Engine) call(s *script, fn starlark.Callable, args ...starlark.Value) {
+	if e.script != s {
+		return
+	}
+	if _, err := starlark.Call(e.thread(s.name), fn, args, nil); err != nil {
+		fmt.Fprintln(e.opts.Output, unwrap(err))
+	}
+}
+
+// latest is the newest beat for a scheduled callback. Beats arriving while
+// a call is queued are merged into it, so a busy script gets the current
+// beat instead of a backlog, and a beat dropped while the queue is full is
+// made up by the next.
+type latest struct {
+	mu     sync.Mutex
+	beat   float64
+	queued bool
+}
+
+// post queues a call to fn with beat, or merges beat into the queued one
+func (l *latest) post(e *Engine, s *script, fn starlark.Callable, beat float64) {
+	l.mu.Lock()
+	l.beat = beat
+	queued := l.queued
+	l.queued = true
+	l.mu.Unlock()
+	if queued {
+		return
+	}
+
+	ok := e.post(func() {
+		l.mu.Lock()
+		beat := l.beat
+		l.queued = false
+		l.mu.Unlock()
+		e.call(s, fn, beatValue(beat))
+	})
+	if !ok {
+		l.mu.Lock()
+		l.queued = false
+		l.mu.Unlock()
+	}
+}
+
+// startScheduler starts following the playhead the first time a script
+// schedules something
+func (e *Engine) startScheduler() {
+	if !e.started {
+		e.scheduler.Start()
+		e.started = true
+	}
+}
+
+// unwrap replaces an evaluation error with its backtrace, which names the
+// script line
+func unwrap(err error) error {
+	var eval *starlark.EvalError
+	if errors.As(err, &eval) {
+		return errors.New(eval.Backtrace())
+	}
+	return err
+}
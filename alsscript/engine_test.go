@@ -0,0 +1,172 @@
+package alsscript
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is written by callbacks while tests read it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func newTestEngine(t *testing.T) (*alstest.Server, *Engine, *syncBuffer) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/tempo", float32(120))
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Drop")
+	live.Set("/live/track/get/mute", int32(1), int32(1))
+
+	out := &syncBuffer{}
+	e := New(live.Client(t), Opts{Output: out, MaxSteps: 10_000})
+	t.Cleanup(e.Close)
+	return live, e, out
+}
+
+func eventually(t *testing.T, fn func() bool) {
+	t.Helper()
+	assert.Eventually(t, fn, time.Second, 5*time.Millisecond)
+}
+
+// TestLoad verifies scripts read and control Live by name or pattern
+func TestLoad(t *testing.T) {
+	live, e, out := newTestEngine(t)
+
+	script := `
+song.tempo = 128
+track("bass").mute()
+track(0).volume = 0.5
+scene("Drop").fire()
+track("Drums").fire("Intro")
+track("b*").solo()
+print(song.tempo, track("Bass").muted, track("Bass"))
+`
+	require.NoError(t, e.Load("show.star", []byte(script)))
+
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	assert.Equal(t, []any{float32(128)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/track/set/mute")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/track/set/volume")
+	assert.Equal(t, []any{int32(0), float32(0.5)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/scene/fire")
+	assert.Equal(t, []any{int32(1)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/clip_slot/fire")
+	assert.Equal(t, []any{int32(0), int32(0)}, msgs[0].Arguments)
+	msgs = live.WaitFor(t, "/live/track/set/solo")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+	assert.Equal(t, "128.0 True track(\"Bass\")\n", out.String())
+}
+
+// TestSandbox verifies errors name the script line, and scripts can't load
+// modules or run forever
+func TestSandbox(t *testing.T) {
+	_, e, _ := newTestEngine(t)
+
+	err := e.Load("show.star", []byte("\ntrack(\"Keys\").mute()\n"))
+	assert.ErrorContains(t, err, "show.star:2")
+	assert.ErrorContains(t, err, `no track matches "Keys"`)
+
+	err = e.Load("show.star", []byte("song.num_tracks = 3\n"))
+	assert.ErrorContains(t, err, "song.num_tracks is read-only")
+
+	err = e.Load("show.star", []byte(`load("os.star", "os")`))
+	assert.Error(t, err)
+
+	err = e.Load("show.star", []byte("def f():\n    while True:\n        pass\nf()\n"))
+	assert.Error(t, err)
+
+	err = e.Load("show.star", []byte("def f():\n    for i in range(1000000000):\n        pass\nf()\n"))
+	assert.ErrorContains(t, err, "too many steps")
+}
+
+// TestOn verifies listener callbacks run until the script is replaced
+func TestOn(t *testing.T) {
+	live, e, out := newTestEngine(t)
+
+	script := `
+def changed(volume):
+    print("volume", volume)
+
+on("track/volume/1", changed)
+`
+	require.NoError(t, e.Load("show.star", []byte(script)))
+	live.WaitFor(t, "/live/track/start_listen/volume")
+
+	live.Emit("/live/track/get/volume", int32(1), float32(0.25))
+	eventually(t, func() bool { return out.String() == "volume 0.25\n" })
+
+	// a script that doesn't compile leaves the old one running
+	assert.Error(t, e.Load("show.star", []byte("def (")))
+	assert.Empty(t, live.Received("/live/track/stop_listen/volume"))
+
+	require.NoError(t, e.Load("show.star", []byte(`print("replaced")`)))
+	live.WaitFor(t, "/live/track/stop_listen/volume")
+}
+
+// TestOnBeat verifies beat callbacks follow the playhead
+func TestOnBeat(t *testing.T) {
+	live, e, out := newTestEngine(t)
+	live.Set("/live/song/get/tempo", float32(6000))
+	live.Set("/live/song/get/is_playing", int32(1))
+	live.Set("/live/song/get/current_song_time", float32(0))
+
+	script := `
+def beat(b):
+    if b == 4:
+        print("four")
+
+on_beat(beat)
+`
+	require.NoError(t, e.Load("show.star", []byte(script)))
+	live.WaitFor(t, "/live/song/start_listen/beat")
+	eventually(t, func() bool { return strings.Contains(out.String(), "four\n") })
+
+	e.Unload()
+	assert.NoError(t, e.Load("show.star", []byte("ramp_tempo(140, 8)")))
+	assert.ErrorContains(t, e.Load("show.star", []byte(`ramp_tempo(140, 8, "wobble")`)), "unknown curve")
+}
+
+// TestWatch verifies scripts reload when the file changes
+func TestWatch(t *testing.T) {
+	live, e, out := newTestEngine(t)
+
+	path := filepath.Join(t.TempDir(), "show.star")
+	require.NoError(t, os.WriteFile(path, []byte("song.tempo = 100\n"), 0o644))
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() { done <- e.Watch(path, 5*time.Millisecond, stop) }()
+	live.WaitFor(t, "/live/song/set/tempo")
+
+	require.NoError(t, os.WriteFile(path, []byte("song.tempo = 110 # faster\n"), 0o644))
+	eventually(t, func() bool { return len(live.Received("/live/song/set/tempo")) == 2 })
+	assert.Contains(t, out.String(), "reloaded "+path)
+
+	close(stop)
+	assert.NoError(t, <-done)
+}
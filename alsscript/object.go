@@ -0,0 +1,201 @@
+package alsscript
+
+import (
+	"fmt"
+	"sort"
+
+	"go.starlark.net/starlark"
+
+	"github.com/matt0792/ableton-ctrl/als"
+)
+
+// object is a Live object seen by scripts. Reading a property asks Live,
+// assigning one sets it in Live.
+type object struct {
+	kind    string
+	label   string
+	props   map[string]property
+	methods map[string]*starlark.Builtin
+}
+
+type property struct {
+	get func() (starlark.Value, error)
+	set func(starlark.Value) error // nil for read-only properties
+}
+
+type builtinFunc = func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error)
+
+var (
+	_ starlark.HasAttrs    = (*object)(nil)
+	_ starlark.HasSetField = (*object)(nil)
+)
+
+func newObject(kind, label string) *object {
+	return &object{
+		kind:    kind,
+		label:   label,
+		props:   map[string]property{},
+		methods: map[string]*starlark.Builtin{},
+	}
+}
+
+func (o *object) prop(name string, get func() (starlark.Value, error), set func(starlark.Value) error) {
+	o.props[name] = property{get: get, set: set}
+}
+
+func (o *object) method(name string, fn builtinFunc) {
+	o.methods[name] = starlark.NewBuiltin(name, fn).BindReceiver(o)
+}
+
+func (o *object) String() string        { return o.label }
+func (o *object) Type() string          { return o.kind }
+func (o *object) Freeze()               {}
+func (o *object) Truth() starlark.Bool  { return starlark.True }
+func (o *object) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", o.kind) }
+
+func (o *object) Attr(name string) (starlark.Value, error) {
+	if m, ok := o.methods[name]; ok {
+		return m, nil
+	}
+	if p, ok := o.props[name]; ok {
+		return p.get()
+	}
+	return nil, nil
+}
+
+func (o *object) AttrNames() []string {
+	names := make([]string, 0, len(o.props)+len(o.methods))
+	for name := range o.props {
+		names = append(names, name)
+	}
+	for name := range o.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (o *object) SetField(name string, v starlark.Value) error {
+	p, ok := o.props[name]
+	if !ok {
+		return starlark.NoSuchAttrError(fmt.Sprintf("%s has no property %s", o.kind, name))
+	}
+	if p.set == nil {
+		return fmt.Errorf("%s.%s is read-only", o.kind, name)
+	}
+	if err := p.set(v); err != nil {
+		return fmt.Errorf("%s.%s: %w", o.kind, name, err)
+	}
+	return nil
+}
+
+// number unpacks an int or float argument
+type number float64
+
+func (n *number) Unpack(v starlark.Value) error {
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return fmt.Errorf("got %s, want number", v.Type())
+	}
+	*n = number(f)
+	return nil
+}
+
+// constant is a property that never changes
+func constant(v starlark.Value) func() (starlark.Value, error) {
+	return func() (starlark.Value, error) { return v, nil }
+}
+
+// get reads a property from Live. It asks with client.Get rather than the
+// typed getters, so Live not answering is an error instead of a zero value
+// the script would act on.
+func (e *Engine) get(addr string, indices ...any) func() (starlark.Value, error) {
+	return func() (starlark.Value, error) {
+		args, err := e.client.Get(addr, indices...)
+		if err != nil {
+			return nil, err
+		}
+		return toValue(als.First(args)), nil
+	}
+}
+
+// getBool reads a switch, which Live sends as 1 or 0
+func (e *Engine) getBool(addr string, indices ...any) func() (starlark.Value, error) {
+	return func() (starlark.Value, error) {
+		args, err := e.client.Get(addr, indices...)
+		if err != nil {
+			return nil, err
+		}
+		return starlark.Bool(als.Bool(als.First(args))), nil
+	}
+}
+
+func setFloat(set func(float32)) func(starlark.Value) error {
+	return func(v starlark.Value) error {
+		f, ok := starlark.AsFloat(v)
+		if !ok {
+			return fmt.Errorf("expected a number, got %s", v.Type())
+		}
+		set(float32(f))
+		return nil
+	}
+}
+
+func setInt(set func(int32)) func(starlark.Value) error {
+	return func(v starlark.Value) error {
+		var i int32
+		if err := starlark.AsInt(v, &i); err != nil {
+			return fmt.Errorf("expected an int, got %s", v.Type())
+		}
+		set(i)
+		return nil
+	}
+}
+
+func setBool(set func(bool)) func(starlark.Value) error {
+	return func(v starlark.Value) error {
+		set(bool(v.Truth()))
+		return nil
+	}
+}
+
+func setString(set func(string)) func(starlark.Value) error {
+	return func(v starlark.Value) error {
+		s, ok := starlark.AsString(v)
+		if !ok {
+			return fmt.Errorf("expected a string, got %s", v.Type())
+		}
+		set(s)
+		return nil
+	}
+}
+
+// action is a method taking no arguments that calls fn
+func action(fn func()) builtinFunc {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+			return nil, err
+		}
+		fn()
+		return starlark.None, nil
+	}
+}
+
+// toValue converts an OSC argument for scripts
+func toValue(v any) starlark.Value {
+	switch v := v.(type) {
+	case int32:
+		return starlark.MakeInt(int(v))
+	case int64:
+		return starlark.MakeInt64(v)
+	case float32:
+		return starlark.Float(v)
+	case float64:
+		return starlark.Float(v)
+	case string:
+		return starlark.String(v)
+	case bool:
+		return starlark.Bool(v)
+	}
+	return starlark.None
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// argKind is what an argument names, used for completion
+type argKind int
+
+const (
+	argNone argKind = iota
+	argTrack
+	argScene
+	argDevice // on the track named by the previous argument
+	argOnOff
+	argTopic
+	argCommand
+)
+
+type command struct {
+	name  string
+	usage string
+	help  string
+	args  []argKind
+	min   int
+	max   int // -1 for no limit
+	run   func(sh *shell, args []string) error
+}
+
+// commands is filled in init, as help refers to it
+var commands []command
+
+func init() {
+	commands = []command{
+		{"help", "help [command]", "list commands or describe one", []argKind{argCommand}, 0, 1, runHelp},
+		{"tempo", "tempo [bpm]", "show or set the tempo", nil, 0, 1, runTempo},
+		{"play", "play", "start playback", nil, 0, 0, action("/live/song/start_playing")},
+		{"stop", "stop", "stop playback", nil, 0, 0, action("/live/song/stop_playing")},
+		{"continue", "continue", "continue playback from the current position", nil, 0, 0, action("/live/song/continue_playing")},
+		{"tracks", "tracks", "list tracks with their mixer state", nil, 0, 0, runTracks},
+		{"scenes", "scenes", "list scenes", nil, 0, 0, runScenes},
+		{"devices", "devices <track>", "list a track's devices", []argKind{argTrack}, 1, 1, runDevices},
+		{"params", "params <track> <device>", "list a device's parameters", []argKind{argTrack, argDevice}, 2, 2, runParams},
+		{"param", "param <track> <device> <parameter> <value>", "set a device parameter by index or name", []argKind{argTrack, argDevice}, 4, 4, runParam},
+		{"mute", "mute <track> [on|off]", "toggle or set mute", []argKind{argTrack, argOnOff}, 1, 2, toggle("mute")},
+		{"solo", "solo <track> [on|off]", "toggle or set solo", []argKind{argTrack, argOnOff}, 1, 2, toggle("solo")},
+		{"arm", "arm <track> [on|off]", "toggle or set record arm", []argKind{argTrack, argOnOff}, 1, 2, toggle("arm")},
+		{"volume", "volume <track> [0-1]", "show or set a track's volume", []argKind{argTrack}, 1, 2, runVolume},
+		{"fire", "fire <track> <slot>", "launch a clip", []argKind{argTrack, argScene}, 2, 2, runFire},
+		{"scene", "scene <scene>", "launch a scene", []argKind{argScene}, 1, 1, runScene},
+		{"stopclips", "stopclips [track]", "stop every clip, or a track's clips", []argKind{argTrack}, 0, 1, runStopClips},
+		{"watch", "watch [topic]...", "print updates, e.g. watch tempo or watch track/volume/Bass", []argKind{argTopic}, 0, -1, runWatch},
+		{"unwatch", "unwatch [topic]...", "stop watching topics, or everything", []argKind{argTopic}, 0, -1, runUnwatch},
+		{"sleep", "sleep <duration>", "pause a batch file, e.g. sleep 500ms", nil, 1, 1, runSleep},
+		{"exit", "exit", "leave the REPL", nil, 0, 0, func(*shell, []string) error { return errExit }},
+	}
+}
+
+func lookup(name string) (command, bool) {
+	if name == "quit" {
+		name = "exit"
+	}
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+func runHelp(sh *shell, args []string) error {
+	if len(args) == 1 {
+		c, ok := lookup(args[0])
+		if !ok {
+			return fmt.Errorf("unknown command %q", args[0])
+		}
+		sh.printf("%s\n  %s\n", c.usage, c.help)
+		return nil
+	}
+	for _, c := range commands {
+		sh.printf("  %-44s %s\n", c.usage, c.help)
+	}
+	sh.printf("\nTracks, scenes, devices and parameters may be given by index or name,\nor by a pattern like Vox* matching one name.\n")
+	return nil
+}
+
+func action(addr string) func(*shell, []string) error {
+	return func(sh *shell, _ []string) error {
+		sh.client.Send(addr)
+		return nil
+	}
+}
+
+func runTempo(sh *shell, args []string) error {
+	if len(args) == 1 {
+		bpm, err := strconv.ParseFloat(args[0], 32)
+		if err != nil || bpm < 20 || bpm > 999 {
+			return fmt.Errorf("invalid tempo %q, expected 20-999", args[0])
+		}
+		sh.client.Song.SetTempo(float32(bpm))
+		return nil
+	}
+
+	sh.printf("%.2f\n", sh.client.Song.GetTempo())
+	return nil
+}
+
+func runTracks(sh *shell, _ []string) error {
+	names, err := sh.resolver.TrackNames()
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		track := int32(i)
+		flags := ""
+		for _, prop := range trackSwitches {
+			if prop.get(sh, track) {
+				flags += strings.ToUpper(prop.name[:1])
+			} else {
+				flags += "-"
+			}
+		}
+		sh.printf("%3d  %s  %.2f  %s\n", i, flags, sh.client.Track.GetVolume(track), name)
+	}
+	return nil
+}
+
+// trackSwitch is a track on/off property
+type trackSwitch struct {
+	name string
+	get  func(sh *shell, track int32) bool
+	set  func(sh *shell, track int32, on bool)
+}
+
+var trackSwitches = []trackSwitch{
+	{"mute", func(sh *shell, t int32) bool { return sh.client.Track.GetMute(t) }, func(sh *shell, t int32, on bool) { sh.client.Track.SetMute(t, on) }},
+	{"solo", func(sh *shell, t int32) bool { return sh.client.Track.GetSolo(t) }, func(sh *shell, t int32, on bool) { sh.client.Track.SetSolo(t, on) }},
+	{"arm", func(sh *shell, t int32) bool { return sh.client.Track.GetArm(t) }, func(sh *shell, t int32, on bool) { sh.client.Track.SetArm(t, on) }},
+}
+
+func runScenes(sh *shell, _ []string) error {
+	names, err := sh.resolver.SceneNames()
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		sh.printf("%3d  %s\n", i, name)
+	}
+	return nil
+}
+
+func runDevices(sh *shell, args []string) error {
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	names, err := sh.resolver.DeviceNames(track)
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		sh.printf("%3d  %s\n", i, name)
+	}
+	return nil
+}
+
+// parameters reads a device's parameter names, values and ranges
+func (sh *shell) parameters(track, device int32) (names []string, columns [3][]float32) {
+	api := sh.client.Device
+	names = api.GetParametersName(track, device)
+	columns = [3][]float32{
+		api.GetParametersValue(track, device),
+		api.GetParametersMin(track, device),
+		api.GetParametersMax(track, device),
+	}
+	return names, columns
+}
+
+func runParams(sh *shell, args []string) error {
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	device, err := sh.device(track, args[1])
+	if err != nil {
+		return err
+	}
+	names, columns := sh.parameters(track, device)
+	for i, name := range names {
+		sh.printf("%3d  %-24s %8.3f  [%g, %g]\n", i, name, at(columns[0], i), at(columns[1], i), at(columns[2], i))
+	}
+	return nil
+}
+
+func runParam(sh *shell, args []string) error {
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	device, err := sh.device(track, args[1])
+	if err != nil {
+		return err
+	}
+	names, columns := sh.parameters(track, device)
+	param, err := index("parameter", args[2],
+		func() ([]string, error) { return names, nil },
+		func(pattern string) (int32, error) { return sh.resolver.Parameter(track, device, pattern) })
+	if err != nil {
+		return err
+	}
+	value, err := strconv.ParseFloat(args[3], 32)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", args[3])
+	}
+	lo, hi := at(columns[1], int(param)), at(columns[2], int(param))
+	if float32(value) < lo || float32(value) > hi {
+		return fmt.Errorf("%s ranges from %g to %g", names[param], lo, hi)
+	}
+	sh.client.Device.SetParameterValue(track, device, param, float32(value))
+	return nil
+}
+
+func at(column []float32, i int) float32 {
+	if i < len(column) {
+		return column[i]
+	}
+	return 0
+}
+
+// toggle sets a track switch, or flips it without a value
+func toggle(name string) func(*shell, []string) error {
+	var prop trackSwitch
+	for _, s := range trackSwitches {
+		if s.name == name {
+			prop = s
+		}
+	}
+	return func(sh *shell, args []string) error {
+		track, err := sh.track(args[0])
+		if err != nil {
+			return err
+		}
+
+		var on bool
+		if len(args) == 2 {
+			switch args[1] {
+			case "on", "1", "true":
+				on = true
+			case "off", "0", "false":
+			default:
+				return fmt.Errorf("expected on or off, got %q", args[1])
+			}
+		} else {
+			on = !prop.get(sh, track)
+		}
+		prop.set(sh, track, on)
+		return nil
+	}
+}
+
+func runVolume(sh *shell, args []string) error {
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	if len(args) == 2 {
+		v, err := strconv.ParseFloat(args[1], 32)
+		if err != nil || v < 0 || v > 1 {
+			return fmt.Errorf("invalid volume %q, expected 0-1", args[1])
+		}
+		sh.client.Track.SetVolume(track, float32(v))
+		return nil
+	}
+
+	sh.printf("%.2f\n", sh.client.Track.GetVolume(track))
+	return nil
+}
+
+func runFire(sh *shell, args []string) error {
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	slot, err := sh.scene(args[1])
+	if err != nil {
+		return err
+	}
+	sh.client.ClipSlot.Fire(track, slot)
+	return nil
+}
+
+func runScene(sh *shell, args []string) error {
+	scene, err := sh.scene(args[0])
+	if err != nil {
+		return err
+	}
+	sh.client.Scene.Fire(scene)
+	return nil
+}
+
+func runStopClips(sh *shell, args []string) error {
+	if len(args) == 0 {
+		sh.client.Song.StopAllClips()
+		return nil
+	}
+	track, err := sh.track(args[0])
+	if err != nil {
+		return err
+	}
+	sh.client.Track.StopAllClips(track)
+	return nil
+}
+
+// topic parses a watch topic. A bare word is a song property, e.g. tempo,
+// and track and scene indices may be names, e.g. track/volume/Bass.
+func (sh *shell) topic(arg string) (listen.Topic, error) {
+	parts := strings.Split(strings.Trim(arg, "/"), "/")
+	if len(parts) == 1 {
+		parts = []string{"song", parts[0]}
+	}
+
+	if len(parts) >= 3 {
+		byName := map[string]func(string) (int32, error){
+			"track": sh.track,
+			"clip":  sh.track,
+			"scene": sh.scene,
+		}[parts[0]]
+		if byName != nil {
+			i, err := byName(parts[2])
+			if err != nil {
+				return listen.Topic{}, err
+			}
+			parts[2] = strconv.Itoa(int(i))
+		}
+	}
+	return listen.ParseTopic(strings.Join(parts, "/"))
+}
+
+func runWatch(sh *shell, args []string) error {
+	if len(args) == 0 {
+		for _, key := range sh.watching() {
+			sh.printf("%s\n", key)
+		}
+		return nil
+	}
+	for _, arg := range args {
+		t, err := sh.topic(arg)
+		if err != nil {
+			return err
+		}
+
+		key := t.String()
+		sh.mu.Lock()
+		_, watching := sh.watches[key]
+		sh.mu.Unlock()
+		if watching {
+			continue
+		}
+
+		unsubscribe, err := sh.hub.Subscribe(t, func(e listen.Event) {
+			sh.printf("%s %s %v\n", e.Time.Format("15:04:05.000"), arg, formatValues(e.Values))
+		})
+		if err != nil {
+			return err
+		}
+		sh.mu.Lock()
+		sh.watches[key] = unsubscribe
+		sh.mu.Unlock()
+	}
+	return nil
+}
+
+func runUnwatch(sh *shell, args []string) error {
+	if len(args) == 0 {
+		sh.unwatchAll()
+		return nil
+	}
+	for _, arg := range args {
+		t, err := sh.topic(arg)
+		if err != nil {
+			return err
+		}
+		sh.mu.Lock()
+		if unsubscribe, ok := sh.watches[t.String()]; ok {
+			unsubscribe()
+			delete(sh.watches, t.String())
+		}
+		sh.mu.Unlock()
+	}
+	return nil
+}
+
+// watching returns the watched topics, sorted
+func (sh *shell) watching() []string {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	keys := make([]string, 0, len(sh.watches))
+	for key := range sh.watches {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func runSleep(sh *shell, args []string) error {
+	d, err := time.ParseDuration(args[0])
+	if err != nil {
+		return err
+	}
+	time.Sleep(d)
+	return nil
+}
+
+func formatValues(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if f, ok := v.(float32); ok {
+			parts[i] = strconv.FormatFloat(float64(f), 'f', -1, 32)
+		} else {
+			parts[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(parts, " ")
+}
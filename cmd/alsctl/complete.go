@@ -0,0 +1,140 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// songTopics are the song properties offered when completing watch
+var songTopics = []string{
+	"tempo", "is_playing", "beat", "current_song_time", "metronome", "loop",
+	"record_mode", "session_record", "signature_numerator", "signature_denominator",
+	"num_tracks", "num_scenes",
+}
+
+// trackTopics are the track properties offered as track/<property>/<track>
+var trackTopics = []string{
+	"volume", "panning", "mute", "solo", "arm", "output_meter_level",
+	"playing_slot_index", "fired_slot_index", "name",
+}
+
+// complete completes the last word of line. It returns the completed line,
+// unchanged when there is nothing to add, and the candidates matching the
+// word.
+func (sh *shell) complete(line string) (string, []string) {
+	words, err := split(line)
+	if err != nil {
+		// complete inside an open quote as if it were closed
+		words, err = split(line + `"`)
+		if err != nil {
+			return line, nil
+		}
+	}
+
+	current := ""
+	if len(words) > 0 && !strings.HasSuffix(line, " ") {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	candidates := sh.candidates(words)
+	matches := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(current)) {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return line, nil
+	}
+
+	// the start of the current word, including any opening quote
+	start := len(line)
+	if current != "" {
+		start = strings.LastIndex(line, current)
+		if start > 0 && line[start-1] == '"' {
+			start--
+		}
+	}
+
+	if len(matches) == 1 {
+		return line[:start] + quote(matches[0]) + " ", matches
+	}
+
+	prefix := commonPrefix(matches)
+	if len(prefix) > len(current) && !strings.ContainsAny(prefix, " \t") {
+		return line[:start] + prefix, matches
+	}
+	return line, matches
+}
+
+// candidates lists what may follow words
+func (sh *shell) candidates(words []string) []string {
+	if len(words) == 0 {
+		return commandNames()
+	}
+	cmd, ok := lookup(words[0])
+	if !ok {
+		return nil
+	}
+
+	var kind argKind
+	if i := len(words) - 1; i < len(cmd.args) {
+		kind = cmd.args[i]
+	} else if len(cmd.args) > 0 && cmd.max < 0 {
+		kind = cmd.args[len(cmd.args)-1]
+	}
+
+	switch kind {
+	case argCommand:
+		return commandNames()
+	case argTrack:
+		names, _ := sh.resolver.TrackNames()
+		return names
+	case argScene:
+		names, _ := sh.resolver.SceneNames()
+		return names
+	case argDevice:
+		track, err := sh.track(words[len(words)-1])
+		if err != nil {
+			return nil
+		}
+		names, _ := sh.resolver.DeviceNames(track)
+		return names
+	case argOnOff:
+		return []string{"on", "off"}
+	case argTopic:
+		return sh.topicCandidates()
+	}
+	return nil
+}
+
+func (sh *shell) topicCandidates() []string {
+	candidates := append([]string{}, songTopics...)
+	names, _ := sh.resolver.TrackNames()
+	for _, prop := range trackTopics {
+		for _, name := range names {
+			candidates = append(candidates, "track/"+prop+"/"+name)
+		}
+	}
+	return candidates
+}
+
+func commandNames() []string {
+	names := make([]string, len(commands))
+	for i, c := range commands {
+		names[i] = c.name
+	}
+	sort.Strings(names)
+	return names
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(strings.ToLower(w), strings.ToLower(prefix)) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
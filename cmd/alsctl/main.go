@@ -0,0 +1,102 @@
+// Command alsctl controls Live from the command line, one command at a time
+// or interactively.
+//
+// Usage:
+//
+//	alsctl [flags] <command> [args]   run one command, e.g. alsctl tempo 128
+//	alsctl [flags] repl               interactive shell with completion and history
+//	alsctl [flags] run <file>...      run batch files, - for stdin
+//
+// Run alsctl help for the commands.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+func main() {
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	keepGoing := flag.Bool("k", false, "keep running batch files after an error")
+	history := flag.String("history", defaultHistoryPath(), "REPL history file, empty to disable")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: alsctl [flags] <command> [args] | repl | run <file>...\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+	})
+	client.Run()
+	defer client.Close()
+
+	sh := newShell(client, os.Stdout)
+	defer sh.close()
+
+	if err := run(sh, flag.Args(), *history, *keepGoing); err != nil {
+		fmt.Fprintln(os.Stderr, "alsctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(sh *shell, args []string, history string, keepGoing bool) error {
+	switch args[0] {
+	case "repl":
+		return repl(sh, history)
+	case "run":
+		if len(args) < 2 {
+			return errors.New("usage: run <file>...")
+		}
+		for _, path := range args[1:] {
+			if err := runFile(sh, path, keepGoing); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	line := make([]string, len(args))
+	for i, arg := range args {
+		line[i] = quote(arg)
+	}
+	if err := sh.exec(strings.Join(line, " ")); err != nil {
+		return err
+	}
+
+	// a one-shot watch tails until interrupted
+	if args[0] == "watch" && len(args) > 1 {
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		<-interrupt
+	}
+	return nil
+}
+
+func runFile(sh *shell, path string, keepGoing bool) error {
+	if path == "-" {
+		return batch(sh, os.Stdin, "stdin", keepGoing)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return batch(sh, f, path, keepGoing)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// historySize is how many lines the history file keeps
+const historySize = 1000
+
+// repl runs an interactive session on a terminal, or reads commands from
+// stdin like a batch file when it isn't one.
+func repl(sh *shell, historyPath string) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return batch(sh, os.Stdin, "stdin", false)
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "als> ")
+	if w, h, err := term.GetSize(fd); err == nil {
+		t.SetSize(w, h)
+	}
+	t.History = loadHistory(historyPath)
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		completed, matches := sh.complete(line[:pos])
+		if completed == line[:pos] && len(matches) > 1 {
+			fmt.Fprintln(t, strings.Join(matches, "  "))
+		}
+		return completed + line[pos:], len(completed), true
+	}
+
+	// the terminal redraws the prompt around watch output
+	sh.mu.Lock()
+	sh.out = t
+	sh.mu.Unlock()
+
+	sh.printf("Connected. Type help for commands, tab to complete, ctrl-d to exit.\n")
+	for {
+		line, err := t.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := sh.exec(line); errors.Is(err, errExit) {
+			return nil
+		} else if err != nil {
+			sh.printf("error: %v\n", err)
+		}
+	}
+}
+
+// batch runs commands from r, one per line, stopping at the first error
+// unless keepGoing. Errors are prefixed with the name and line number.
+func batch(sh *shell, r io.Reader, name string, keepGoing bool) error {
+	scanner := bufio.NewScanner(r)
+	var failed error
+	for n := 1; scanner.Scan(); n++ {
+		err := sh.exec(scanner.Text())
+		if errors.Is(err, errExit) {
+			break
+		}
+		if err != nil {
+			err = fmt.Errorf("%s:%d: %w", name, n, err)
+			if !keepGoing {
+				return err
+			}
+			sh.printf("%v\n", err)
+			failed = err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return failed
+}
+
+// fileHistory is a terminal history saved to a file, so it lasts between
+// sessions
+type fileHistory struct {
+	mu      sync.Mutex
+	path    string
+	entries []string // oldest first
+}
+
+func defaultHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".alsctl_history")
+}
+
+// loadHistory reads a history file. A missing or unreadable file starts an
+// empty history, and an empty path keeps history in memory only.
+func loadHistory(path string) *fileHistory {
+	h := &fileHistory{path: path}
+	if path == "" {
+		return h
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return h
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+	h.trim()
+	return h
+}
+
+func (h *fileHistory) Add(entry string) {
+	if strings.TrimSpace(entry) == "" {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if n := len(h.entries); n > 0 && h.entries[n-1] == entry {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	h.trim()
+	h.save()
+}
+
+func (h *fileHistory) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.entries)
+}
+
+// At returns an entry, 0 being the most recent.
+func (h *fileHistory) At(i int) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.entries[len(h.entries)-1-i]
+}
+
+func (h *fileHistory) trim() {
+	if len(h.entries) > historySize {
+		h.entries = h.entries[len(h.entries)-historySize:]
+	}
+}
+
+// save rewrites the file. History is a convenience, so failing to save is
+// ignored.
+func (h *fileHistory) save() {
+	if h.path == "" {
+		return
+	}
+	os.WriteFile(h.path, []byte(strings.Join(h.entries, "\n")+"\n"), 0o600)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/alsex/resolve"
+)
+
+// errExit ends a REPL or batch session
+var errExit = errors.New("exit")
+
+// shell runs alsctl commands against a client. It's shared by one-shot
+// commands, the REPL and batch files.
+type shell struct {
+	client *als.Client
+	hub    *listen.Hub
+
+	mu      sync.Mutex // guards out and watches, as watch events print concurrently
+	out     io.Writer
+	watches map[string]func()
+
+	// resolver caches names for resolving and completion, so pressing tab
+	// doesn't wait on Live every time
+	resolver *resolve.Resolver
+}
+
+func newShell(client *als.Client, out io.Writer) *shell {
+	sh := &shell{
+		client:   client,
+		hub:      listen.Shared(client),
+		out:      out,
+		watches:  make(map[string]func()),
+		resolver: resolve.New(client),
+	}
+	// song topics are always valid
+	_ = sh.resolver.Start()
+	return sh
+}
+
+func (sh *shell) printf(format string, args ...any) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	fmt.Fprintf(sh.out, format, args...)
+}
+
+// exec runs one line. Blank lines and # comments do nothing.
+func (sh *shell) exec(line string) error {
+	args, err := split(line)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "#") {
+		return nil
+	}
+
+	cmd, ok := lookup(args[0])
+	if !ok {
+		return fmt.Errorf("unknown command %q, try help", args[0])
+	}
+	args = args[1:]
+	if len(args) < cmd.min || (cmd.max >= 0 && len(args) > cmd.max) {
+		return fmt.Errorf("usage: %s", cmd.usage)
+	}
+	return cmd.run(sh, args)
+}
+
+// close stops every watch and the resolver
+func (sh *shell) close() {
+	sh.unwatchAll()
+	sh.resolver.Stop()
+}
+
+// unwatchAll stops every watch
+func (sh *shell) unwatchAll() {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	for key, unsubscribe := range sh.watches {
+		unsubscribe()
+		delete(sh.watches, key)
+	}
+}
+
+// track resolves a track index or name
+func (sh *shell) track(arg string) (int32, error) {
+	return index("track", arg, sh.resolver.TrackNames, sh.resolver.Track)
+}
+
+// scene resolves a scene index or name
+func (sh *shell) scene(arg string) (int32, error) {
+	return index("scene", arg, sh.resolver.SceneNames, sh.resolver.Scene)
+}
+
+// device resolves a device index or name on a track
+func (sh *shell) device(track int32, arg string) (int32, error) {
+	names := func() ([]string, error) { return sh.resolver.DeviceNames(track) }
+	byName := func(pattern string) (int32, error) { return sh.resolver.Device(track, pattern) }
+	return index("device", arg, names, byName)
+}
+
+// index resolves arg as an index checked against names, or else as a name
+// pattern with byName, see resolve.Pattern
+func index(kind, arg string, names func() ([]string, error), byName func(string) (int32, error)) (int32, error) {
+	i, err := strconv.Atoi(arg)
+	if err != nil {
+		return byName(arg)
+	}
+	list, err := names()
+	if err != nil {
+		return 0, err
+	}
+	if i < 0 || i >= len(list) {
+		return 0, fmt.Errorf("%s %d not found, there are %d", kind, i, len(list))
+	}
+	return int32(i), nil
+}
+
+// split splits a line into words. Double quotes group words with spaces,
+// e.g. mute "Lead Synth".
+func split(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord, quoted := false, false
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// quote quotes a name for the command line if it contains spaces
+func quote(name string) string {
+	if strings.ContainsAny(name, " \t") {
+		return `"` + name + `"`
+	}
+	return name
+}
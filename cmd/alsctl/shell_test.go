@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is written by watch events while tests read it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func newTestShell(t *testing.T) (*alstest.Server, *shell, *syncBuffer) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/track_names", "Drums", "Lead Synth")
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Drop")
+	live.Set("/live/track/get/devices/name", int32(1), "Operator", "Auto Filter")
+	live.Set("/live/track/get/mute", int32(1), int32(0))
+
+	out := &syncBuffer{}
+	sh := newShell(live.Client(t), out)
+	t.Cleanup(sh.close)
+	return live, sh, out
+}
+
+// TestExec verifies commands resolve names and send messages
+func TestExec(t *testing.T) {
+	live, sh, out := newTestShell(t)
+
+	require.NoError(t, sh.exec("tempo 128"))
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	assert.Equal(t, []any{float32(128)}, msgs[0].Arguments)
+
+	require.NoError(t, sh.exec(`mute "lead synth"`))
+	msgs = live.WaitFor(t, "/live/track/set/mute")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+
+	require.NoError(t, sh.exec("solo lead* on"))
+	msgs = live.WaitFor(t, "/live/track/set/solo")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+
+	require.NoError(t, sh.exec("fire 0 Drop"))
+	msgs = live.WaitFor(t, "/live/clip_slot/fire")
+	assert.Equal(t, []any{int32(0), int32(1)}, msgs[0].Arguments)
+
+	require.NoError(t, sh.exec("devices 1"))
+	assert.Contains(t, out.String(), "  1  Auto Filter\n")
+
+	assert.EqualError(t, sh.exec("mute Bass"), `no track matches "Bass"`)
+	assert.EqualError(t, sh.exec("scene 5"), "scene 5 not found, there are 2")
+	assert.EqualError(t, sh.exec("volume"), "usage: volume <track> [0-1]")
+	assert.ErrorContains(t, sh.exec("dance"), "unknown command")
+	assert.ErrorContains(t, sh.exec(`mute "Lead`), "unterminated quote")
+	assert.ErrorIs(t, sh.exec("quit"), errExit)
+	assert.NoError(t, sh.exec("  # a comment"))
+}
+
+// TestComplete verifies commands, names and topics complete
+func TestComplete(t *testing.T) {
+	_, sh, _ := newTestShell(t)
+
+	line, _ := sh.complete("tem")
+	assert.Equal(t, "tempo ", line)
+
+	line, matches := sh.complete("s")
+	assert.Equal(t, "s", line)
+	assert.Equal(t, []string{"scene", "scenes", "sleep", "solo", "stop", "stopclips"}, matches)
+
+	line, _ = sh.complete("mute le")
+	assert.Equal(t, `mute "Lead Synth" `, line)
+	line, _ = sh.complete(`mute "Lead Synth" o`)
+	assert.Equal(t, `mute "Lead Synth" o`, line)
+	line, _ = sh.complete(`mute "Lead Synth" of`)
+	assert.Equal(t, `mute "Lead Synth" off `, line)
+
+	line, _ = sh.complete("params 1 au")
+	assert.Equal(t, `params 1 "Auto Filter" `, line)
+
+	line, _ = sh.complete("watch tem")
+	assert.Equal(t, "watch tempo ", line)
+	line, _ = sh.complete("watch track/volume/D")
+	assert.Equal(t, "watch track/volume/Drums ", line)
+
+	line, matches = sh.complete("explode ")
+	assert.Equal(t, "explode ", line)
+	assert.Empty(t, matches)
+}
+
+// TestWatch verifies events are printed until unwatched
+func TestWatch(t *testing.T) {
+	live, sh, out := newTestShell(t)
+
+	require.NoError(t, sh.exec(`watch tempo track/volume/"Lead Synth"`))
+	live.WaitFor(t, "/live/song/start_listen/tempo")
+	live.WaitFor(t, "/live/track/start_listen/volume")
+
+	live.Emit("/live/track/get/volume", int32(1), float32(0.5))
+	assert.Eventually(t, func() bool {
+		return strings.Contains(out.String(), "track/volume/Lead Synth 0.5\n")
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, sh.exec("watch"))
+	assert.Contains(t, out.String(), "song/tempo[]\n")
+
+	require.NoError(t, sh.exec("unwatch tempo"))
+	live.WaitFor(t, "/live/song/stop_listen/tempo")
+	assert.Empty(t, live.Received("/live/track/stop_listen/volume"))
+}
+
+// TestBatch verifies batch files stop at errors with line numbers
+func TestBatch(t *testing.T) {
+	live, sh, out := newTestShell(t)
+
+	script := "# set up\ntempo 100\n\nmute Bass\ntempo 110\n"
+	err := batch(sh, strings.NewReader(script), "show.als", false)
+	assert.EqualError(t, err, `show.als:4: no track matches "Bass"`)
+	msgs := live.WaitFor(t, "/live/song/set/tempo")
+	require.Len(t, msgs, 1)
+	assert.Equal(t, []any{float32(100)}, msgs[0].Arguments)
+
+	err = batch(sh, strings.NewReader(script), "show.als", true)
+	assert.Error(t, err)
+	assert.Contains(t, out.String(), "show.als:4:")
+	// the line after the error still runs
+	assert.Eventually(t, func() bool {
+		return len(live.Received("/live/song/set/tempo")) == 3
+	}, time.Second, 5*time.Millisecond)
+
+	assert.NoError(t, batch(sh, strings.NewReader("exit\nmute Bass\n"), "stdin", false))
+}
+
+// TestHistory verifies history persists, skipping repeats
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	h := loadHistory(path)
+	h.Add("tempo 120")
+	h.Add("tempo 120")
+	h.Add("  ")
+	h.Add("play")
+
+	h = loadHistory(path)
+	require.Equal(t, 2, h.Len())
+	assert.Equal(t, "play", h.At(0))
+	assert.Equal(t, "tempo 120", h.At(1))
+}
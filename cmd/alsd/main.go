@@ -0,0 +1,57 @@
+// Command alsd serves the als API as a REST gateway, for tools that can't
+// speak OSC.
+//
+// Usage:
+//
+//	alsd [-addr :8080] [-send 11000] [-listen 11001] [-timeout 2s] [-origins https://example.com,...]
+//	alsd -openapi > openapi.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "HTTP listen address")
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	rateLimit := flag.Int("rate", 0, "maximum OSC messages per second, 0 for no limit")
+	origins := flag.String("origins", "", "comma-separated browser origins allowed to open WebSockets besides localhost, * for any")
+	spec := flag.Bool("openapi", false, "print the OpenAPI spec and exit")
+	flag.Parse()
+
+	if *spec {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(openAPI()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+		RateLimit:  *rateLimit,
+	})
+	client.Run()
+	defer client.Close()
+
+	log.Printf("alsd listening on %s", *addr)
+	s := newServer(client)
+	if *origins != "" {
+		s.origins = strings.Split(*origins, ",")
+	}
+	log.Fatal(http.ListenAndServe(*addr, s))
+}
@@ -0,0 +1,168 @@
+package main
+
+import "strings"
+
+// openAPI generates an OpenAPI 3 description of the gateway from the
+// resource table
+func openAPI() map[string]any {
+	paths := map[string]any{}
+	schemas := map[string]any{
+		"Error": object(map[string]any{"error": schema("string")}),
+		"Item": object(map[string]any{
+			"name": schema("string"),
+		}),
+		"Parameter": object(map[string]any{
+			"parameter": schema("integer"),
+			"name":      schema("string"),
+			"value":     schema("number"),
+			"min":       schema("number"),
+			"max":       schema("number"),
+		}),
+	}
+
+	for _, r := range resources {
+		title := strings.ToUpper(r.name[:1]) + r.name[1:]
+
+		props := map[string]any{}
+		writable := map[string]any{}
+		for _, name := range r.params {
+			props[name] = schema("integer")
+		}
+		for _, p := range r.props {
+			s := schema(p.kind.String())
+			if p.readOnly {
+				s["readOnly"] = true
+			} else {
+				writable[p.name] = schema(p.kind.String())
+			}
+			props[p.name] = s
+		}
+		schemas[title] = object(props)
+		schemas[title+"Patch"] = object(writable)
+
+		params := pathParams(r.params)
+		paths[r.path] = map[string]any{
+			"get": operation("Get the "+r.name, params, nil, map[string]any{
+				"200": jsonResponse("The "+r.name, ref(title)),
+			}),
+			"patch": operation("Update "+r.name+" properties", params, ref(title+"Patch"), map[string]any{
+				"202": map[string]any{"description": "Sent to Live"},
+			}),
+		}
+
+		if len(r.actions) > 0 {
+			actionParams := append(pathParams(r.params), map[string]any{
+				"name":     "action",
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string", "enum": r.actions},
+			})
+			paths[r.path+"/{action}"] = map[string]any{
+				"post": operation("Trigger a "+r.name+" action", actionParams, nil, map[string]any{
+					"202": map[string]any{"description": "Sent to Live"},
+				}),
+			}
+		}
+	}
+
+	list := func(item string) map[string]any {
+		return map[string]any{"type": "array", "items": ref(item)}
+	}
+	paths["/tracks"] = map[string]any{
+		"get": operation("List tracks", nil, nil, map[string]any{"200": jsonResponse("Tracks", list("Item"))}),
+	}
+	paths["/scenes"] = map[string]any{
+		"get": operation("List scenes", nil, nil, map[string]any{"200": jsonResponse("Scenes", list("Item"))}),
+	}
+	paths["/tracks/{track}/devices"] = map[string]any{
+		"get": operation("List a track's devices", pathParams([]string{"track"}), nil, map[string]any{
+			"200": jsonResponse("Devices", list("Item")),
+		}),
+	}
+	paths["/tracks/{track}/devices/{device}/parameters"] = map[string]any{
+		"get": operation("List a device's parameters", pathParams([]string{"track", "device"}), nil, map[string]any{
+			"200": jsonResponse("Parameters", list("Parameter")),
+		}),
+	}
+	paths["/tracks/{track}/devices/{device}/parameters/{parameter}"] = map[string]any{
+		"patch": operation("Set a parameter value", pathParams([]string{"track", "device", "parameter"}),
+			object(map[string]any{"value": schema("number")}),
+			map[string]any{"202": map[string]any{"description": "Sent to Live"}}),
+	}
+
+	paths["/ws"] = map[string]any{
+		"get": map[string]any{
+			"summary": "Stream listener events over a WebSocket. Send " +
+				`{"op":"subscribe","object":"track","property":"volume","indices":[2]} ` +
+				"to subscribe, or list topics such as track/volume/2 in the query.",
+			"parameters": []map[string]any{{
+				"name":   "topic",
+				"in":     "query",
+				"schema": map[string]any{"type": "array", "items": schema("string")},
+			}},
+			"responses": map[string]any{"101": map[string]any{"description": "Switching to WebSocket"}},
+		},
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "alsd",
+			"version": "1.0.0",
+			"description": "REST gateway to Ableton Live via AbletonOSC. Writes are " +
+				"fire and forget, so they return 202 once sent.",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+}
+
+func operation(summary string, params []map[string]any, body map[string]any, responses map[string]any) map[string]any {
+	responses["400"] = jsonResponse("Invalid request", ref("Error"))
+	responses["404"] = jsonResponse("No such object", ref("Error"))
+	responses["504"] = jsonResponse("Live did not respond", ref("Error"))
+
+	op := map[string]any{"summary": summary, "responses": responses}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	if body != nil {
+		op["requestBody"] = map[string]any{
+			"required": true,
+			"content":  map[string]any{"application/json": map[string]any{"schema": body}},
+		}
+	}
+	return op
+}
+
+func pathParams(names []string) []map[string]any {
+	params := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		params = append(params, map[string]any{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": "integer", "minimum": 0},
+		})
+	}
+	return params
+}
+
+func jsonResponse(description string, s map[string]any) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content":     map[string]any{"application/json": map[string]any{"schema": s}},
+	}
+}
+
+func object(props map[string]any) map[string]any {
+	return map[string]any{"type": "object", "properties": props}
+}
+
+func schema(t string) map[string]any {
+	return map[string]any{"type": t}
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
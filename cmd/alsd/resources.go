@@ -0,0 +1,168 @@
+package main
+
+// kind is the JSON type of a property
+type kind int
+
+const (
+	kindFloat kind = iota
+	kindInt
+	kindBool
+	kindString
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindFloat:
+		return "number"
+	case kindInt:
+		return "integer"
+	case kindBool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// property is a Live property read with /get/<name> and written with
+// /set/<name>
+type property struct {
+	name     string
+	kind     kind
+	readOnly bool
+}
+
+// resource is a Live object exposed over REST. Path parameters are the
+// object's indices, in the order AbletonOSC expects them.
+type resource struct {
+	name    string
+	path    string
+	osc     string
+	params  []string
+	props   []property
+	actions []string
+}
+
+var resources = []resource{
+	{
+		name: "song",
+		path: "/song",
+		osc:  "/live/song",
+		props: []property{
+			{"tempo", kindFloat, false},
+			{"is_playing", kindBool, true},
+			{"current_song_time", kindFloat, false},
+			{"metronome", kindBool, false},
+			{"loop", kindBool, false},
+			{"loop_start", kindFloat, false},
+			{"loop_length", kindFloat, false},
+			{"record_mode", kindBool, false},
+			{"session_record", kindBool, false},
+			{"groove_amount", kindFloat, false},
+			{"signature_numerator", kindInt, false},
+			{"signature_denominator", kindInt, false},
+			{"clip_trigger_quantization", kindInt, false},
+			{"num_tracks", kindInt, true},
+			{"num_scenes", kindInt, true},
+			{"song_length", kindFloat, true},
+			{"can_undo", kindBool, true},
+			{"can_redo", kindBool, true},
+		},
+		actions: []string{
+			"start_playing", "stop_playing", "continue_playing", "stop_all_clips",
+			"tap_tempo", "undo", "redo", "capture_midi", "trigger_session_record",
+			"jump_to_next_cue", "jump_to_prev_cue",
+		},
+	},
+	{
+		name:   "track",
+		path:   "/tracks/{track}",
+		osc:    "/live/track",
+		params: []string{"track"},
+		props: []property{
+			{"name", kindString, false},
+			{"mute", kindBool, false},
+			{"solo", kindBool, false},
+			{"arm", kindBool, false},
+			{"volume", kindFloat, false},
+			{"panning", kindFloat, false},
+			{"color", kindInt, false},
+			{"current_monitoring_state", kindInt, false},
+			{"num_devices", kindInt, true},
+			{"playing_slot_index", kindInt, true},
+			{"fired_slot_index", kindInt, true},
+			{"output_meter_level", kindFloat, true},
+			{"has_midi_input", kindBool, true},
+			{"is_grouped", kindBool, true},
+		},
+		actions: []string{"stop_all_clips"},
+	},
+	{
+		name:   "clip",
+		path:   "/clips/{track}/{clip}",
+		osc:    "/live/clip",
+		params: []string{"track", "clip"},
+		props: []property{
+			{"name", kindString, false},
+			{"color", kindInt, false},
+			{"gain", kindFloat, false},
+			{"pitch_coarse", kindInt, false},
+			{"pitch_fine", kindFloat, false},
+			{"loop_start", kindFloat, false},
+			{"loop_end", kindFloat, false},
+			{"start_marker", kindFloat, false},
+			{"end_marker", kindFloat, false},
+			{"warping", kindBool, false},
+			{"length", kindFloat, true},
+			{"is_playing", kindBool, true},
+			{"is_recording", kindBool, true},
+			{"is_midi_clip", kindBool, true},
+			{"playing_position", kindFloat, true},
+		},
+		actions: []string{"fire", "stop", "duplicate_loop"},
+	},
+	{
+		name:   "scene",
+		path:   "/scenes/{scene}",
+		osc:    "/live/scene",
+		params: []string{"scene"},
+		props: []property{
+			{"name", kindString, false},
+			{"color", kindInt, false},
+			{"tempo", kindFloat, false},
+			{"tempo_enabled", kindBool, false},
+			{"is_empty", kindBool, true},
+			{"is_triggered", kindBool, true},
+		},
+		actions: []string{"fire", "fire_as_selected"},
+	},
+	{
+		name:   "device",
+		path:   "/tracks/{track}/devices/{device}",
+		osc:    "/live/device",
+		params: []string{"track", "device"},
+		props: []property{
+			{"name", kindString, true},
+			{"class_name", kindString, true},
+			{"type", kindInt, true},
+			{"num_parameters", kindInt, true},
+		},
+	},
+}
+
+func (r resource) property(name string) (property, bool) {
+	for _, p := range r.props {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+func (r resource) hasAction(name string) bool {
+	for _, a := range r.actions {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+var (
+	errNotFound   = errors.New("not found")
+	errBadRequest = errors.New("bad request")
+)
+
+// server exposes the als API over REST, sharing one client between all
+// requests
+type server struct {
+	client *als.Client
+	hub    *listen.Hub
+	mux    *http.ServeMux
+
+	// origins are browser origins allowed to open WebSockets besides the
+	// server's own and localhost, "*" for any
+	origins []string
+}
+
+func newServer(client *als.Client) *server {
+	s := &server{client: client, hub: listen.Shared(client), mux: http.NewServeMux()}
+
+	for _, r := range resources {
+		s.mux.HandleFunc("GET "+r.path, s.getResource(r))
+		s.mux.HandleFunc("PATCH "+r.path, s.patchResource(r))
+		if len(r.actions) > 0 {
+			s.mux.HandleFunc("POST "+r.path+"/{action}", s.postAction(r))
+		}
+	}
+	s.mux.HandleFunc("GET /tracks", s.handle(s.listTracks))
+	s.mux.HandleFunc("GET /scenes", s.handle(s.listScenes))
+	s.mux.HandleFunc("GET /tracks/{track}/devices", s.handle(s.listDevices))
+	s.mux.HandleFunc("GET /tracks/{track}/devices/{device}/parameters", s.handle(s.listParameters))
+	s.mux.HandleFunc("PATCH /tracks/{track}/devices/{device}/parameters/{parameter}", s.handle(s.patchParameter))
+	s.mux.HandleFunc("GET /ws", s.serveWS)
+	s.mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, openAPI())
+	})
+
+	return s
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle adapts a handler returning a status and body, mapping errors to
+// statuses
+func (s *server) handle(fn func(r *http.Request) (int, any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, body, err := fn(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if body == nil {
+			w.WriteHeader(status)
+			return
+		}
+		writeJSON(w, status, body)
+	}
+}
+
+func (s *server) getResource(res resource) http.HandlerFunc {
+	return s.handle(func(r *http.Request) (int, any, error) {
+		indices, err := s.indices(r, res.params)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		body := make(map[string]any, len(res.props)+len(indices))
+		for i, name := range res.params {
+			body[name] = indices[i]
+		}
+		for _, p := range res.props {
+			args, err := s.client.Get(res.osc+"/get/"+p.name, indices...)
+			if err != nil {
+				return 0, nil, err
+			}
+			if len(args) > 0 {
+				body[p.name] = toJSON(p.kind, args[0])
+			}
+		}
+		return http.StatusOK, body, nil
+	})
+}
+
+func (s *server) patchResource(res resource) http.HandlerFunc {
+	return s.handle(func(r *http.Request) (int, any, error) {
+		indices, err := s.indices(r, res.params)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		var patch map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			return 0, nil, fmt.Errorf("%w: invalid JSON: %v", errBadRequest, err)
+		}
+
+		// validate everything before sending anything
+		sets := make([][]any, 0, len(patch))
+		names := make([]string, 0, len(patch))
+		for name, value := range patch {
+			p, ok := res.property(name)
+			if !ok {
+				return 0, nil, fmt.Errorf("%w: unknown property %q", errBadRequest, name)
+			}
+			if p.readOnly {
+				return 0, nil, fmt.Errorf("%w: %s is read only", errBadRequest, name)
+			}
+			v, err := fromJSON(p.kind, value)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: %s: %v", errBadRequest, name, err)
+			}
+			sets = append(sets, append(append([]any{}, indices...), v))
+			names = append(names, name)
+		}
+
+		for i, args := range sets {
+			s.client.Send(res.osc+"/set/"+names[i], args...)
+		}
+		return http.StatusAccepted, nil, nil
+	})
+}
+
+func (s *server) postAction(res resource) http.HandlerFunc {
+	return s.handle(func(r *http.Request) (int, any, error) {
+		action := r.PathValue("action")
+		if !res.hasAction(action) {
+			return 0, nil, fmt.Errorf("%w: unknown action %q", errNotFound, action)
+		}
+		indices, err := s.indices(r, res.params)
+		if err != nil {
+			return 0, nil, err
+		}
+		s.client.Send(res.osc+"/"+action, indices...)
+		return http.StatusAccepted, nil, nil
+	})
+}
+
+func (s *server) listTracks(r *http.Request) (int, any, error) {
+	args, err := s.client.Get("/live/song/get/track_names")
+	if err != nil {
+		return 0, nil, err
+	}
+	tracks := make([]map[string]any, 0, len(args))
+	for i, name := range args {
+		tracks = append(tracks, map[string]any{"track": i, "name": name})
+	}
+	return http.StatusOK, tracks, nil
+}
+
+func (s *server) listScenes(r *http.Request) (int, any, error) {
+	names, err := s.client.SceneNames()
+	if err != nil {
+		return 0, nil, err
+	}
+	scenes := make([]map[string]any, 0, len(names))
+	for i, name := range names {
+		scenes = append(scenes, map[string]any{"scene": i, "name": name})
+	}
+	return http.StatusOK, scenes, nil
+}
+
+func (s *server) listDevices(r *http.Request) (int, any, error) {
+	indices, err := s.indices(r, []string{"track"})
+	if err != nil {
+		return 0, nil, err
+	}
+	args, err := s.client.Get("/live/track/get/devices/name", indices...)
+	if err != nil {
+		return 0, nil, err
+	}
+	devices := make([]map[string]any, 0, len(args))
+	for i, name := range args {
+		devices = append(devices, map[string]any{"device": i, "name": name})
+	}
+	return http.StatusOK, devices, nil
+}
+
+func (s *server) listParameters(r *http.Request) (int, any, error) {
+	indices, err := s.indices(r, []string{"track", "device"})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	columns := []string{"name", "value", "min", "max"}
+	values := make([][]any, len(columns))
+	for i, column := range columns {
+		values[i], err = s.client.Get("/live/device/get/parameters/"+column, indices...)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	params := make([]map[string]any, 0, len(values[0]))
+	for i := range values[0] {
+		p := map[string]any{"parameter": i}
+		for c, column := range columns {
+			if i < len(values[c]) {
+				p[column] = toJSON(kindFloat, values[c][i])
+			}
+		}
+		params = append(params, p)
+	}
+	return http.StatusOK, params, nil
+}
+
+func (s *server) patchParameter(r *http.Request) (int, any, error) {
+	indices, err := s.indices(r, []string{"track", "device", "parameter"})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var patch struct {
+		Value *float64 `json:"value"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		return 0, nil, fmt.Errorf("%w: invalid JSON: %v", errBadRequest, err)
+	}
+	if patch.Value == nil {
+		return 0, nil, fmt.Errorf("%w: value is required", errBadRequest)
+	}
+
+	s.client.Send("/live/device/set/parameter/value", append(indices, float32(*patch.Value))...)
+	return http.StatusAccepted, nil, nil
+}
+
+// indices parses and checks the path parameters naming a Live object
+func (s *server) indices(r *http.Request, params []string) ([]any, error) {
+	indices := make([]any, 0, len(params))
+	for _, name := range params {
+		v, err := strconv.ParseInt(r.PathValue(name), 10, 32)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("%w: invalid %s %q", errBadRequest, name, r.PathValue(name))
+		}
+		i := int32(v)
+
+		var n int32
+		switch name {
+		case "track":
+			n, err = s.count("/live/song/get/num_tracks")
+		case "scene":
+			n, err = s.count("/live/song/get/num_scenes")
+		case "device":
+			n, err = s.count("/live/track/get/num_devices", indices...)
+		case "parameter":
+			n, err = s.count("/live/device/get/num_parameters", indices...)
+		case "clip":
+			var args []any
+			args, err = s.client.Get("/live/clip_slot/get/has_clip", append(indices, i)...)
+			n = math.MaxInt32
+			if err == nil && !toJSON(kindBool, als.First(args)).(bool) {
+				return nil, fmt.Errorf("%w: no clip in slot %d", errNotFound, i)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i >= n {
+			return nil, fmt.Errorf("%w: %s %d", errNotFound, name, i)
+		}
+		indices = append(indices, i)
+	}
+	return indices, nil
+}
+
+func (s *server) count(addr string, indices ...any) (int32, error) {
+	args, err := s.client.Get(addr, indices...)
+	if err != nil {
+		return 0, err
+	}
+	return als.Int(als.First(args)), nil
+}
+
+// toJSON converts an OSC argument to the property's JSON type
+func toJSON(k kind, v any) any {
+	switch k {
+	case kindFloat:
+		return float64(als.Float(v))
+	case kindInt:
+		return als.Int(v)
+	case kindBool:
+		return als.Bool(v)
+	default:
+		return als.String(v)
+	}
+}
+
+// fromJSON converts a decoded JSON value to the OSC argument for a property
+func fromJSON(k kind, v any) (any, error) {
+	switch k {
+	case kindFloat:
+		if n, ok := v.(float64); ok {
+			return float32(n), nil
+		}
+	case kindInt:
+		if n, ok := v.(float64); ok && n == math.Trunc(n) && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return int32(n), nil
+		}
+	case kindBool:
+		if b, ok := v.(bool); ok {
+			return als.FromBool(b), nil
+		}
+	case kindString:
+		if str, ok := v.(string); ok {
+			return str, nil
+		}
+	}
+	return nil, fmt.Errorf("expected %s", k)
+}
+
+// statusOf maps errors to HTTP statuses. Live not answering in time is a
+// gateway timeout, as the daemon is a gateway to it.
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, errBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, errNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, oscclient.ErrTimeout):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, statusOf(err), map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*alstest.Server, *server) {
+	live := alstest.NewServer(t)
+	for _, p := range resources[1].props {
+		live.Set("/live/track/get/"+p.name, int32(1), map[kind]any{
+			kindFloat:  float32(0),
+			kindInt:    int32(0),
+			kindBool:   int32(0),
+			kindString: "",
+		}[p.kind])
+	}
+	live.Set("/live/song/get/num_tracks", int32(2))
+	live.Set("/live/song/get/tempo", float32(124))
+	live.Set("/live/song/get/is_playing", int32(1))
+	live.Set("/live/track/get/name", int32(1), "Bass")
+	live.Set("/live/track/get/mute", int32(1), int32(0))
+	return live, newServer(live.Client(t))
+}
+
+func do(t *testing.T, s *server, method, path, body string) (*httptest.ResponseRecorder, map[string]any) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var decoded map[string]any
+	if strings.HasPrefix(rec.Body.String(), "{") {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &decoded))
+	}
+	return rec, decoded
+}
+
+// TestGetResource verifies properties are read and typed
+func TestGetResource(t *testing.T) {
+	_, s := newTestServer(t)
+
+	rec, body := do(t, s, "GET", "/tracks/1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Bass", body["name"])
+	assert.Equal(t, false, body["mute"])
+	assert.Equal(t, float64(1), body["track"])
+}
+
+// TestQueryConcurrent verifies concurrent queries for different tracks
+// each get their own reply
+func TestQueryConcurrent(t *testing.T) {
+	live, s := newTestServer(t)
+	for i := range 8 {
+		live.Set("/live/track/get/name", int32(i), fmt.Sprint("Track ", i))
+	}
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			args, err := s.client.Get("/live/track/get/name", int32(i))
+			if assert.NoError(t, err) {
+				assert.Equal(t, []any{fmt.Sprint("Track ", i)}, args)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// TestPatchResource verifies writes are validated and sent
+func TestPatchResource(t *testing.T) {
+	live, s := newTestServer(t)
+
+	rec, _ := do(t, s, "PATCH", "/tracks/1", `{"mute":true,"name":"Sub"}`)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	live.WaitFor(t, "/live/track/set/mute")
+	args, _ := live.Get("/live/track/get/mute", int32(1))
+	assert.Equal(t, []any{int32(1), int32(1)}, args)
+
+	rec, body := do(t, s, "PATCH", "/tracks/1", `{"mute":"yes"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, body["error"], "expected boolean")
+
+	rec, _ = do(t, s, "PATCH", "/tracks/1", `{"num_devices":3}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec, _ = do(t, s, "PATCH", "/tracks/1", `{"colour":3}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestStatusMapping verifies missing objects and timeouts
+func TestStatusMapping(t *testing.T) {
+	live, s := newTestServer(t)
+
+	rec, _ := do(t, s, "GET", "/tracks/5", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, _ = do(t, s, "GET", "/tracks/x", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, _ = do(t, s, "POST", "/song/explode", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// scenes are never answered by the fake
+	rec, body := do(t, s, "GET", "/scenes/0", "")
+	assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	assert.Contains(t, body["error"], "num_scenes")
+
+	live.Set("/live/clip_slot/get/has_clip", int32(1), int32(0), int32(0))
+	rec, _ = do(t, s, "POST", "/clips/1/0/fire", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// TestActions verifies actions are sent with their indices
+func TestActions(t *testing.T) {
+	live, s := newTestServer(t)
+	live.Set("/live/clip_slot/get/has_clip", int32(1), int32(0), int32(1))
+
+	rec, _ := do(t, s, "POST", "/clips/1/0/fire", "")
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	msgs := live.WaitFor(t, "/live/clip/fire")
+	assert.Equal(t, []any{int32(1), int32(0)}, msgs[0].Arguments)
+
+	rec, _ = do(t, s, "POST", "/song/start_playing", "")
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	live.WaitFor(t, "/live/song/start_playing")
+}
+
+// TestCheckOrigin verifies WebSockets are same-origin or localhost unless
+// other origins are allowed
+func TestCheckOrigin(t *testing.T) {
+	_, s := newTestServer(t)
+	allowed := func(origin string) bool {
+		req := httptest.NewRequest("GET", "http://studio.lan:8080/ws", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		return s.checkOrigin(req)
+	}
+
+	assert.True(t, allowed(""))
+	assert.True(t, allowed("http://studio.lan:8080"))
+	assert.True(t, allowed("http://localhost:3000"))
+	assert.True(t, allowed("http://127.0.0.1:5173"))
+	assert.True(t, allowed("http://[::1]:5173"))
+	assert.False(t, allowed("https://evil.example"))
+	assert.False(t, allowed("http://studio.lan:9000"))
+
+	s.origins = []string{"https://tools.example"}
+	assert.True(t, allowed("https://tools.example"))
+	assert.False(t, allowed("https://evil.example"))
+
+	s.origins = []string{"*"}
+	assert.True(t, allowed("https://evil.example"))
+}
+
+// TestOpenAPI verifies the spec covers every route
+func TestOpenAPI(t *testing.T) {
+	_, s := newTestServer(t)
+
+	rec, body := do(t, s, "GET", "/openapi.json", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "3.0.3", body["openapi"])
+
+	paths := body["paths"].(map[string]any)
+	for _, path := range []string{"/song", "/tracks/{track}", "/clips/{track}/{clip}/{action}", "/tracks/{track}/devices/{device}/parameters"} {
+		assert.Contains(t, paths, path)
+	}
+	track := body["components"].(map[string]any)["schemas"].(map[string]any)["TrackPatch"].(map[string]any)
+	assert.Contains(t, track["properties"], "mute")
+	assert.NotContains(t, track["properties"], "num_devices")
+}
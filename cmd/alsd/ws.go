@@ -0,0 +1,181 @@
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// wsBuffer is how many frames queue for a slow client before events are
+// dropped
+const wsBuffer = 256
+
+// checkOrigin stops other sites open in a browser from driving Live: only
+// requests without an Origin, such as from scripts, and pages served by
+// alsd's own host or localhost may connect, unless the origin is allowed
+// with -origins.
+func (s *server) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || slices.Contains(s.origins, "*") || slices.Contains(s.origins, origin) {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if u.Host == r.Host {
+		return true
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
+
+// wsRequest is a message from a client, e.g.
+// {"op":"subscribe","object":"track","property":"volume","indices":[2]}
+type wsRequest struct {
+	Op string `json:"op"`
+	listen.Topic
+}
+
+// wsFrame is a message to a client. Type is "event", "subscribed",
+// "unsubscribed" or "error".
+type wsFrame struct {
+	Type string `json:"type"`
+	listen.Topic
+	Values []any      `json:"values,omitempty"`
+	Time   *time.Time `json:"time,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// serveWS streams listener events over a WebSocket. Topics can be given up
+// front as ?topic=song/tempo&topic=track/volume/2, or subscribed to with
+// requests.
+func (s *server) serveWS(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: s.checkOrigin}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &wsConn{
+		conn:          conn,
+		hub:           s.hub,
+		out:           make(chan wsFrame, wsBuffer),
+		done:          make(chan struct{}),
+		subscriptions: make(map[string]func()),
+	}
+	go c.write()
+	defer c.close()
+
+	for _, topic := range r.URL.Query()["topic"] {
+		t, err := listen.ParseTopic(topic)
+		if err != nil {
+			c.send(wsFrame{Type: "error", Error: err.Error()})
+			continue
+		}
+		c.subscribe(t)
+	}
+
+	for {
+		var req wsRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Println("websocket:", err)
+			}
+			return
+		}
+
+		switch req.Op {
+		case "subscribe":
+			c.subscribe(req.Topic)
+		case "unsubscribe":
+			c.unsubscribe(req.Topic)
+		default:
+			c.send(wsFrame{Type: "error", Error: "unknown op " + req.Op})
+		}
+	}
+}
+
+type wsConn struct {
+	conn *websocket.Conn
+	hub  *listen.Hub
+	out  chan wsFrame
+	done chan struct{}
+
+	mu            sync.Mutex
+	subscriptions map[string]func()
+}
+
+func (c *wsConn) subscribe(t listen.Topic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subscriptions[t.String()]; ok {
+		c.send(wsFrame{Type: "subscribed", Topic: t})
+		return
+	}
+	unsubscribe, err := c.hub.Subscribe(t, func(e listen.Event) {
+		c.send(wsFrame{Type: "event", Topic: e.Topic, Values: e.Values, Time: &e.Time})
+	})
+	if err != nil {
+		c.send(wsFrame{Type: "error", Topic: t, Error: err.Error()})
+		return
+	}
+	c.subscriptions[t.String()] = unsubscribe
+	c.send(wsFrame{Type: "subscribed", Topic: t})
+}
+
+func (c *wsConn) unsubscribe(t listen.Topic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if unsubscribe, ok := c.subscriptions[t.String()]; ok {
+		unsubscribe()
+		delete(c.subscriptions, t.String())
+	}
+	c.send(wsFrame{Type: "unsubscribed", Topic: t})
+}
+
+// send queues a frame, dropping it if the client isn't keeping up
+func (c *wsConn) send(f wsFrame) {
+	select {
+	case <-c.done:
+	case c.out <- f:
+	default:
+	}
+}
+
+func (c *wsConn) write() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case f := <-c.out:
+			if err := c.conn.WriteJSON(f); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) close() {
+	c.mu.Lock()
+	for _, unsubscribe := range c.subscriptions {
+		unsubscribe()
+	}
+	c.subscriptions = nil
+	c.mu.Unlock()
+
+	// events already being delivered see done and are dropped
+	close(c.done)
+	c.conn.Close()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dialWS(t *testing.T, url string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(url, "http", "ws", 1), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readFrame(t *testing.T, conn *websocket.Conn) wsFrame {
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var f wsFrame
+	require.NoError(t, conn.ReadJSON(&f))
+	return f
+}
+
+// TestWebSocket verifies clients share listeners and receive events
+func TestWebSocket(t *testing.T) {
+	live, s := newTestServer(t)
+	http := httptest.NewServer(s)
+	defer http.Close()
+
+	tempo := listen.Topic{Object: "song", Property: "tempo"}
+
+	a := dialWS(t, http.URL+"/ws?topic=song/tempo")
+	assert.Equal(t, wsFrame{Type: "subscribed", Topic: tempo}, readFrame(t, a))
+
+	b := dialWS(t, http.URL+"/ws")
+	require.NoError(t, b.WriteJSON(wsRequest{Op: "subscribe", Topic: tempo}))
+	assert.Equal(t, "subscribed", readFrame(t, b).Type)
+	require.NoError(t, b.WriteJSON(wsRequest{Op: "subscribe", Topic: listen.Topic{Object: "track", Property: "volume"}}))
+	assert.Equal(t, "error", readFrame(t, b).Type)
+
+	assert.Equal(t, 2, s.hub.Count(tempo))
+	assert.Len(t, live.WaitFor(t, "/live/song/start_listen/tempo"), 1)
+
+	live.Emit("/live/song/get/tempo", float32(128))
+	for _, conn := range []*websocket.Conn{a, b} {
+		f := readFrame(t, conn)
+		assert.Equal(t, "event", f.Type)
+		assert.Equal(t, []any{float64(128)}, f.Values)
+	}
+
+	require.NoError(t, b.WriteJSON(wsRequest{Op: "unsubscribe", Topic: tempo}))
+	assert.Equal(t, "unsubscribed", readFrame(t, b).Type)
+	a.Close()
+	live.WaitFor(t, "/live/song/stop_listen/tempo")
+	assert.Equal(t, 0, s.hub.Count(tempo))
+}
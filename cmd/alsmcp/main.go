@@ -0,0 +1,48 @@
+// Command alsmcp is a Model Context Protocol server for Live, run by an
+// assistant over stdio. Protocol messages use stdout, so logs go to stderr.
+//
+// Usage:
+//
+//	alsmcp [-send 11000] [-listen 11001] [-timeout 2s]
+//
+// Point -send and -listen at a simulated AbletonOSC to try it without Live.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsmcp"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+func main() {
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	rateLimit := flag.Int("rate", 0, "maximum OSC messages per second, 0 for no limit")
+	flag.Parse()
+
+	log.SetOutput(os.Stderr)
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+		RateLimit:  *rateLimit,
+	})
+	client.Run()
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := alsmcp.NewServer(client).Serve(ctx, os.Stdin, os.Stdout); err != nil && ctx.Err() == nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,49 @@
+// Command alsrpcd serves the als API over gRPC. The schema is
+// alsrpc/alspb/als.proto.
+//
+// Usage:
+//
+//	alsrpcd [-addr :9090] [-send 11000] [-listen 11001] [-timeout 2s]
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsrpc"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "gRPC listen address")
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	rateLimit := flag.Int("rate", 0, "maximum OSC messages per second, 0 for no limit")
+	flag.Parse()
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+		RateLimit:  *rateLimit,
+	})
+	client.Run()
+	defer client.Close()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := alsrpc.NewServer(client)
+	// reflection lets grpcurl and similar tools discover the services
+	reflection.Register(s)
+
+	log.Printf("alsrpcd listening on %s", *addr)
+	log.Fatal(s.Serve(lis))
+}
@@ -0,0 +1,62 @@
+// Command alsscript runs a Starlark automation script against Live,
+// reloading it whenever the file is saved.
+//
+// Usage:
+//
+//	alsscript [flags] <script.star>
+//
+// See package alsscript for what scripts can use.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsscript"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+func main() {
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	poll := flag.Duration("poll", 500*time.Millisecond, "how often to check the script for changes")
+	maxSteps := flag.Uint64("max-steps", alsscript.DefaultMaxSteps, "computation limit of each script run")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: alsscript [flags] <script.star>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+	})
+	client.Run()
+	defer client.Close()
+
+	engine := alsscript.New(client, alsscript.Opts{Output: os.Stdout, MaxSteps: *maxSteps})
+	defer engine.Close()
+
+	stop := make(chan struct{})
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		close(stop)
+	}()
+
+	if err := engine.Watch(flag.Arg(0), *poll, stop); err != nil {
+		fmt.Fprintln(os.Stderr, "alsscript:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,139 @@
+// Command alsshow runs a show file against Live.
+//
+// Usage:
+//
+//	alsshow [flags] <show.yaml>
+//
+// The show is checked against the open set before it starts. Then press
+// enter to fire the next cue, type "goto <song> [cue]" to jump (counting
+// from 1) or "quit". With -timed, cues with bars advance by themselves.
+// With -check, alsshow only checks the show.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/show"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+func main() {
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	timed := flag.Bool("timed", false, "advance cues with bars automatically")
+	check := flag.Bool("check", false, "check the show against the set and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: alsshow [flags] <show.yaml>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	s, err := show.Load(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "alsshow:", err)
+		os.Exit(1)
+	}
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+	})
+	client.Run()
+	defer client.Close()
+
+	if *check {
+		if err := s.Check(client); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println("ok")
+		return
+	}
+
+	runner, err := show.NewRunner(client, s, show.Opts{
+		Timed: *timed,
+		OnCue: func(pos show.Position) {
+			song := s.Songs[pos.Song]
+			fmt.Printf("%d.%d  %s: %s\n", pos.Song+1, pos.Cue+1, song.Name, song.Cues[pos.Cue].Scene)
+		},
+		OnError: func(err error) {
+			fmt.Println("error:", err)
+		},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer runner.Stop()
+
+	if err := run(runner); err != nil {
+		fmt.Fprintln(os.Stderr, "alsshow:", err)
+		os.Exit(1)
+	}
+}
+
+func run(runner *show.Runner) error {
+	if err := runner.Start(); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			if err := runner.Next(); errors.Is(err, show.ErrEnd) {
+				fmt.Println(err)
+			} else if err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch fields[0] {
+		case "quit", "q":
+			return nil
+		case "goto", "g":
+			pos, err := parsePosition(fields[1:])
+			if err == nil {
+				err = runner.GoTo(pos)
+			}
+			if err != nil {
+				fmt.Println("error:", err)
+			}
+		default:
+			fmt.Println(`enter fires the next cue, "goto <song> [cue]" jumps, "quit" exits`)
+		}
+	}
+	return scanner.Err()
+}
+
+// parsePosition parses a song and optional cue, counting from 1
+func parsePosition(args []string) (show.Position, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return show.Position{}, errors.New("usage: goto <song> [cue]")
+	}
+	var n [2]int
+	n[1] = 1
+	for i, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil || v < 1 {
+			return show.Position{}, fmt.Errorf("invalid number %q", arg)
+		}
+		n[i] = v
+	}
+	return show.Position{Song: n[0] - 1, Cue: n[1] - 1}, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+)
+
+// key is a key press: a printable character or one of the names below
+type key string
+
+const (
+	keyUp    key = "up"
+	keyDown  key = "down"
+	keyLeft  key = "left"
+	keyRight key = "right"
+	keyEnter key = "enter"
+	keyQuit  key = "ctrl-c"
+)
+
+// volumeStep is how much +/- change the volume
+const volumeStep = 0.02
+
+// parseKeys splits raw terminal input into keys. Unknown escape sequences are
+// dropped.
+func parseKeys(buf []byte) []key {
+	var keys []key
+	for len(buf) > 0 {
+		switch {
+		case len(buf) >= 3 && buf[0] == 0x1b && (buf[1] == '[' || buf[1] == 'O'):
+			// skip parameters up to the final byte
+			n := 2
+			for n < len(buf)-1 && (buf[n] < 0x40 || buf[n] > 0x7e) {
+				n++
+			}
+			switch buf[n] {
+			case 'A':
+				keys = append(keys, keyUp)
+			case 'B':
+				keys = append(keys, keyDown)
+			case 'C':
+				keys = append(keys, keyRight)
+			case 'D':
+				keys = append(keys, keyLeft)
+			}
+			buf = buf[min(n+1, len(buf)):]
+			continue
+		case buf[0] == '\r' || buf[0] == '\n':
+			keys = append(keys, keyEnter)
+		case buf[0] == 3:
+			keys = append(keys, keyQuit)
+		case buf[0] >= ' ' && buf[0] < 0x7f:
+			keys = append(keys, key(buf[0:1]))
+		}
+		buf = buf[1:]
+	}
+	return keys
+}
+
+// handle acts on a key. It reports whether to quit.
+func (a *app) handle(k key) bool {
+	st := a.snapshot()
+	ti, si := int32(st.cursor.track), int32(st.cursor.scene)
+	var t *track
+	if int(ti) < len(st.tracks) {
+		t = &st.tracks[ti]
+	}
+	sceneName := ""
+	if int(si) < len(st.scenes) {
+		sceneName = st.scenes[si]
+	}
+
+	switch k {
+	case "q", keyQuit:
+		return true
+	case keyUp, "k":
+		a.move(0, -1)
+	case keyDown, "j":
+		a.move(0, 1)
+	case keyLeft, "h":
+		a.move(-1, 0)
+	case keyRight, "l":
+		a.move(1, 0)
+	case " ":
+		if st.playing {
+			a.client.Song.StopPlaying()
+		} else {
+			a.client.Song.StartPlaying()
+		}
+	case "f":
+		if int(si) < len(st.scenes) {
+			a.client.Scene.Fire(si)
+			a.setStatus("fired scene " + sceneName)
+		}
+	case "X":
+		a.client.Song.StopAllClips()
+		a.setStatus("stopped all clips")
+	case "r":
+		notify(a.reload)
+	}
+	if t == nil {
+		return false
+	}
+
+	switch k {
+	case keyEnter:
+		if int(si) < len(st.scenes) {
+			a.client.ClipSlot.Fire(ti, si)
+			a.setStatus(fmt.Sprintf("fired %s / %s", t.name, sceneName))
+		}
+	case "x":
+		a.client.Track.StopAllClips(ti)
+		a.setStatus("stopped " + t.name)
+	case "m":
+		a.client.Track.SetMute(ti, !t.mute)
+	case "s":
+		a.client.Track.SetSolo(ti, !t.solo)
+	case "a":
+		a.client.Track.SetArm(ti, !t.arm)
+	case "+", "=":
+		a.client.Track.SetVolume(ti, min(1, t.volume+volumeStep))
+	case "-":
+		a.client.Track.SetVolume(ti, max(0, t.volume-volumeStep))
+	}
+	return false
+}
+
+func (a *app) move(tracks, scenes int) {
+	a.update(func(st *state) {
+		st.cursor.track += tracks
+		st.cursor.scene += scenes
+		a.clampCursor()
+	})
+}
+
+func (a *app) setStatus(s string) {
+	a.update(func(st *state) { st.status = s })
+}
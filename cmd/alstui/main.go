@@ -0,0 +1,144 @@
+// Command alstui is a full-screen terminal UI for Live: the session clip
+// grid, a mixer strip per track with meters, and the transport. It's driven
+// by Live's listeners, so the screen follows Live without polling: clips
+// being recorded, added, removed or renamed and renamed scenes show up as
+// they change. That takes a listener on every clip slot, so very large sets
+// are slow to load.
+//
+// Usage:
+//
+//	alstui [flags]
+//
+// Keys: arrows or hjkl move, enter fires the clip slot, f fires the scene,
+// m/s/a toggle mute/solo/arm, +/- change the volume, space starts or stops
+// playback, x stops the track, X stops all clips, r reloads and q quits.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/oscclient"
+)
+
+// frame is the shortest time between redraws, so meters don't flood the
+// terminal
+const frame = 30 * time.Millisecond
+
+func main() {
+	send := flag.Int("send", 11000, "AbletonOSC port")
+	listen := flag.Int("listen", 11001, "port AbletonOSC replies to")
+	timeout := flag.Duration("timeout", 2*time.Second, "time to wait for Live")
+	flag.Parse()
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		fmt.Fprintln(os.Stderr, "alstui: stdin is not a terminal")
+		os.Exit(2)
+	}
+
+	client := als.NewClient(oscclient.ClientOpts{
+		SendAddr:   *send,
+		ListenAddr: *listen,
+		Timeout:    *timeout,
+	})
+	client.Run()
+	defer client.Close()
+
+	a := newApp(client)
+	if err := a.load(); err != nil {
+		fmt.Fprintln(os.Stderr, "alstui:", err)
+		os.Exit(1)
+	}
+	defer a.stop()
+
+	if err := run(a, fd, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "alstui:", err)
+		os.Exit(1)
+	}
+}
+
+// run takes over the terminal until the user quits
+func run(a *app, fd int, in io.Reader, out io.Writer) error {
+	saved, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, saved)
+
+	// alternate screen, hidden cursor
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+
+	keys := make(chan []key)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := in.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			keys <- parseKeys(buf[:n])
+		}
+	}()
+
+	resize, stopResize := watchResize(fd)
+	defer stopResize()
+
+	w := bufio.NewWriter(out)
+	draw := func() {
+		width, height, err := term.GetSize(fd)
+		if err != nil {
+			width, height = 80, 24
+		}
+		lines := render(a.snapshot(), width, height)
+		w.WriteString("\x1b[H")
+		w.WriteString(strings.Join(lines, "\x1b[K\r\n"))
+		w.WriteString("\x1b[K\x1b[J")
+		w.Flush()
+	}
+	draw()
+
+	ticker := time.NewTicker(frame)
+	defer ticker.Stop()
+	dirty := false
+	for {
+		select {
+		case ks, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, k := range ks {
+				if a.handle(k) {
+					return nil
+				}
+			}
+		case <-a.changed:
+			dirty = true
+		case <-resize:
+			dirty = true
+		case <-a.reload:
+			if err := a.load(); err != nil {
+				a.setStatus("reload: " + err.Error())
+			}
+		case <-a.clips:
+			if err := a.refreshClips(); err != nil {
+				a.setStatus("clips: " + err.Error())
+			}
+		case <-ticker.C:
+			if dirty {
+				draw()
+				dirty = false
+			}
+		}
+	}
+}
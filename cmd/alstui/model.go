@@ -0,0 +1,445 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/matt0792/ableton-ctrl/als"
+	"github.com/matt0792/ableton-ctrl/alsex/listen"
+)
+
+// state is everything on screen. It's updated by listener events, never by
+// polling.
+type state struct {
+	tempo   float32
+	playing bool
+	beat    int32
+
+	tracks []track
+	scenes []string
+
+	cursor struct{ track, scene int }
+	status string
+}
+
+type track struct {
+	name            string
+	mute, solo, arm bool
+	volume          float32
+	meterL, meterR  float32
+	playing, fired  int32 // slot indices, -1 for none
+	clips           []clip
+}
+
+type clip struct {
+	name  string
+	color int32
+	has   bool
+}
+
+// trackProps are the track listeners kept for every track
+var trackProps = []string{
+	"mute", "solo", "arm", "volume", "output_meter_left", "output_meter_right",
+	"playing_slot_index", "fired_slot_index", "name",
+}
+
+// songProps are the song listeners. num_tracks and num_scenes reload the
+// session, as indices shift.
+var songProps = []string{"tempo", "is_playing", "beat", "num_tracks", "num_scenes"}
+
+// clipProps are the listeners kept for every clip. Every slot has a
+// has_clip listener too, which adds and drops them as clips come and go.
+var clipProps = []string{"name", "color"}
+
+// app keeps the state in sync with Live
+type app struct {
+	client *als.Client
+	hub    *listen.Hub
+
+	mu    sync.Mutex
+	st    state
+	unsub []func()
+	// clipUnsub holds the listeners on each clip by track and slot, and
+	// slots the tracks whose clips were added or removed
+	clipUnsub map[[2]int32]func()
+	slots     map[int32]bool
+
+	// changed is signalled after every update, reload when the structure
+	// changes and clips when clips are added or removed. They drop signals
+	// while one is pending.
+	changed chan struct{}
+	reload  chan struct{}
+	clips   chan struct{}
+}
+
+func newApp(client *als.Client) *app {
+	return &app{
+		client:    client,
+		hub:       listen.Shared(client),
+		changed:   make(chan struct{}, 1),
+		reload:    make(chan struct{}, 1),
+		clips:     make(chan struct{}, 1),
+		clipUnsub: map[[2]int32]func(){},
+		slots:     map[int32]bool{},
+	}
+}
+
+func notify(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// update changes the state and signals a redraw
+func (a *app) update(fn func(st *state)) {
+	a.mu.Lock()
+	fn(&a.st)
+	a.mu.Unlock()
+	notify(a.changed)
+}
+
+// snapshot returns a copy of the state to render. Tracks are copied, as
+// listeners keep changing them.
+func (a *app) snapshot() state {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	st := a.st
+	st.tracks = append([]track(nil), a.st.tracks...)
+	return st
+}
+
+// load reads the session and starts listening to it, replacing any previous
+// listeners
+func (a *app) load() error {
+	a.stop()
+
+	st := state{}
+	song, err := a.client.Get("/live/song/get/tempo")
+	if err != nil {
+		return err
+	}
+	st.tempo = als.Float(als.First(song))
+	playing, err := a.client.Get("/live/song/get/is_playing")
+	if err != nil {
+		return err
+	}
+	st.playing = als.Bool(als.First(playing))
+
+	names, err := a.client.Get("/live/song/get/track_names")
+	if err != nil {
+		return err
+	}
+	if st.scenes, err = a.client.SceneNames(); err != nil {
+		return err
+	}
+
+	st.tracks = make([]track, len(names))
+	for i, name := range names {
+		t, err := a.loadTrack(int32(i), len(st.scenes))
+		if err != nil {
+			return err
+		}
+		t.name = als.String(name)
+		st.tracks[i] = t
+	}
+
+	a.mu.Lock()
+	st.cursor = a.st.cursor
+	a.st = st
+	a.clampCursor()
+	a.mu.Unlock()
+	notify(a.changed)
+
+	return a.listen(len(names), len(st.scenes))
+}
+
+func (a *app) loadTrack(index int32, scenes int) (track, error) {
+	t := track{playing: -1, fired: -1, clips: make([]clip, scenes)}
+
+	values := map[string][]any{}
+	for _, prop := range []string{"mute", "solo", "arm", "volume", "playing_slot_index", "fired_slot_index"} {
+		v, err := a.client.Get("/live/track/get/"+prop, index)
+		if err != nil {
+			return t, err
+		}
+		values[prop] = v
+	}
+	t.mute = als.Bool(als.First(values["mute"]))
+	t.solo = als.Bool(als.First(values["solo"]))
+	t.arm = als.Bool(als.First(values["arm"]))
+	t.volume = als.Float(als.First(values["volume"]))
+	t.playing = als.Int(als.First(values["playing_slot_index"]))
+	t.fired = als.Int(als.First(values["fired_slot_index"]))
+
+	clips, err := a.readClips(index, scenes)
+	t.clips = clips
+	return t, err
+}
+
+// readClips reads a track's clips. The lists have one entry per slot, nil
+// where the slot is empty.
+func (a *app) readClips(index int32, scenes int) ([]clip, error) {
+	clips := make([]clip, scenes)
+	names, err := a.client.Get("/live/track/get/clips/name", index)
+	if err != nil {
+		return clips, err
+	}
+	colors, err := a.client.Get("/live/track/get/clips/color", index)
+	if err != nil {
+		return clips, err
+	}
+	for i := range clips {
+		if i < len(names) {
+			name, ok := names[i].(string)
+			clips[i] = clip{name: name, has: ok}
+		}
+		if i < len(colors) {
+			clips[i].color = als.Int(colors[i])
+		}
+	}
+	return clips, nil
+}
+
+// listen subscribes to the song, every track and scene, every slot and
+// every clip
+func (a *app) listen(tracks, scenes int) error {
+	var unsub []func()
+	subscribe := func(t listen.Topic, fn func(listen.Event)) error {
+		u, err := a.hub.Subscribe(t, fn)
+		if err != nil {
+			return err
+		}
+		unsub = append(unsub, u)
+		return nil
+	}
+
+	for _, prop := range songProps {
+		if err := subscribe(listen.Topic{Object: "song", Property: prop}, a.onSong); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < scenes; i++ {
+		t := listen.Topic{Object: "scene", Property: "name", Indices: []int32{int32(i)}}
+		if err := subscribe(t, a.onScene); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < tracks; i++ {
+		for _, prop := range trackProps {
+			t := listen.Topic{Object: "track", Property: prop, Indices: []int32{int32(i)}}
+			if err := subscribe(t, a.onTrack); err != nil {
+				return err
+			}
+		}
+		for slot := 0; slot < scenes; slot++ {
+			t := listen.Topic{Object: "clip_slot", Property: "has_clip", Indices: []int32{int32(i), int32(slot)}}
+			if err := subscribe(t, a.onSlot); err != nil {
+				return err
+			}
+		}
+	}
+
+	a.mu.Lock()
+	a.unsub = unsub
+	a.mu.Unlock()
+
+	for i := 0; i < tracks; i++ {
+		if err := a.followClips(int32(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// followClips listens to the track's clips, and stops listening to slots
+// that have become empty
+func (a *app) followClips(index int32) error {
+	a.mu.Lock()
+	var add [][2]int32
+	var drop []func()
+	if int(index) < len(a.st.tracks) {
+		clips := a.st.tracks[index].clips
+		for slot, c := range clips {
+			key := [2]int32{index, int32(slot)}
+			if _, ok := a.clipUnsub[key]; c.has && !ok {
+				add = append(add, key)
+			}
+		}
+		for key, unsubscribe := range a.clipUnsub {
+			if key[0] == index && (int(key[1]) >= len(clips) || !clips[key[1]].has) {
+				drop = append(drop, unsubscribe)
+				delete(a.clipUnsub, key)
+			}
+		}
+	}
+	a.mu.Unlock()
+
+	for _, unsubscribe := range drop {
+		unsubscribe()
+	}
+	for _, key := range add {
+		var unsub []func()
+		for _, prop := range clipProps {
+			u, err := a.hub.Subscribe(listen.Topic{Object: "clip", Property: prop, Indices: key[:]}, a.onClip)
+			if err != nil {
+				return err
+			}
+			unsub = append(unsub, u)
+		}
+		a.mu.Lock()
+		a.clipUnsub[key] = func() {
+			for _, u := range unsub {
+				u()
+			}
+		}
+		a.mu.Unlock()
+	}
+	return nil
+}
+
+// refreshClips reads the clips of tracks whose slots changed and follows
+// them. It runs on the UI goroutine, as it waits for Live.
+func (a *app) refreshClips() error {
+	a.mu.Lock()
+	tracks := a.slots
+	a.slots = map[int32]bool{}
+	scenes := len(a.st.scenes)
+	a.mu.Unlock()
+
+	for index := range tracks {
+		clips, err := a.readClips(index, scenes)
+		if err != nil {
+			return err
+		}
+		a.update(func(st *state) {
+			if int(index) < len(st.tracks) && len(st.scenes) == scenes {
+				st.tracks[index].clips = clips
+			}
+		})
+		if err := a.followClips(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stop stops every listener
+func (a *app) stop() {
+	a.mu.Lock()
+	unsub := a.unsub
+	a.unsub = nil
+	for key, u := range a.clipUnsub {
+		unsub = append(unsub, u)
+		delete(a.clipUnsub, key)
+	}
+	a.mu.Unlock()
+	for _, u := range unsub {
+		u()
+	}
+}
+
+// onSong, onTrack, onScene, onSlot and onClip run on the OSC server
+// goroutine, so only update state
+func (a *app) onSong(e listen.Event) {
+	v := als.First(e.Values)
+	switch e.Property {
+	case "num_tracks", "num_scenes":
+		notify(a.reload)
+		return
+	}
+	a.update(func(st *state) {
+		switch e.Property {
+		case "tempo":
+			st.tempo = als.Float(v)
+		case "is_playing":
+			st.playing = als.Bool(v)
+		case "beat":
+			st.beat = als.Int(v)
+		}
+	})
+}
+
+func (a *app) onTrack(e listen.Event) {
+	v := als.First(e.Values)
+	index := int(e.Indices[0])
+	a.update(func(st *state) {
+		if index >= len(st.tracks) {
+			return
+		}
+		t := &st.tracks[index]
+		switch e.Property {
+		case "mute":
+			t.mute = als.Bool(v)
+		case "solo":
+			t.solo = als.Bool(v)
+		case "arm":
+			t.arm = als.Bool(v)
+		case "volume":
+			t.volume = als.Float(v)
+		case "output_meter_left":
+			t.meterL = als.Float(v)
+		case "output_meter_right":
+			t.meterR = als.Float(v)
+		case "playing_slot_index":
+			t.playing = als.Int(v)
+		case "fired_slot_index":
+			t.fired = als.Int(v)
+		case "name":
+			t.name = als.String(v)
+		}
+	})
+}
+
+func (a *app) onScene(e listen.Event) {
+	index := int(e.Indices[0])
+	a.update(func(st *state) {
+		if index < len(st.scenes) {
+			st.scenes[index] = als.String(als.First(e.Values))
+		}
+	})
+}
+
+// onSlot notes a clip added to or removed from a slot. The clip's name and
+// color are read on the UI goroutine, which refreshClips runs on.
+func (a *app) onSlot(e listen.Event) {
+	index, slot := e.Indices[0], int(e.Indices[1])
+	has := als.Bool(als.First(e.Values))
+	a.mu.Lock()
+	changed := false
+	if int(index) < len(a.st.tracks) && slot < len(a.st.tracks[index].clips) {
+		if c := &a.st.tracks[index].clips[slot]; c.has != has {
+			*c = clip{has: has}
+			a.slots[index] = true
+			changed = true
+		}
+	}
+	a.mu.Unlock()
+	if changed {
+		notify(a.changed)
+		notify(a.clips)
+	}
+}
+
+func (a *app) onClip(e listen.Event) {
+	v := als.First(e.Values)
+	index, slot := int(e.Indices[0]), int(e.Indices[1])
+	a.update(func(st *state) {
+		if index >= len(st.tracks) || slot >= len(st.tracks[index].clips) {
+			return
+		}
+		c := &st.tracks[index].clips[slot]
+		switch e.Property {
+		case "name":
+			c.name = als.String(v)
+		case "color":
+			c.color = als.Int(v)
+		}
+	})
+}
+
+// clampCursor keeps the cursor on the grid. The caller holds mu.
+func (a *app) clampCursor() {
+	c := &a.st.cursor
+	c.track = max(0, min(c.track, len(a.st.tracks)-1))
+	c.scene = max(0, min(c.scene, len(a.st.scenes)-1))
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matt0792/ableton-ctrl/als/alstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestApp(t *testing.T) (*alstest.Server, *app) {
+	live := alstest.NewServer(t)
+	live.Set("/live/song/get/tempo", float32(124))
+	live.Set("/live/song/get/is_playing", int32(1))
+	live.Set("/live/song/get/track_names", "Drums", "Bass")
+	live.Set("/live/song/get/num_scenes", int32(2))
+	live.Set("/live/scene/get/name", int32(0), "Intro")
+	live.Set("/live/scene/get/name", int32(1), "Drop")
+	for i := int32(0); i < 2; i++ {
+		live.Set("/live/track/get/mute", i, int32(0))
+		live.Set("/live/track/get/solo", i, int32(0))
+		live.Set("/live/track/get/arm", i, int32(0))
+		live.Set("/live/track/get/volume", i, float32(0.85))
+		live.Set("/live/track/get/playing_slot_index", i, int32(-1))
+		live.Set("/live/track/get/fired_slot_index", i, int32(-1))
+	}
+	live.Set("/live/track/get/clips/name", int32(0), "Beat", "Fill")
+	live.Set("/live/track/get/clips/color", int32(0), int32(0xff0000), int32(0x00ff00))
+	live.Set("/live/track/get/clips/name", int32(1), nil, "Sub")
+	live.Set("/live/track/get/clips/color", int32(1), nil, int32(0x0000ff))
+
+	a := newApp(live.Client(t))
+	require.NoError(t, a.load())
+	t.Cleanup(a.stop)
+	return live, a
+}
+
+var escapes = regexp.MustCompile("\x1b\\[[0-9;?]*[a-zA-Z]")
+
+func plain(lines []string) string {
+	return escapes.ReplaceAllString(strings.Join(lines, "\n"), "")
+}
+
+// TestLoad verifies the session is read with empty slots in place
+func TestLoad(t *testing.T) {
+	_, a := newTestApp(t)
+
+	st := a.snapshot()
+	assert.Equal(t, float32(124), st.tempo)
+	assert.True(t, st.playing)
+	assert.Equal(t, []string{"Intro", "Drop"}, st.scenes)
+	require.Len(t, st.tracks, 2)
+	assert.Equal(t, "Bass", st.tracks[1].name)
+	assert.Equal(t, []clip{{}, {name: "Sub", color: 0x0000ff, has: true}}, st.tracks[1].clips)
+	assert.Equal(t, int32(-1), st.tracks[0].playing)
+}
+
+// TestEvents verifies listener events update the state and structural
+// changes ask for a reload
+func TestEvents(t *testing.T) {
+	live, a := newTestApp(t)
+	live.WaitFor(t, "/live/track/start_listen/output_meter_left")
+
+	live.Emit("/live/song/get/tempo", float32(130))
+	live.Emit("/live/track/get/mute", int32(1), int32(1))
+	live.Emit("/live/track/get/playing_slot_index", int32(0), int32(1))
+	live.Emit("/live/track/get/output_meter_left", int32(0), float32(0.6))
+	assert.Eventually(t, func() bool {
+		st := a.snapshot()
+		return st.tempo == 130 && st.tracks[1].mute &&
+			st.tracks[0].playing == 1 && st.tracks[0].meterL == 0.6
+	}, time.Second, 5*time.Millisecond)
+
+	live.Emit("/live/song/get/num_tracks", int32(3))
+	select {
+	case <-a.reload:
+	case <-time.After(time.Second):
+		t.Fatal("no reload after num_tracks changed")
+	}
+}
+
+// TestClipEvents verifies renamed scenes and clips, and clips added to
+// slots, are followed
+func TestClipEvents(t *testing.T) {
+	live, a := newTestApp(t)
+	live.WaitFor(t, "/live/clip/start_listen/color")
+
+	live.Emit("/live/scene/get/name", int32(1), "Break")
+	live.Emit("/live/clip/get/name", int32(0), int32(1), "Roll")
+	assert.Eventually(t, func() bool {
+		st := a.snapshot()
+		return st.scenes[1] == "Break" && st.tracks[0].clips[1].name == "Roll"
+	}, time.Second, 5*time.Millisecond)
+
+	// a clip recorded into the empty slot is read and listened to
+	live.Set("/live/track/get/clips/name", int32(1), "Bassline", "Sub")
+	live.Set("/live/track/get/clips/color", int32(1), int32(0xffff00), int32(0x0000ff))
+	live.Emit("/live/clip_slot/get/has_clip", int32(1), int32(0), int32(1))
+	select {
+	case <-a.clips:
+	case <-time.After(time.Second):
+		t.Fatal("no refresh after a clip was added")
+	}
+	require.NoError(t, a.refreshClips())
+	assert.Equal(t, clip{name: "Bassline", color: 0xffff00, has: true}, a.snapshot().tracks[1].clips[0])
+
+	live.Emit("/live/clip/get/color", int32(1), int32(0), int32(0x00ffff))
+	assert.Eventually(t, func() bool {
+		return a.snapshot().tracks[1].clips[0].color == 0x00ffff
+	}, time.Second, 5*time.Millisecond)
+}
+
+// TestKeys verifies raw input is split into keys
+func TestKeys(t *testing.T) {
+	keys := parseKeys([]byte("j\x1b[A\x1b[1;2Cm\r\x03 \x1bOD"))
+	assert.Equal(t, []key{"j", keyUp, keyRight, "m", keyEnter, keyQuit, " ", keyLeft}, keys)
+}
+
+// TestHandle verifies keys move the cursor and control Live
+func TestHandle(t *testing.T) {
+	live, a := newTestApp(t)
+
+	a.handle(keyDown)
+	a.handle("l")
+	a.handle("l") // clamped to the last track
+	st := a.snapshot()
+	assert.Equal(t, 1, st.cursor.track)
+	assert.Equal(t, 1, st.cursor.scene)
+
+	a.handle(keyEnter)
+	msgs := live.WaitFor(t, "/live/clip_slot/fire")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+	assert.Equal(t, "fired Bass / Drop", a.snapshot().status)
+
+	a.handle("m")
+	msgs = live.WaitFor(t, "/live/track/set/mute")
+	assert.Equal(t, []any{int32(1), int32(1)}, msgs[0].Arguments)
+
+	a.handle("+")
+	msgs = live.WaitFor(t, "/live/track/set/volume")
+	assert.InDelta(t, 0.87, msgs[0].Arguments[1], 1e-6)
+
+	a.handle(" ")
+	live.WaitFor(t, "/live/song/stop_playing")
+	a.handle("f")
+	msgs = live.WaitFor(t, "/live/scene/fire")
+	assert.Equal(t, []any{int32(1)}, msgs[0].Arguments)
+
+	assert.True(t, a.handle("q"))
+}
+
+// TestRender verifies the grid, mixer and transport are drawn
+func TestRender(t *testing.T) {
+	_, a := newTestApp(t)
+	a.update(func(st *state) {
+		st.tracks[0].playing = 0
+		st.tracks[1].fired = 1
+		st.tracks[1].solo = true
+		st.tracks[0].meterR = 0.5
+	})
+
+	screen := plain(render(a.snapshot(), 80, 16))
+	assert.Contains(t, screen, "▶ 124.00 BPM  beat 0")
+	assert.Contains(t, screen, "Intro      ▶ Beat      ·")
+	assert.Contains(t, screen, "Drop       ■ Fill      ◇ Sub")
+	assert.Contains(t, screen, " M S A       M S A")
+	assert.Contains(t, screen, "out        █████░░░░░ ")
+
+	// two scenes fit, the rest is blank above the mixer
+	lines := render(a.snapshot(), 80, 16)
+	assert.Len(t, lines, 16)
+
+	// narrow screens scroll to the cursor
+	a.move(1, 1)
+	screen = plain(render(a.snapshot(), sceneWidth+colWidth, 9))
+	assert.NotContains(t, screen, "Drums")
+	assert.Contains(t, screen, "Drop       ◇ Sub")
+	assert.NotContains(t, screen, "Intro")
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Layout. Each track is a column of colWidth, the scene names sit in a
+// column on the left.
+const (
+	colWidth   = 12
+	sceneWidth = 10
+
+	// rows outside the grid: transport, grid header, the mixer strip and the
+	// footer
+	chromeRows = 2 + mixerRows + 2
+	mixerRows  = 4
+)
+
+const (
+	reset   = "\x1b[0m"
+	reverse = "\x1b[7m"
+	bold    = "\x1b[1m"
+	dim     = "\x1b[2m"
+)
+
+// Slot markers
+const (
+	markPlaying = "▶"
+	markFired   = "◇"
+	markClip    = "■"
+	markEmpty   = "·"
+)
+
+const help = "←↓↑→ move  enter fire  f scene  m/s/a mute/solo/arm  +/- volume  space play  x/X stop  r reload  q quit"
+
+// render draws the state as lines fitting width and height. It keeps the
+// cursor on screen, scrolling the grid when the session is larger.
+func render(st state, width, height int) []string {
+	var lines []string
+
+	lines = append(lines, pad(transport(st), width))
+
+	visible := max(1, (width-sceneWidth)/colWidth)
+	first := scroll(st.cursor.track, visible, len(st.tracks))
+	last := min(len(st.tracks), first+visible)
+	tracks := st.tracks[first:last]
+
+	// header of track names
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", sceneWidth))
+	for i, t := range tracks {
+		name := pad(" "+t.name, colWidth)
+		if first+i == st.cursor.track {
+			name = bold + name + reset
+		}
+		b.WriteString(name)
+	}
+	lines = append(lines, b.String())
+
+	rows := max(1, height-chromeRows)
+	top := scroll(st.cursor.scene, rows, len(st.scenes))
+	for s := top; s < min(len(st.scenes), top+rows); s++ {
+		b.Reset()
+		scene := pad(st.scenes[s], sceneWidth-1) + " "
+		if s == st.cursor.scene {
+			scene = bold + scene + reset
+		}
+		b.WriteString(scene)
+		for i, t := range tracks {
+			b.WriteString(slot(t, s, first+i == st.cursor.track && s == st.cursor.scene))
+		}
+		lines = append(lines, b.String())
+	}
+	for len(lines) < 2+rows {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, mixer(tracks)...)
+	lines = append(lines, dim+pad(help, width)+reset, pad(st.status, width))
+	return lines
+}
+
+func transport(st state) string {
+	if st.playing {
+		return fmt.Sprintf("%s %.2f BPM  beat %d", markPlaying, st.tempo, st.beat)
+	}
+	return fmt.Sprintf("%s %.2f BPM  stopped", markClip, st.tempo)
+}
+
+// slot draws one clip slot, coloured like the clip in Live
+func slot(t track, scene int, selected bool) string {
+	var c clip
+	if scene < len(t.clips) {
+		c = t.clips[scene]
+	}
+
+	mark := markEmpty
+	switch {
+	case int32(scene) == t.playing:
+		mark = markPlaying
+	case int32(scene) == t.fired:
+		mark = markFired
+	case c.has:
+		mark = markClip
+	}
+	text := pad(" "+mark+" "+c.name, colWidth-1) + " "
+
+	style := ""
+	if c.has {
+		style = color(c.color)
+	}
+	if selected {
+		style += reverse
+	}
+	if style == "" {
+		return text
+	}
+	return style + text + reset
+}
+
+// mixer draws a strip per track: flags, volume and the output meter
+func mixer(tracks []track) []string {
+	var flags, volume, meter strings.Builder
+	flags.WriteString(pad("", sceneWidth))
+	volume.WriteString(pad("vol", sceneWidth))
+	meter.WriteString(pad("out", sceneWidth))
+	for _, t := range tracks {
+		f := " " + toggle(t.mute, "M") + toggle(t.solo, "S") + toggle(t.arm, "A")
+		flags.WriteString(f + strings.Repeat(" ", colWidth-7))
+		volume.WriteString(" " + bar(t.volume, colWidth-2) + " ")
+		meter.WriteString(" " + bar(max(t.meterL, t.meterR), colWidth-2) + " ")
+	}
+	return []string{"", flags.String(), volume.String(), meter.String()}
+}
+
+func toggle(on bool, name string) string {
+	if on {
+		return reverse + name + reset + " "
+	}
+	return dim + name + reset + " "
+}
+
+// bar draws a 0-1 value as a bar of width cells
+func bar(v float32, width int) string {
+	n := int(max(0, min(1, v))*float32(width) + 0.5)
+	return strings.Repeat("█", n) + strings.Repeat("░", width-n)
+}
+
+// color is the escape for Live's 0xRRGGBB clip colour
+func color(c int32) string {
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", c>>16&0xff, c>>8&0xff, c&0xff)
+}
+
+// scroll returns the first of visible items to show so cursor is on screen
+func scroll(cursor, visible, total int) int {
+	return max(0, min(cursor-visible+1, total-visible))
+}
+
+// pad pads or truncates s to width runes
+func pad(s string, width int) string {
+	r := []rune(s)
+	if len(r) > width {
+		return string(r[:width])
+	}
+	return s + strings.Repeat(" ", width-len(r))
+}
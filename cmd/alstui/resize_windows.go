@@ -0,0 +1,39 @@
+package main
+
+import (
+	"time"
+
+	"golang.org/x/term"
+)
+
+// resizePoll is how often the console size is checked, as Windows has no
+// resize signal
+const resizePoll = 250 * time.Millisecond
+
+// watchResize signals on resize until stop is called
+func watchResize(fd int) (resize <-chan struct{}, stop func()) {
+	ch := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(resizePoll)
+		defer ticker.Stop()
+		width, height, _ := term.GetSize(fd)
+		for {
+			select {
+			case <-ticker.C:
+				w, h, err := term.GetSize(fd)
+				if err != nil || (w == width && h == height) {
+					continue
+				}
+				width, height = w, h
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return ch, func() { close(done) }
+}
@@ -1,8 +1,24 @@
+// Package oscclient sends OSC messages to AbletonOSC and routes what comes
+// back, either to listeners registered with Subscribe or to requests
+// waiting for a reply.
+//
+// Replies are matched by address and by the arguments they echo. AbletonOSC
+// starts a getter's reply with the getter's own arguments, such as a track
+// index, so Request only takes a reply echoing its params, and RequestEcho
+// one echoing the given leading arguments. A reply goes to the oldest
+// request on its address that takes it. Replies nobody is waiting for, such
+// as late replies to requests that timed out, are dropped rather than kept
+// for the next request on the address, which would otherwise get another
+// track's value.
+//
+// Call.Wait registers after the message was sent, so it can miss a fast
+// reply. Use Request where the reply matters.
 package oscclient
 
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"sync"
 	"time"
 
@@ -29,6 +45,7 @@ type ClientOpts struct {
 // implements token bucket rate limiting
 type rateLimiter struct {
 	enabled    bool
+	perSecond  int
 	tokens     int
 	maxTokens  int
 	refillRate time.Duration
@@ -42,6 +59,7 @@ func newRateLimiter(requestsPerSec int) *rateLimiter {
 	}
 	return &rateLimiter{
 		enabled:    true,
+		perSecond:  requestsPerSec,
 		tokens:     requestsPerSec,
 		maxTokens:  requestsPerSec,
 		refillRate: time.Second / time.Duration(requestsPerSec),
@@ -151,6 +169,55 @@ func (c *Client) sendWith(addr string, params ...any) *Call {
 	return call
 }
 
+// RateLimit returns the maximum messages sent per second, or 0 if sending
+// isn't limited.
+func (c *Client) RateLimit() int {
+	return c.rateLimiter.perSecond
+}
+
+// Subscribe calls fn for every message received on addr until the returned
+// function is called. See Receiver.Subscribe.
+func (c *Client) Subscribe(addr string, fn func(*osc.Message)) (unsubscribe func()) {
+	return c.receiver.Subscribe(addr, fn)
+}
+
+// Request sends a message and waits for the response, returning ErrTimeout
+// if none arrives. The response is expected before sending, so fast replies
+// can't be missed.
+//
+// AbletonOSC replies to getters start with the getter's arguments, such as
+// a track index, so only a reply echoing params is taken. Replies to other
+// requests on the same address, late replies and listener updates are left
+// alone.
+func (c *Client) Request(addr string, params ...any) (*osc.Message, error) {
+	return c.RequestEcho(addr, params, params...)
+}
+
+// RequestEcho is Request for getters whose reply echoes only some leading
+// arguments, such as /live/clip/get/notes with a range, which echoes the
+// track and clip. A nil echo takes any reply.
+func (c *Client) RequestEcho(addr string, echo []any, params ...any) (*osc.Message, error) {
+	ch := c.receiver.ExpectMatch(addr, Echoes(echo...))
+	c.sendWith(addr, params...)
+	return c.receiver.WaitForErr(ch, addr)
+}
+
+// Echoes returns a match for Queue.RegisterMatch taking messages whose
+// leading arguments are args.
+func Echoes(args ...any) func(*osc.Message) bool {
+	return func(msg *osc.Message) bool {
+		if len(msg.Arguments) < len(args) {
+			return false
+		}
+		for i, arg := range args {
+			if !reflect.DeepEqual(msg.Arguments[i], arg) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 type Call struct {
 	receiver *Receiver
 	addr     string
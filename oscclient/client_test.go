@@ -414,3 +414,57 @@ func BenchmarkQueueOperations(b *testing.B) {
 		<-ch
 	}
 }
+
+// TestReceiverSubscribe verifies subscribers see every message until unsubscribed
+func TestReceiverSubscribe(t *testing.T) {
+	receiver := NewReceiver(0, false)
+
+	var beats []int32
+	unsubscribe := receiver.Subscribe("/live/song/get/beat", func(msg *osc.Message) {
+		beats = append(beats, msg.Arguments[0].(int32))
+	})
+
+	// pending requests still receive the message
+	ch := receiver.Expect("/live/song/get/beat")
+	for _, beat := range []int32{1, 2} {
+		msg := osc.NewMessage("/live/song/get/beat")
+		msg.Append(beat)
+		receiver.Populate(msg)
+	}
+	retrieved := <-ch
+	assert.Equal(t, int32(1), retrieved.Arguments[0])
+
+	unsubscribe()
+	unsubscribe()
+	msg := osc.NewMessage("/live/song/get/beat")
+	msg.Append(int32(3))
+	receiver.Populate(msg)
+
+	assert.Equal(t, []int32{1, 2}, beats)
+}
+
+// TestQueueMatch verifies replies only go to requests echoing their
+// indices, and replies nobody waits for aren't kept for later requests
+func TestQueueMatch(t *testing.T) {
+	queue := NewQueue()
+
+	// a late reply for track 1 doesn't answer a later request for track 2
+	queue.Deliver(osc.NewMessage("/live/track/get/name", int32(1), "Bass"))
+	track2 := queue.RegisterMatch("/live/track/get/name", Echoes(int32(2)))
+	select {
+	case <-track2:
+		t.Fatal("unclaimed message delivered")
+	default:
+	}
+
+	track1 := queue.RegisterMatch("/live/track/get/name", Echoes(int32(1)))
+	queue.Deliver(osc.NewMessage("/live/track/get/name", int32(1), "Bass"))
+	queue.Deliver(osc.NewMessage("/live/track/get/name", int32(2), "Keys"))
+
+	retrieved := <-track1
+	require.NotNil(t, retrieved)
+	assert.Equal(t, "Bass", retrieved.Arguments[1])
+	retrieved = <-track2
+	require.NotNil(t, retrieved)
+	assert.Equal(t, "Keys", retrieved.Arguments[1])
+}
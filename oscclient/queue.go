@@ -10,42 +10,57 @@ import (
 var (
 	ErrDuplicateId = errors.New("duplicate id")
 	ErrNotFound    = errors.New("not found")
+	ErrTimeout     = errors.New("timeout waiting for response")
 )
 
 type Queue struct {
-	pending map[string][]chan *osc.Message
+	pending map[string][]pending
 	mu      sync.RWMutex
 }
 
+// pending is a channel waiting for a response, and which responses it takes
+type pending struct {
+	ch    chan *osc.Message
+	match func(*osc.Message) bool // nil takes any response
+}
+
 func NewQueue() *Queue {
 	return &Queue{
-		pending: make(map[string][]chan *osc.Message),
+		pending: make(map[string][]pending),
 	}
 }
 
 // Register creates and registers a response channel for an address
 func (q *Queue) Register(addr string) chan *osc.Message {
+	return q.RegisterMatch(addr, nil)
+}
+
+// RegisterMatch registers a response channel taking only responses match
+// accepts, such as replies for one track. A nil match takes any response.
+func (q *Queue) RegisterMatch(addr string, match func(*osc.Message) bool) chan *osc.Message {
 	ch := make(chan *osc.Message, 1)
 	q.mu.Lock()
 	defer q.mu.Unlock()
-	q.pending[addr] = append(q.pending[addr], ch)
+	q.pending[addr] = append(q.pending[addr], pending{ch: ch, match: match})
 	return ch
 }
 
-// Deliver sends a response to the oldest waiting channel for the address (FIFO)
+// Deliver sends a response to the oldest waiting channel for the address
+// that takes it (FIFO). Responses nobody takes are dropped.
 func (q *Queue) Deliver(msg *osc.Message) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
 	channels := q.pending[msg.Address]
-	if len(channels) == 0 {
+	for i, p := range channels {
+		if p.match != nil && !p.match(msg) {
+			continue
+		}
+		p.ch <- msg
+		close(p.ch)
+		q.pending[msg.Address] = append(channels[:i:i], channels[i+1:]...)
 		return
 	}
-
-	channels[0] <- msg
-	close(channels[0])
-
-	q.pending[msg.Address] = channels[1:]
 }
 
 func (q *Queue) Cancel(addr string, ch chan *osc.Message) {
@@ -53,8 +68,8 @@ func (q *Queue) Cancel(addr string, ch chan *osc.Message) {
 	defer q.mu.Unlock()
 
 	channels := q.pending[addr]
-	for i, pending := range channels {
-		if pending == ch {
+	for i, p := range channels {
+		if p.ch == ch {
 			q.pending[addr] = append(channels[:i], channels[i+1:]...)
 			close(ch)
 			return
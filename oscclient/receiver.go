@@ -2,6 +2,7 @@ package oscclient
 
 import (
 	"log"
+	"sync"
 	"time"
 
 	"github.com/hypebeast/go-osc/osc"
@@ -11,6 +12,10 @@ type Receiver struct {
 	queue        *Queue
 	timeout      time.Duration
 	enableLogger bool
+
+	mu          sync.RWMutex
+	subscribers map[string]map[int]func(*osc.Message)
+	nextID      int
 }
 
 func NewReceiver(timeout time.Duration, enableLogger bool) *Receiver {
@@ -21,6 +26,7 @@ func NewReceiver(timeout time.Duration, enableLogger bool) *Receiver {
 		queue:        NewQueue(),
 		timeout:      timeout,
 		enableLogger: enableLogger,
+		subscribers:  make(map[string]map[int]func(*osc.Message)),
 	}
 }
 
@@ -29,8 +35,23 @@ func (r *Receiver) Expect(addr string) chan *osc.Message {
 	return r.queue.Register(addr)
 }
 
+// ExpectMatch is Expect for a response match accepts, see Queue.RegisterMatch
+func (r *Receiver) ExpectMatch(addr string, match func(*osc.Message) bool) chan *osc.Message {
+	return r.queue.RegisterMatch(addr, match)
+}
+
 // WaitFor waits for response on the given channel with timeout
 func (r *Receiver) WaitFor(ch chan *osc.Message, addr string) *osc.Message {
+	msg, err := r.WaitForErr(ch, addr)
+	if err != nil {
+		return &osc.Message{}
+	}
+	return msg
+}
+
+// WaitForErr waits for response on the given channel, returning ErrTimeout
+// if none arrives in time
+func (r *Receiver) WaitForErr(ch chan *osc.Message, addr string) (*osc.Message, error) {
 	timeout := time.After(r.timeout)
 
 	select {
@@ -39,15 +60,15 @@ func (r *Receiver) WaitFor(ch chan *osc.Message, addr string) *osc.Message {
 			if r.enableLogger {
 				log.Printf("[oscclient] WARNING: Received nil/closed message for %s", addr)
 			}
-			return &osc.Message{}
+			return nil, ErrTimeout
 		}
-		return msg
+		return msg, nil
 	case <-timeout:
 		if r.enableLogger {
 			log.Printf("[oscclient] WARNING: Timeout waiting for response from %s (waited %v)", addr, r.timeout)
 		}
 		r.queue.Cancel(addr, ch)
-		return &osc.Message{}
+		return nil, ErrTimeout
 	}
 }
 
@@ -74,7 +95,46 @@ func (r *Receiver) WaitChan(addr string) <-chan *osc.Message {
 	return result
 }
 
+// Subscribe calls fn for every message received on addr, e.g. listener
+// updates, until the returned function is called. Subscribers don't consume
+// messages, so pending requests for the same address still get a response.
+func (r *Receiver) Subscribe(addr string, fn func(*osc.Message)) (unsubscribe func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := r.nextID
+	r.nextID++
+	if r.subscribers[addr] == nil {
+		r.subscribers[addr] = make(map[int]func(*osc.Message))
+	}
+	r.subscribers[addr][id] = fn
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			delete(r.subscribers[addr], id)
+			if len(r.subscribers[addr]) == 0 {
+				delete(r.subscribers, addr)
+			}
+		})
+	}
+}
+
 // Populate delivers an incoming message to the appropriate waiting channel
+// and to any subscribers
 func (r *Receiver) Populate(msg *osc.Message) {
 	r.queue.Deliver(msg)
+
+	r.mu.RLock()
+	subscribers := make([]func(*osc.Message), 0, len(r.subscribers[msg.Address]))
+	for _, fn := range r.subscribers[msg.Address] {
+		subscribers = append(subscribers, fn)
+	}
+	r.mu.RUnlock()
+
+	for _, fn := range subscribers {
+		fn(msg)
+	}
 }